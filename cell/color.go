@@ -16,6 +16,7 @@ package cell
 
 import (
 	"fmt"
+	"math"
 )
 
 // color.go defines constants for cell colors.
@@ -28,6 +29,9 @@ func (cc Color) String() string {
 	if n, ok := colorNames[cc]; ok {
 		return n
 	}
+	if r, g, b, ok := cc.RGB(); ok {
+		return fmt.Sprintf("ColorRGB(%d, %d, %d)", r, g, b)
+	}
 	return fmt.Sprintf("Color:%d", cc)
 }
 
@@ -121,3 +125,226 @@ func ColorRGB24(r, g, b int) Color {
 	}
 	return ColorRGB6(r/51, g/51, b/51)
 }
+
+// colorRGBFlag marks colors created by ColorRGB. The red, green and blue
+// components are stored in the lower 24 bits.
+const colorRGBFlag Color = 1 << 24
+
+// ColorRGB sets a true color (24 bit) using the provided red, green and blue
+// components. Make sure your terminal is set to the
+// terminalapi.ColorModeFullRGB mode, in other modes the color is approximated
+// the same way as ColorRGB24.
+// The provided values (r, g, b) must be in the range 0-255.
+// Larger or smaller values will be reset to the default color.
+func ColorRGB(r, g, b int) Color {
+	for _, c := range []int{r, g, b} {
+		if c < 0 || c > 255 {
+			return ColorDefault
+		}
+	}
+	return colorRGBFlag | Color(r<<16|g<<8|b)
+}
+
+// RGB returns the red, green and blue components of a color created by
+// ColorRGB. The returned ok is false for all other colors.
+func (cc Color) RGB() (r, g, b int, ok bool) {
+	if cc&^(colorRGBFlag-1) != colorRGBFlag {
+		return 0, 0, 0, false
+	}
+	return int(cc>>16) & 0xff, int(cc>>8) & 0xff, int(cc) & 0xff, true
+}
+
+// xterm16 contains the red, green and blue components of the 16 Xterm colors.
+var xterm16 = [16][3]int{
+	{0, 0, 0},
+	{128, 0, 0},
+	{0, 128, 0},
+	{128, 128, 0},
+	{0, 0, 128},
+	{128, 0, 128},
+	{0, 128, 128},
+	{192, 192, 192},
+	{128, 128, 128},
+	{255, 0, 0},
+	{0, 255, 0},
+	{255, 255, 0},
+	{0, 0, 255},
+	{255, 0, 255},
+	{0, 255, 255},
+	{255, 255, 255},
+}
+
+// xtermLevels are the values of the components of the 6x6x6 terminal colors.
+var xtermLevels = [6]int{0, 95, 135, 175, 215, 255}
+
+// xtermRGB returns the red, green and blue components of the Xterm color
+// number n, where 0 <= n <= 255.
+func xtermRGB(n int) (r, g, b int) {
+	switch {
+	case n < 16:
+		c := xterm16[n]
+		return c[0], c[1], c[2]
+	case n < 232:
+		n -= 16
+		return xtermLevels[n/36], xtermLevels[n/6%6], xtermLevels[n%6]
+	default:
+		v := 8 + 10*(n-232)
+		return v, v, v
+	}
+}
+
+// NearestColor16 returns the one of the 16 Xterm colors (ColorBlack through
+// ColorWhite) that is the closest to the provided color. Useful to know how
+// a color looks like on a terminal in the terminalapi.ColorModeNormal mode.
+// The first 16 colors and the ColorDefault are returned unchanged, colors
+// outside of the 256 terminal colors that weren't created by ColorRGB are
+// converted to the default color.
+func NearestColor16(c Color) Color {
+	if _, _, _, ok := c.RGB(); !ok && c >= ColorDefault && c <= ColorWhite {
+		return c
+	}
+	r, g, b, ok := colorRGB(c)
+	if !ok {
+		return ColorDefault
+	}
+
+	nearest, minDist := 0, -1
+	for i, x := range xterm16 {
+		dr, dg, db := r-x[0], g-x[1], b-x[2]
+		if dist := dr*dr + dg*dg + db*db; minDist < 0 || dist < minDist {
+			nearest, minDist = i, dist
+		}
+	}
+	return ColorNumber(nearest)
+}
+
+// colorRGB returns the red, green and blue components of the color. Returns
+// false for the default color and colors outside of the 256 terminal colors
+// that weren't created by ColorRGB.
+func colorRGB(c Color) (r, g, b int, ok bool) {
+	if r, g, b, ok := c.RGB(); ok {
+		return r, g, b, true
+	}
+	if c <= ColorDefault || c > ColorNumber(255) {
+		return 0, 0, 0, false
+	}
+	r, g, b = xtermRGB(int(c) - 1) // Colors are off-by-one due to ColorDefault being zero.
+	return r, g, b, true
+}
+
+// Luminance returns the perceived brightness of the color in the range
+// 0 <= luminance <= 1, e.g. to choose a readable text color for a background
+// color. Computed from the red, green and blue components of the color using
+// the ITU-R BT.601 luma coefficients. Returns false for the default color and
+// colors outside of the 256 terminal colors that weren't created by ColorRGB,
+// since their brightness isn't known.
+func Luminance(c Color) (float64, bool) {
+	r, g, b, ok := colorRGB(c)
+	if !ok {
+		return 0, false
+	}
+	return (0.299*float64(r) + 0.587*float64(g) + 0.114*float64(b)) / 255, true
+}
+
+// Lerp returns the color at the fraction frac of a linear gradient
+// between the colors from and to, where 0 <= frac <= 1. Returns from when
+// frac is zero and to when frac is one, the colors in between are created by
+// ColorRGB and display precisely only in the terminalapi.ColorModeFullRGB
+// mode. If either of the colors is the default color, the gradient switches
+// from one to the other in the middle.
+func Lerp(from, to Color, frac float64) Color {
+	switch {
+	case frac <= 0:
+		return from
+	case frac >= 1:
+		return to
+	}
+
+	fr, fg, fb, fromOK := colorRGB(from)
+	tr, tg, tb, toOK := colorRGB(to)
+	if !fromOK || !toOK {
+		if frac < 0.5 {
+			return from
+		}
+		return to
+	}
+	lerp := func(a, b int) int {
+		return a + int(math.Round(float64(b-a)*frac))
+	}
+	return ColorRGB(lerp(fr, tr), lerp(fg, tg), lerp(fb, tb))
+}
+
+// Gradient returns the provided number of colors evenly spaced along a
+// linear gradient between the colors from and to, e.g. to color the levels
+// of a heat map. The first color is from and the last one is to, the colors
+// in between are computed by Lerp, so they display precisely only in the
+// terminalapi.ColorModeFullRGB mode. Use ColorMode.Gradient in the
+// terminalapi package to get colors suitable for other color modes.
+// Returns nil if steps is zero or negative and only from if steps is one.
+func Gradient(from, to Color, steps int) []Color {
+	if steps <= 0 {
+		return nil
+	}
+	if steps == 1 {
+		return []Color{from}
+	}
+
+	res := make([]Color, steps)
+	for i := range res {
+		res[i] = Lerp(from, to, float64(i)/float64(steps-1))
+	}
+	return res
+}
+
+// Blend composites the color over onto the color base with the opacity
+// alpha, where 0 <= alpha <= 1, e.g. to draw a semi-transparent highlight.
+// Returns base when alpha is zero and over when alpha is one.
+// When both colors are among the 256 terminal colors, the result is the
+// nearest of the 256 terminal colors so that it displays in the
+// terminalapi.ColorMode256 mode. Otherwise the result is created by ColorRGB.
+// If either of the colors is the default color, the result switches from
+// base to over when alpha reaches one half.
+func Blend(base, over Color, alpha float64) Color {
+	c := Lerp(base, over, alpha)
+	r, g, b, ok := c.RGB()
+	if !ok {
+		return c
+	}
+	if _, _, _, ok := base.RGB(); ok {
+		return c
+	}
+	if _, _, _, ok := over.RGB(); ok {
+		return c
+	}
+	return nearestColor256(r, g, b)
+}
+
+// NearestColor256 returns the one of the 256 terminal colors that is the
+// closest to the provided color. Useful to approximate colors created by
+// ColorRGB on a terminal in the terminalapi.ColorMode256 mode.
+// The 256 terminal colors and the ColorDefault are returned unchanged, other
+// colors that weren't created by ColorRGB are converted to the default color.
+func NearestColor256(c Color) Color {
+	r, g, b, ok := c.RGB()
+	if !ok {
+		if c < ColorDefault || c > ColorNumber(255) {
+			return ColorDefault
+		}
+		return c
+	}
+	return nearestColor256(r, g, b)
+}
+
+// nearestColor256 returns the one of the 256 terminal colors that is the
+// closest to the provided red, green and blue components.
+func nearestColor256(r, g, b int) Color {
+	nearest, minDist := 0, -1
+	for n := 0; n < 256; n++ {
+		xr, xg, xb := xtermRGB(n)
+		dr, dg, db := r-xr, g-xg, b-xb
+		if dist := dr*dr + dg*dg + db*db; minDist < 0 || dist < minDist {
+			nearest, minDist = n, dist
+		}
+	}
+	return ColorNumber(nearest)
+}
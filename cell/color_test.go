@@ -16,7 +16,10 @@ package cell
 
 import (
 	"fmt"
+	"math"
 	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
 )
 
 func TestColorNumber(t *testing.T) {
@@ -205,3 +208,493 @@ func TestColorRGB24(t *testing.T) {
 		})
 	}
 }
+
+func TestColorRGB(t *testing.T) {
+	tests := []struct {
+		desc          string
+		r, g, b       int
+		want          Color
+		wantComponent bool
+		wantString    string
+	}{
+		{
+			desc:       "default when r too small",
+			r:          -1,
+			want:       ColorDefault,
+			wantString: "ColorDefault",
+		},
+		{
+			desc:       "default when g too large",
+			g:          256,
+			want:       ColorDefault,
+			wantString: "ColorDefault",
+		},
+		{
+			desc:       "default when b too large",
+			b:          256,
+			want:       ColorDefault,
+			wantString: "ColorDefault",
+		},
+		{
+			desc:          "black",
+			want:          colorRGBFlag,
+			wantComponent: true,
+			wantString:    "ColorRGB(0, 0, 0)",
+		},
+		{
+			desc:          "stores the components",
+			r:             18,
+			g:             52,
+			b:             86,
+			want:          colorRGBFlag | 0x123456,
+			wantComponent: true,
+			wantString:    "ColorRGB(18, 52, 86)",
+		},
+		{
+			desc:          "white",
+			r:             255,
+			g:             255,
+			b:             255,
+			want:          colorRGBFlag | 0xffffff,
+			wantComponent: true,
+			wantString:    "ColorRGB(255, 255, 255)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := ColorRGB(tc.r, tc.g, tc.b)
+			if got != tc.want {
+				t.Errorf("ColorRGB(%v, %v, %v) => %v, want %v", tc.r, tc.g, tc.b, got, tc.want)
+			}
+			if got := got.String(); got != tc.wantString {
+				t.Errorf("String => %q, want %q", got, tc.wantString)
+			}
+
+			r, g, b, ok := got.RGB()
+			if ok != tc.wantComponent {
+				t.Fatalf("RGB => ok %v, want %v", ok, tc.wantComponent)
+			}
+			if ok && (r != tc.r || g != tc.g || b != tc.b) {
+				t.Errorf("RGB => (%v, %v, %v), want (%v, %v, %v)", r, g, b, tc.r, tc.g, tc.b)
+			}
+		})
+	}
+}
+
+func TestRGBNotSetForOtherColors(t *testing.T) {
+	for _, c := range []Color{ColorDefault, ColorBlack, ColorWhite, ColorNumber(255), ColorRGB6(5, 5, 5), ColorRGB24(255, 255, 255), Color(-1)} {
+		if r, g, b, ok := c.RGB(); ok {
+			t.Errorf("%v.RGB => (%v, %v, %v, %v), want ok false", c, r, g, b, ok)
+		}
+	}
+}
+
+func TestNearestColor16(t *testing.T) {
+	tests := []struct {
+		desc  string
+		color Color
+		want  Color
+	}{
+		{
+			desc:  "default color is unchanged",
+			color: ColorDefault,
+			want:  ColorDefault,
+		},
+		{
+			desc:  "negative color is converted to default",
+			color: Color(-1),
+			want:  ColorDefault,
+		},
+		{
+			desc:  "color above the terminal colors is converted to default",
+			color: Color(257),
+			want:  ColorDefault,
+		},
+		{
+			desc:  "first of the 16 colors is unchanged",
+			color: ColorBlack,
+			want:  ColorBlack,
+		},
+		{
+			desc:  "last of the 16 colors is unchanged",
+			color: ColorWhite,
+			want:  ColorWhite,
+		},
+		{
+			desc:  "black of the 6x6x6 colors",
+			color: ColorRGB6(0, 0, 0),
+			want:  ColorBlack,
+		},
+		{
+			desc:  "dark blue of the 6x6x6 colors",
+			color: ColorRGB6(0, 0, 1),
+			want:  ColorNavy,
+		},
+		{
+			desc:  "red of the 6x6x6 colors",
+			color: ColorRGB6(5, 0, 0),
+			want:  ColorRed,
+		},
+		{
+			desc:  "orange of the 6x6x6 colors",
+			color: ColorRGB6(5, 3, 0),
+			want:  ColorYellow,
+		},
+		{
+			desc:  "dark shade of grey",
+			color: ColorNumber(233),
+			want:  ColorBlack,
+		},
+		{
+			desc:  "middle shade of grey",
+			color: ColorNumber(244),
+			want:  ColorGray,
+		},
+		{
+			desc:  "light shade of grey",
+			color: ColorNumber(250),
+			want:  ColorSilver,
+		},
+		{
+			desc:  "RGB color",
+			color: ColorRGB(0, 150, 140),
+			want:  ColorTeal,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := NearestColor16(tc.color)
+			if got != tc.want {
+				t.Errorf("NearestColor16(%v) => %v, want %v", tc.color, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNearestColor256(t *testing.T) {
+	tests := []struct {
+		desc  string
+		color Color
+		want  Color
+	}{
+		{
+			desc:  "default color is unchanged",
+			color: ColorDefault,
+			want:  ColorDefault,
+		},
+		{
+			desc:  "negative color is converted to default",
+			color: Color(-1),
+			want:  ColorDefault,
+		},
+		{
+			desc:  "color above the terminal colors is converted to default",
+			color: Color(257),
+			want:  ColorDefault,
+		},
+		{
+			desc:  "terminal color is unchanged",
+			color: ColorNumber(100),
+			want:  ColorNumber(100),
+		},
+		{
+			desc:  "RGB color matching one of the 16 colors",
+			color: ColorRGB(128, 0, 128),
+			want:  ColorPurple,
+		},
+		{
+			desc:  "RGB color matching one of the 6x6x6 colors",
+			color: ColorRGB(95, 135, 175),
+			want:  ColorRGB6(1, 2, 3),
+		},
+		{
+			desc:  "RGB shade of grey",
+			color: ColorRGB(100, 100, 100),
+			want:  ColorNumber(241),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := NearestColor256(tc.color)
+			if got != tc.want {
+				t.Errorf("NearestColor256(%v) => %v, want %v", tc.color, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLuminance(t *testing.T) {
+	tests := []struct {
+		desc   string
+		color  Color
+		want   float64
+		wantOK bool
+	}{
+		{
+			desc:  "unknown for the default color",
+			color: ColorDefault,
+		},
+		{
+			desc:  "unknown for colors outside of the terminal colors",
+			color: Color(257),
+		},
+		{
+			desc:   "black",
+			color:  ColorBlack,
+			want:   0,
+			wantOK: true,
+		},
+		{
+			desc:   "white",
+			color:  ColorWhite,
+			want:   1,
+			wantOK: true,
+		},
+		{
+			desc:   "yellow",
+			color:  ColorYellow,
+			want:   0.886,
+			wantOK: true,
+		},
+		{
+			desc:   "blue",
+			color:  ColorBlue,
+			want:   0.114,
+			wantOK: true,
+		},
+		{
+			desc:   "shade of grey",
+			color:  ColorNumber(244),
+			want:   128.0 / 255,
+			wantOK: true,
+		},
+		{
+			desc:   "RGB color",
+			color:  ColorRGB(255, 0, 0),
+			want:   0.299,
+			wantOK: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, ok := Luminance(tc.color)
+			if ok != tc.wantOK || math.Abs(got-tc.want) > 1e-9 {
+				t.Errorf("Luminance(%v) => (%v, %v), want (%v, %v)", tc.color, got, ok, tc.want, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestLerp(t *testing.T) {
+	tests := []struct {
+		desc     string
+		from, to Color
+		frac     float64
+		want     Color
+	}{
+		{
+			desc: "returns from at the start",
+			from: ColorRed,
+			to:   ColorBlue,
+			frac: 0,
+			want: ColorRed,
+		},
+		{
+			desc: "returns to at the end",
+			from: ColorRed,
+			to:   ColorBlue,
+			frac: 1,
+			want: ColorBlue,
+		},
+		{
+			desc: "clamps fractions outside of the range",
+			from: ColorRed,
+			to:   ColorBlue,
+			frac: 1.5,
+			want: ColorBlue,
+		},
+		{
+			desc: "interpolates between numbered colors",
+			from: ColorRed,
+			to:   ColorBlue,
+			frac: 0.5,
+			want: ColorRGB(127, 0, 128),
+		},
+		{
+			desc: "interpolates between RGB colors",
+			from: ColorRGB(0, 0, 0),
+			to:   ColorRGB(100, 200, 40),
+			frac: 0.25,
+			want: ColorRGB(25, 50, 10),
+		},
+		{
+			desc: "switches from the default color in the middle",
+			from: ColorDefault,
+			to:   ColorBlue,
+			frac: 0.4,
+			want: ColorDefault,
+		},
+		{
+			desc: "switches to the default color in the middle",
+			from: ColorRed,
+			to:   ColorDefault,
+			frac: 0.5,
+			want: ColorDefault,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := Lerp(tc.from, tc.to, tc.frac)
+			if got != tc.want {
+				t.Errorf("Lerp(%v, %v, %v) => %v, want %v", tc.from, tc.to, tc.frac, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGradient(t *testing.T) {
+	tests := []struct {
+		desc     string
+		from, to Color
+		steps    int
+		want     []Color
+	}{
+		{
+			desc:  "no colors for zero steps",
+			from:  ColorRed,
+			to:    ColorBlue,
+			steps: 0,
+			want:  nil,
+		},
+		{
+			desc:  "no colors for negative steps",
+			from:  ColorRed,
+			to:    ColorBlue,
+			steps: -1,
+			want:  nil,
+		},
+		{
+			desc:  "only from for one step",
+			from:  ColorRed,
+			to:    ColorBlue,
+			steps: 1,
+			want:  []Color{ColorRed},
+		},
+		{
+			desc:  "from and to for two steps",
+			from:  ColorRed,
+			to:    ColorBlue,
+			steps: 2,
+			want:  []Color{ColorRed, ColorBlue},
+		},
+		{
+			desc:  "evenly spaced RGB colors",
+			from:  ColorRGB(0, 0, 0),
+			to:    ColorRGB(200, 100, 40),
+			steps: 5,
+			want: []Color{
+				ColorRGB(0, 0, 0),
+				ColorRGB(50, 25, 10),
+				ColorRGB(100, 50, 20),
+				ColorRGB(150, 75, 30),
+				ColorRGB(200, 100, 40),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := Gradient(tc.from, tc.to, tc.steps)
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("Gradient => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBlend(t *testing.T) {
+	tests := []struct {
+		desc       string
+		base, over Color
+		alpha      float64
+		want       Color
+	}{
+		{
+			desc:  "returns base when fully transparent",
+			base:  ColorRed,
+			over:  ColorBlue,
+			alpha: 0,
+			want:  ColorRed,
+		},
+		{
+			desc:  "returns over when fully opaque",
+			base:  ColorRed,
+			over:  ColorBlue,
+			alpha: 1,
+			want:  ColorBlue,
+		},
+		{
+			desc:  "blends the 16 Xterm colors into a terminal color",
+			base:  ColorBlack,
+			over:  ColorWhite,
+			alpha: 0.5,
+			want:  ColorGray,
+		},
+		{
+			desc:  "returns the nearest terminal color",
+			base:  ColorRed,
+			over:  ColorBlue,
+			alpha: 0.5,
+			want:  ColorPurple,
+		},
+		{
+			desc:  "blends the 6x6x6 terminal colors",
+			base:  ColorNumber(196),
+			over:  ColorNumber(21),
+			alpha: 0.2,
+			want:  ColorNumber(161),
+		},
+		{
+			desc:  "blends RGB colors into an RGB color",
+			base:  ColorRGB(0, 0, 0),
+			over:  ColorRGB(100, 200, 40),
+			alpha: 0.25,
+			want:  ColorRGB(25, 50, 10),
+		},
+		{
+			desc:  "blends an RGB and a terminal color into an RGB color",
+			base:  ColorRGB(0, 0, 0),
+			over:  ColorWhite,
+			alpha: 0.5,
+			want:  ColorRGB(128, 128, 128),
+		},
+		{
+			desc:  "keeps the default base color below one half",
+			base:  ColorDefault,
+			over:  ColorBlue,
+			alpha: 0.4,
+			want:  ColorDefault,
+		},
+		{
+			desc:  "switches from the default base color at one half",
+			base:  ColorDefault,
+			over:  ColorBlue,
+			alpha: 0.5,
+			want:  ColorBlue,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := Blend(tc.base, tc.over, tc.alpha)
+			if got != tc.want {
+				t.Errorf("Blend(%v, %v, %v) => %v, want %v", tc.base, tc.over, tc.alpha, got, tc.want)
+			}
+		})
+	}
+}
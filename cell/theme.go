@@ -0,0 +1,77 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cell
+
+// theme.go contains a set of colors that can be shared by multiple widgets.
+
+// Theme bundles the colors commonly used across a dashboard, so they can be
+// defined once and passed around instead of repeating the cell options for
+// each widget. Unset colors are ColorDefault, i.e. the default colors of the
+// terminal.
+//
+// The methods return the cell options for text drawn in one of the colors on
+// the Background color. Options provided to the methods are appended, so they
+// can add attributes or override the colors:
+//
+//	theme := cell.Theme{Primary: cell.ColorNumber(33), Text: cell.ColorWhite}
+//	text.WriteCellOpts(theme.AccentOpts(cell.Bold())...)
+//
+// Options that accept a single color, e.g. the border color of a container,
+// can use the fields directly.
+type Theme struct {
+	// Primary is the main color, e.g. of the bars and lines in charts.
+	Primary Color
+	// Secondary is the color of supporting elements, e.g. of a second series.
+	Secondary Color
+	// Accent is the color that draws attention, e.g. to highlighted values.
+	Accent Color
+	// Border is the color of borders and axes.
+	Border Color
+	// Text is the color of regular text and labels.
+	Text Color
+	// Background is the background color of all the elements.
+	Background Color
+}
+
+// PrimaryOpts returns cell options for the Primary color.
+func (t Theme) PrimaryOpts(opts ...Option) []Option {
+	return t.opts(t.Primary, opts)
+}
+
+// SecondaryOpts returns cell options for the Secondary color.
+func (t Theme) SecondaryOpts(opts ...Option) []Option {
+	return t.opts(t.Secondary, opts)
+}
+
+// AccentOpts returns cell options for the Accent color.
+func (t Theme) AccentOpts(opts ...Option) []Option {
+	return t.opts(t.Accent, opts)
+}
+
+// BorderOpts returns cell options for the Border color.
+func (t Theme) BorderOpts(opts ...Option) []Option {
+	return t.opts(t.Border, opts)
+}
+
+// TextOpts returns cell options for the Text color.
+func (t Theme) TextOpts(opts ...Option) []Option {
+	return t.opts(t.Text, opts)
+}
+
+// opts returns cell options for the foreground color on the Background color
+// followed by the provided options.
+func (t Theme) opts(fg Color, opts []Option) []Option {
+	return append([]Option{FgColor(fg), BgColor(t.Background)}, opts...)
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cell
+
+import (
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+)
+
+func TestTheme(t *testing.T) {
+	theme := Theme{
+		Primary:    ColorNumber(33),
+		Secondary:  ColorBlue,
+		Accent:     ColorYellow,
+		Border:     ColorWhite,
+		Text:       ColorGreen,
+		Background: ColorBlack,
+	}
+
+	tests := []struct {
+		desc string
+		opts []Option
+		want *Options
+	}{
+		{
+			desc: "primary",
+			opts: theme.PrimaryOpts(),
+			want: &Options{FgColor: ColorNumber(33), BgColor: ColorBlack},
+		},
+		{
+			desc: "secondary",
+			opts: theme.SecondaryOpts(),
+			want: &Options{FgColor: ColorBlue, BgColor: ColorBlack},
+		},
+		{
+			desc: "accent with additional options",
+			opts: theme.AccentOpts(Bold(), Underline()),
+			want: &Options{FgColor: ColorYellow, BgColor: ColorBlack, Bold: true, Underline: true},
+		},
+		{
+			desc: "border",
+			opts: theme.BorderOpts(),
+			want: &Options{FgColor: ColorWhite, BgColor: ColorBlack},
+		},
+		{
+			desc: "text with overridden background",
+			opts: theme.TextOpts(BgColor(ColorRed)),
+			want: &Options{FgColor: ColorGreen, BgColor: ColorRed},
+		},
+		{
+			desc: "zero value uses the default colors",
+			opts: Theme{}.TextOpts(),
+			want: &Options{FgColor: ColorDefault, BgColor: ColorDefault},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := NewOptions(tc.opts...)
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("NewOptions => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -26,8 +26,11 @@ import (
 	"fmt"
 	"image"
 	"sync"
+	"time"
 
+	"github.com/woodliu/termdash/keyboard"
 	"github.com/woodliu/termdash/linestyle"
+	"github.com/woodliu/termdash/mouse"
 	"github.com/woodliu/termdash/private/alignfor"
 	"github.com/woodliu/termdash/private/area"
 	"github.com/woodliu/termdash/private/event"
@@ -57,9 +60,28 @@ type Container struct {
 	// Initialized the first time Draw is called.
 	area image.Rectangle
 
+	// widgetDrawnArea is the area of the terminal the widget was given to
+	// draw onto on the last call to Draw.
+	// A zero area if the widget wasn't drawn.
+	widgetDrawnArea image.Rectangle
+
+	// scrollOffset is the number of lines the content of a scrollable
+	// container is scrolled by.
+	scrollOffset int
+	// scrollHeight is the height of the scrolled canvas of the widget on the
+	// last call to Draw. Zero if the widget didn't need to be scrolled.
+	scrollHeight int
+	// scrollView is the area of the terminal that displayed the scrolled
+	// content on the last call to Draw.
+	scrollView image.Rectangle
+
 	// opts are the options provided to the container.
 	opts *options
 
+	// hidden indicates that this container and all of its sub containers
+	// aren't drawn and don't receive events, see SetVisible.
+	hidden bool
+
 	// clearNeeded indicates if the terminal needs to be cleared next time we
 	// are clearNeeded the container.
 	// This is required if the container was updated and thus the layout might
@@ -139,8 +161,8 @@ func (c *Container) usable() image.Rectangle {
 }
 
 // widgetArea returns the area in the container that is available for the
-// widget's canvas. Takes the container border, widget's requested maximum size
-// and ratio and container's alignment into account.
+// widget's canvas. Takes the container border, padding, maximum size, widget's
+// requested maximum size and ratio and container's alignment into account.
 // Returns a zero area if the container has no widget.
 func (c *Container) widgetArea() (image.Rectangle, error) {
 	if !c.hasWidget() {
@@ -160,6 +182,12 @@ func (c *Container) widgetArea() (image.Rectangle, error) {
 	if maxY := wOpts.MaximumSize.Y; maxY > 0 && adjusted.Dy() > maxY {
 		adjusted.Max.Y -= adjusted.Dy() - maxY
 	}
+	if maxX := c.opts.maxWidth; maxX > 0 && adjusted.Dx() > maxX {
+		adjusted.Max.X -= adjusted.Dx() - maxX
+	}
+	if maxY := c.opts.maxHeight; maxY > 0 && adjusted.Dy() > maxY {
+		adjusted.Max.Y -= adjusted.Dy() - maxY
+	}
 
 	if wOpts.Ratio.X > 0 && wOpts.Ratio.Y > 0 {
 		adjusted = area.WithRatio(adjusted, wOpts.Ratio)
@@ -172,12 +200,19 @@ func (c *Container) widgetArea() (image.Rectangle, error) {
 }
 
 // split splits the container's usable area into child areas.
+// If one of the child containers is hidden, the other one gets all the area.
 // Panics if the container isn't configured for a split.
 func (c *Container) split() (image.Rectangle, image.Rectangle, error) {
 	ar, err := c.opts.padding.apply(c.usable())
 	if err != nil {
 		return image.ZR, image.ZR, err
 	}
+	switch {
+	case c.first != nil && c.first.hidden:
+		return image.ZR, ar, nil
+	case c.second != nil && c.second.hidden:
+		return ar, image.ZR, nil
+	}
 	if c.opts.splitFixed > DefaultSplitFixed {
 		if c.opts.split == splitTypeVertical {
 			if c.opts.splitReversed {
@@ -191,16 +226,108 @@ func (c *Container) split() (image.Rectangle, image.Rectangle, error) {
 		return area.HSplitCells(ar, c.opts.splitFixed)
 	}
 
-	if c.opts.split == splitTypeVertical {
+	var first, second image.Rectangle
+	if c.opts.splitTotal > 0 {
+		if c.opts.split == splitTypeVertical {
+			first, second, err = area.VSplitCells(ar, ar.Dx()*c.opts.splitParts/c.opts.splitTotal)
+		} else {
+			first, second, err = area.HSplitCells(ar, ar.Dy()*c.opts.splitParts/c.opts.splitTotal)
+		}
+	} else if c.opts.split == splitTypeVertical {
 		if c.opts.splitReversed {
-			return area.VSplitReversed(ar, c.opts.splitPercent)
+			first, second, err = area.VSplitReversed(ar, c.opts.splitPercent)
+		} else {
+			first, second, err = area.VSplit(ar, c.opts.splitPercent)
 		}
-		return area.VSplit(ar, c.opts.splitPercent)
+	} else {
+		if c.opts.splitReversed {
+			first, second, err = area.HSplitReversed(ar, c.opts.splitPercent)
+		} else {
+			first, second, err = area.HSplit(ar, c.opts.splitPercent)
+		}
+	}
+	if err != nil {
+		return image.ZR, image.ZR, err
 	}
-	if c.opts.splitReversed {
-		return area.HSplitReversed(ar, c.opts.splitPercent)
+	return c.clampSplit(ar, first, second)
+}
+
+// clampSplit adjusts a percentage based split of the area so that neither of
+// the child containers is smaller than its minimum size, see minSize.
+// Returns the split unchanged if the container doesn't have both children or
+// if the area is too small to satisfy the minimum sizes of both children.
+func (c *Container) clampSplit(ar, first, second image.Rectangle) (image.Rectangle, image.Rectangle, error) {
+	if c.first == nil || c.second == nil {
+		return first, second, nil
+	}
+
+	firstMin, secondMin := c.first.minSize(), c.second.minSize()
+	if c.opts.split == splitTypeVertical {
+		cells, ok := clampCells(first.Dx(), ar.Dx(), firstMin.X, secondMin.X)
+		if !ok {
+			return first, second, nil
+		}
+		return area.VSplitCells(ar, cells)
+	}
+
+	cells, ok := clampCells(first.Dy(), ar.Dy(), firstMin.Y, secondMin.Y)
+	if !ok {
+		return first, second, nil
+	}
+	return area.HSplitCells(ar, cells)
+}
+
+// clampCells clamps the size of the first part of a split of total cells so
+// that the first part has at least firstMin cells and the second part at least
+// secondMin cells. The bool return value is false if the split doesn't need to
+// change or if both minimums can't be satisfied.
+func clampCells(firstCells, total, firstMin, secondMin int) (int, bool) {
+	if firstMin+secondMin > total {
+		return 0, false
+	}
+	switch {
+	case firstCells < firstMin:
+		return firstMin, true
+	case total-firstCells < secondMin:
+		return total - secondMin, true
+	}
+	return 0, false
+}
+
+// minSize returns the minimum size this container needs so that all the
+// widgets in it get at least their requested minimum size.
+// Takes borders, margin and padding specified in cells into account, margin
+// and padding specified as a percentage are ignored.
+func (c *Container) minSize() image.Point {
+	var size image.Point
+	if c.hidden {
+		// Hidden containers don't need any space.
+		return size
+	}
+	switch {
+	case c.hasWidget():
+		size = c.opts.widget.Options().MinimumSize
+		if c.opts.scrollable {
+			// Any height will do, but the scrollbar needs space.
+			size = image.Point{size.X + scrollbarWidth, 1}
+		}
+
+	case c.first != nil && c.second != nil:
+		first, second := c.first.minSize(), c.second.minSize()
+		if c.opts.split == splitTypeVertical {
+			size = image.Point{first.X + second.X, max(first.Y, second.Y)}
+		} else {
+			size = image.Point{max(first.X, second.X), first.Y + second.Y}
+		}
+	}
+
+	if c.hasBorder() {
+		size = size.Add(image.Point{2, 2})
 	}
-	return area.HSplit(ar, c.opts.splitPercent)
+	p, m := c.opts.padding, c.opts.margin
+	size.X += p.leftCells + p.rightCells + m.leftCells + m.rightCells
+	size.Y += p.topCells + p.bottomCells + m.topCells + m.bottomCells
+	return size
 }
 
 // createFirst creates and returns the first sub container of this container.
@@ -276,6 +403,122 @@ func (c *Container) Update(id string, opts ...Option) error {
 	return nil
 }
 
+// SetSplitPercent changes the relative size of the split of the container
+// with the specified id to p percent of the available space, as if it was
+// split with the SplitPercent option. This replaces any previously configured
+// split size, including SplitFixed or the *FromEnd options.
+// Useful for panes resizable by the user, e.g. via keyboard shortcuts.
+//
+// The container must be split using SplitVertical or SplitHorizontal and the
+// p must be in the range 0 < p < 100. The change takes effect the next time
+// the container is drawn.
+func (c *Container) SetSplitPercent(id string, p int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	target, err := findID(c, id)
+	if err != nil {
+		return err
+	}
+	if target.isLeaf() {
+		return fmt.Errorf("container with ID %q isn't split, cannot set its split percentage", id)
+	}
+	if min, max := 0, 100; p <= min || p >= max {
+		return fmt.Errorf("invalid split percentage %d, must be in range %d < p < %d", p, min, max)
+	}
+
+	target.opts.splitPercent = p
+	target.opts.splitFixed = DefaultSplitFixed
+	target.opts.splitTotal = 0
+	target.opts.splitReversed = false
+	c.clearNeeded = true
+	return nil
+}
+
+// SetVisible hides or shows the container with the specified id together with
+// all of its sub containers. Useful to toggle panels without rebuilding the
+// layout with Update.
+//
+// A hidden container isn't drawn and its widgets don't receive keyboard or
+// mouse events and can't be focused. If the hidden container is one of the
+// two sides of a split, the other side expands to take all the area of the
+// split. Otherwise the area of the hidden container is left blank. Focus on a
+// container that becomes hidden moves to the parent of the hidden container.
+// The change takes effect the next time the container is drawn.
+func (c *Container) SetVisible(id string, visible bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	target, err := findID(c, id)
+	if err != nil {
+		return err
+	}
+	if target.hidden == !visible {
+		return nil
+	}
+	target.hidden = !visible
+	c.clearNeeded = true
+	if visible {
+		return nil
+	}
+
+	if target.parent != nil && c.focusTracker.reachableFrom(target) {
+		c.focusTracker.setActive(target.parent)
+	}
+	var errStr string
+	preOrder(target, &errStr, visitFunc(func(cur *Container) error {
+		cur.area = image.ZR
+		cur.widgetDrawnArea = image.ZR
+		return nil
+	}))
+	return nil
+}
+
+// WidgetArea returns the area of the terminal that was given to the widget
+// placed in the container with the specified id on the last call to Draw.
+// Useful to draw external annotations aligned to a widget or to debug the
+// layout.
+// Returns false if there is no container with the id or if its widget wasn't
+// drawn, e.g. because Draw wasn't called yet, the container doesn't have a
+// widget or the terminal is too small for it.
+func (c *Container) WidgetArea(id string) (image.Rectangle, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	target, err := findID(c, id)
+	if err != nil {
+		return image.ZR, false
+	}
+	if target.widgetDrawnArea.Empty() {
+		return image.ZR, false
+	}
+	return target.widgetDrawnArea, true
+}
+
+// Broadcast calls the provided function once for each widget placed in this
+// container or any of its sub containers, in pre-order of the container tree.
+// The widgets are collected while holding the container lock, but the function
+// is called after the lock is released, so it can safely call methods of the
+// widgets or of this container, e.g. Update.
+func (c *Container) Broadcast(fn func(w widgetapi.Widget)) {
+	c.mu.Lock()
+	var (
+		errStr  string
+		widgets []widgetapi.Widget
+	)
+	preOrder(c, &errStr, visitFunc(func(cur *Container) error {
+		if cur.hasWidget() {
+			widgets = append(widgets, cur.opts.widget)
+		}
+		return nil
+	}))
+	c.mu.Unlock()
+
+	for _, w := range widgets {
+		fn(w)
+	}
+}
+
 // updateFocusFromMouse processes the mouse event and determines if it changes
 // the focused container.
 // Caller must hold c.mu.
@@ -287,6 +530,56 @@ func (c *Container) updateFocusFromMouse(m *terminalapi.Mouse) {
 	c.focusTracker.mouse(target, m)
 }
 
+// scroll scrolls the content of the container by the specified number of
+// lines, negative values scroll up.
+func (c *Container) scroll(lines int) {
+	c.scrollOffset += lines
+	c.clampScroll()
+}
+
+// clampScroll keeps the scroll offset within the bounds of the scrolled
+// content as of the last call to Draw.
+func (c *Container) clampScroll() {
+	if maxOff := c.scrollHeight - c.scrollView.Dy(); c.scrollOffset > maxOff {
+		c.scrollOffset = maxOff
+	}
+	if c.scrollOffset < 0 {
+		c.scrollOffset = 0
+	}
+}
+
+// updateScrollFromMouse processes the mouse event and scrolls the container
+// under the mouse cursor if it is scrollable.
+// Caller must hold c.mu.
+func (c *Container) updateScrollFromMouse(m *terminalapi.Mouse) {
+	target := pointCont(c, m.Position)
+	if target == nil || !target.opts.scrollable {
+		return
+	}
+	switch m.Button {
+	case mouse.ButtonWheelUp:
+		target.scroll(-1)
+	case mouse.ButtonWheelDown:
+		target.scroll(1)
+	}
+}
+
+// updateScrollFromKeyboard processes the keyboard event and scrolls the
+// focused container if it is scrollable.
+// Caller must hold c.mu.
+func (c *Container) updateScrollFromKeyboard(k *terminalapi.Keyboard) {
+	active := c.focusTracker.active()
+	if !active.opts.scrollable {
+		return
+	}
+	switch k.Key {
+	case keyboard.KeyPgUp:
+		active.scroll(-active.scrollView.Dy())
+	case keyboard.KeyPgDn:
+		active.scroll(active.scrollView.Dy())
+	}
+}
+
 // inFocusGroup returns true if this container is in the specified focus group.
 func (c *Container) inFocusGroup(fg FocusGroup) bool {
 	for _, cg := range c.opts.keyFocusGroups {
@@ -308,15 +601,16 @@ func (c *Container) updateFocusFromKeyboard(k *terminalapi.Keyboard) {
 	nextMatchesContGroup, nextG := nextGroupsForKey.firstMatching(active.opts.keyFocusGroups)
 	prevMatchesContGroup, prevG := prevGroupsForKey.firstMatching(active.opts.keyFocusGroups)
 
+	wrap := active.opts.global.keyFocusWrap
 	switch {
 	case active.opts.global.keyFocusNext != nil && *active.opts.global.keyFocusNext == k.Key:
-		c.focusTracker.next( /* group = */ nil)
+		c.focusTracker.next( /* group = */ nil, wrap)
 	case active.opts.global.keyFocusPrevious != nil && *active.opts.global.keyFocusPrevious == k.Key:
-		c.focusTracker.previous( /* group = */ nil)
+		c.focusTracker.previous( /* group = */ nil, wrap)
 	case isGroupKeyForNext && nextMatchesContGroup:
-		c.focusTracker.next(&nextG)
+		c.focusTracker.next(&nextG, wrap)
 	case isGroupKeyForPrev && prevMatchesContGroup:
-		c.focusTracker.previous(&prevG)
+		c.focusTracker.previous(&prevG, wrap)
 	}
 }
 
@@ -346,6 +640,7 @@ func (c *Container) prepareEvTargets(ev terminalapi.Event) (func() error, error)
 	switch e := ev.(type) {
 	case *terminalapi.Mouse:
 		c.updateFocusFromMouse(ev.(*terminalapi.Mouse))
+		c.updateScrollFromMouse(e)
 
 		targets, err := c.mouseEvTargets(e)
 		if err != nil {
@@ -354,7 +649,7 @@ func (c *Container) prepareEvTargets(ev terminalapi.Event) (func() error, error)
 		return func() error {
 			for _, mt := range targets {
 				if err := mt.widget.Mouse(mt.ev, mt.meta); err != nil {
-					return err
+					return fmt.Errorf("%s failed to process the mouse event: %v", mt.desc, err)
 				}
 			}
 			return nil
@@ -362,12 +657,13 @@ func (c *Container) prepareEvTargets(ev terminalapi.Event) (func() error, error)
 
 	case *terminalapi.Keyboard:
 		c.updateFocusFromKeyboard(ev.(*terminalapi.Keyboard))
+		c.updateScrollFromKeyboard(e)
 
 		targets := c.keyEvTargets()
 		return func() error {
 			for _, kt := range targets {
 				if err := kt.widget.Keyboard(e, kt.meta); err != nil {
-					return err
+					return fmt.Errorf("%s failed to process the keyboard event: %v", kt.desc, err)
 				}
 			}
 			return nil
@@ -378,19 +674,31 @@ func (c *Container) prepareEvTargets(ev terminalapi.Event) (func() error, error)
 	}
 }
 
+// widgetDesc describes the widget placed in the container for use in error
+// messages, e.g. `widget *text.Text in container "logs"`.
+func widgetDesc(c *Container) string {
+	if c.opts.id == "" {
+		return fmt.Sprintf("widget %T", c.opts.widget)
+	}
+	return fmt.Sprintf("widget %T in container %q", c.opts.widget, c.opts.id)
+}
+
 // keyEvTarget contains a widget that should receive an event and the metadata
 // for the event.
 type keyEvTarget struct {
 	// widget is the widget that should receive the keyboard event.
 	widget widgetapi.Widget
+	// desc describes the widget in error messages.
+	desc string
 	// meta is the metadata about the event.
 	meta *widgetapi.EventMeta
 }
 
-// newKeyEvTarget returns a new keyEvTarget.
-func newKeyEvTarget(w widgetapi.Widget, meta *widgetapi.EventMeta) *keyEvTarget {
+// newKeyEvTarget returns a new keyEvTarget for the widget in the container.
+func newKeyEvTarget(c *Container, meta *widgetapi.EventMeta) *keyEvTarget {
 	return &keyEvTarget{
-		widget: w,
+		widget: c.opts.widget,
+		desc:   widgetDesc(c),
 		meta:   meta,
 	}
 }
@@ -403,13 +711,13 @@ func (c *Container) keyEvTargets() []*keyEvTarget {
 		errStr  string
 		targets []*keyEvTarget
 		// If the currently focused widget set the ExclusiveKeyboardOnFocus
-		// option, this pointer is set to that widget.
-		exclusiveWidget widgetapi.Widget
+		// option, this pointer is set to the container of that widget.
+		exclusiveCont *Container
 	)
 
 	// All the targets that should receive this event.
 	// For now stable ordering (preOrder).
-	preOrder(c, &errStr, visitFunc(func(cur *Container) error {
+	preOrderVisible(c, &errStr, visitFunc(func(cur *Container) error {
 		if !cur.hasWidget() {
 			return nil
 		}
@@ -420,7 +728,7 @@ func (c *Container) keyEvTargets() []*keyEvTarget {
 		}
 		wOpt := cur.opts.widget.Options()
 		if focused && wOpt.ExclusiveKeyboardOnFocus {
-			exclusiveWidget = cur.opts.widget
+			exclusiveCont = cur
 		}
 
 		switch wOpt.WantKeyboard {
@@ -430,18 +738,18 @@ func (c *Container) keyEvTargets() []*keyEvTarget {
 
 		case widgetapi.KeyScopeFocused:
 			if focused {
-				targets = append(targets, newKeyEvTarget(cur.opts.widget, meta))
+				targets = append(targets, newKeyEvTarget(cur, meta))
 			}
 
 		case widgetapi.KeyScopeGlobal:
-			targets = append(targets, newKeyEvTarget(cur.opts.widget, meta))
+			targets = append(targets, newKeyEvTarget(cur, meta))
 		}
 		return nil
 	}))
 
-	if exclusiveWidget != nil {
+	if exclusiveCont != nil {
 		targets = []*keyEvTarget{
-			newKeyEvTarget(exclusiveWidget, &widgetapi.EventMeta{Focused: true}),
+			newKeyEvTarget(exclusiveCont, &widgetapi.EventMeta{Focused: true}),
 		}
 	}
 	return targets
@@ -452,16 +760,19 @@ func (c *Container) keyEvTargets() []*keyEvTarget {
 type mouseEvTarget struct {
 	// widget is the widget that should receive the mouse event.
 	widget widgetapi.Widget
+	// desc describes the widget in error messages.
+	desc string
 	// ev is the adjusted mouse event.
 	ev *terminalapi.Mouse
 	// meta is the metadata about the event.
 	meta *widgetapi.EventMeta
 }
 
-// newMouseEvTarget returns a new mouseEvTarget.
-func newMouseEvTarget(w widgetapi.Widget, wArea image.Rectangle, ev *terminalapi.Mouse, meta *widgetapi.EventMeta) *mouseEvTarget {
+// newMouseEvTarget returns a new mouseEvTarget for the widget in the container.
+func newMouseEvTarget(c *Container, wArea image.Rectangle, ev *terminalapi.Mouse, meta *widgetapi.EventMeta) *mouseEvTarget {
 	return &mouseEvTarget{
-		widget: w,
+		widget: c.opts.widget,
+		desc:   widgetDesc(c),
 		ev:     adjustMouseEv(ev, wArea),
 		meta:   meta,
 	}
@@ -478,7 +789,7 @@ func (c *Container) mouseEvTargets(m *terminalapi.Mouse) ([]*mouseEvTarget, erro
 
 	// All the widgets that should receive this event.
 	// For now stable ordering (preOrder).
-	preOrder(c, &errStr, visitFunc(func(cur *Container) error {
+	preOrderVisible(c, &errStr, visitFunc(func(cur *Container) error {
 		if !cur.hasWidget() {
 			return nil
 		}
@@ -488,10 +799,11 @@ func (c *Container) mouseEvTargets(m *terminalapi.Mouse) ([]*mouseEvTarget, erro
 		if err != nil {
 			return err
 		}
-
-		meta := &widgetapi.EventMeta{
-			Focused: cur.focusTracker.isActive(cur),
+		if cur.scrollHeight > 0 {
+			wa = cur.scrollView
 		}
+
+		var want bool
 		switch wOpts.WantMouse {
 		case widgetapi.MouseScopeNone:
 			// Widget doesn't want any mouse events.
@@ -499,20 +811,34 @@ func (c *Container) mouseEvTargets(m *terminalapi.Mouse) ([]*mouseEvTarget, erro
 
 		case widgetapi.MouseScopeWidget:
 			// Only if the event falls inside of the widget's canvas.
-			if m.Position.In(wa) {
-				widgets = append(widgets, newMouseEvTarget(cur.opts.widget, wa, m, meta))
-			}
+			want = m.Position.In(wa)
 
 		case widgetapi.MouseScopeContainer:
 			// Only if the event falls inside the widget's parent container.
-			if m.Position.In(cur.area) {
-				widgets = append(widgets, newMouseEvTarget(cur.opts.widget, wa, m, meta))
-			}
+			want = m.Position.In(cur.area)
 
 		case widgetapi.MouseScopeGlobal:
 			// Widget wants all mouse events.
-			widgets = append(widgets, newMouseEvTarget(cur.opts.widget, wa, m, meta))
+			want = true
+
+		case widgetapi.MouseScopeWheel:
+			// Only the mouse wheel if the event falls inside of the widget's
+			// canvas.
+			want = m.Position.In(wa) && (m.Button == mouse.ButtonWheelUp || m.Button == mouse.ButtonWheelDown)
+		}
+		if !want {
+			return nil
+		}
+
+		meta := &widgetapi.EventMeta{
+			Focused: cur.focusTracker.isActive(cur),
 		}
+		target := newMouseEvTarget(cur, wa, m, meta)
+		if cur.scrollHeight > 0 && m.Position.In(wa) {
+			// Positions are relative to the scrolled canvas.
+			target.ev.Position.Y += cur.scrollOffset
+		}
+		widgets = append(widgets, target)
 		return nil
 	}))
 
@@ -546,6 +872,47 @@ func (c *Container) Subscribe(eds *event.DistributionSystem) {
 	}, event.MaxRepetitive(maxReps))
 }
 
+// SetTerminal replaces the terminal the container and all of its sub
+// containers draw onto. Termdash uses this to wrap the terminal while it runs
+// and restores the original terminal when it stops.
+// This method is private to termdash, stability isn't guaranteed and changes
+// won't be backward compatible.
+func (c *Container) SetTerminal(t terminalapi.Terminal) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var errStr string
+	preOrder(rootCont(c), &errStr, visitFunc(func(c *Container) error {
+		c.term = t
+		return nil
+	}))
+}
+
+// RedrawInterval returns the shortest redraw interval requested by the visible
+// widgets via widgetapi.Options.RedrawInterval. Returns zero if none of the
+// widgets requested one.
+// This method is private to termdash, stability isn't guaranteed and changes
+// won't be backward compatible.
+func (c *Container) RedrawInterval() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var (
+		errStr   string
+		interval time.Duration
+	)
+	preOrderVisible(rootCont(c), &errStr, visitFunc(func(cur *Container) error {
+		if !cur.hasWidget() {
+			return nil
+		}
+		if ri := cur.opts.widget.Options().RedrawInterval; ri > 0 && (interval == 0 || ri < interval) {
+			interval = ri
+		}
+		return nil
+	}))
+	return interval
+}
+
 // adjustMouseEv adjusts the mouse event relative to the widget area.
 func adjustMouseEv(m *terminalapi.Mouse, wArea image.Rectangle) *terminalapi.Mouse {
 	// The sent mouse coordinate is relative to the widget canvas, i.e. zero
@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kylelemons/godebug/pretty"
 	"github.com/woodliu/termdash/align"
 	"github.com/woodliu/termdash/cell"
 	"github.com/woodliu/termdash/keyboard"
@@ -427,6 +428,22 @@ func TestNew(t *testing.T) {
 			},
 			wantContainerErr: true,
 		},
+		{
+			desc:     "fails on MaxWidth too low",
+			termSize: image.Point{10, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(ft, MaxWidth(0))
+			},
+			wantContainerErr: true,
+		},
+		{
+			desc:     "fails on MaxHeight too low",
+			termSize: image.Point{10, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(ft, MaxHeight(0))
+			},
+			wantContainerErr: true,
+		},
 		{
 			desc:     "fails on PaddingLeft too low",
 			termSize: image.Point{10, 10},
@@ -1784,6 +1801,67 @@ func TestKeyboard(t *testing.T) {
 	}
 }
 
+func TestEventErrorsDescribeWidget(t *testing.T) {
+	tests := []struct {
+		desc    string
+		opts    []Option
+		event   terminalapi.Event
+		wantErr string
+	}{
+		{
+			desc:    "keyboard error without container ID",
+			event:   &terminalapi.Keyboard{Key: keyboard.KeyEsc},
+			wantErr: "widget *fakewidget.Mirror failed to process the keyboard event: fakewidget received keyboard event: Keyboard{Key: KeyEsc}",
+		},
+		{
+			desc: "keyboard error with container ID",
+			opts: []Option{
+				ID("logs"),
+			},
+			event:   &terminalapi.Keyboard{Key: keyboard.KeyEsc},
+			wantErr: `widget *fakewidget.Mirror in container "logs" failed to process the keyboard event: fakewidget received keyboard event: Keyboard{Key: KeyEsc}`,
+		},
+		{
+			desc: "mouse error with container ID",
+			opts: []Option{
+				ID("logs"),
+			},
+			event:   &terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonRight},
+			wantErr: `widget *fakewidget.Mirror in container "logs" failed to process the mouse event: fakewidget received mouse event: Mouse{Position: (1,1), Button: ButtonRight}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ft, err := faketerm.New(image.Point{40, 20})
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+
+			opts := append(tc.opts, PlaceWidget(fakewidget.New(widgetapi.Options{
+				WantKeyboard: widgetapi.KeyScopeFocused,
+				WantMouse:    widgetapi.MouseScopeWidget,
+			})))
+			c, err := New(ft, opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			// Initial draw to determine sizes of containers.
+			if err := c.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			err = c.processEvent(tc.event)
+			if err == nil {
+				t.Fatalf("processEvent => got nil error, want %q", tc.wantErr)
+			}
+			if got := err.Error(); got != tc.wantErr {
+				t.Errorf("processEvent => got error %q, want %q", got, tc.wantErr)
+			}
+		})
+	}
+}
+
 func TestMouse(t *testing.T) {
 	tests := []struct {
 		desc      string
@@ -2280,6 +2358,48 @@ func TestMouse(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:     "MouseScopeWheel, only wheel events on the widget's canvas are forwarded",
+			termSize: image.Point{30, 20},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Light),
+					PlaceWidget(
+						fakewidget.New(widgetapi.Options{WantMouse: widgetapi.MouseScopeWheel}),
+					),
+				)
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{2, 2}, Button: mouse.ButtonWheelUp},
+				&terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonWheelDown},
+				&terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonRelease},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(
+					cvs,
+					ft.Area(),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+				testcanvas.MustApply(cvs, ft)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(1, 1, 29, 19)),
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget},
+					&fakewidget.Event{
+						Ev:   &terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonWheelUp},
+						Meta: &widgetapi.EventMeta{Focused: true},
+					},
+				)
+				return ft
+			},
+		},
 		{
 			desc:     "MouseScopeGlobal, event forwarded if it falls on the container's border",
 			termSize: image.Point{23, 20},
@@ -3157,3 +3277,771 @@ func TestUpdate(t *testing.T) {
 	}
 
 }
+
+func TestBroadcast(t *testing.T) {
+	left := fakewidget.New(widgetapi.Options{})
+	top := fakewidget.New(widgetapi.Options{})
+	bottom := fakewidget.New(widgetapi.Options{})
+
+	ft, err := faketerm.New(image.Point{10, 10})
+	if err != nil {
+		t.Fatalf("faketerm.New => unexpected error: %v", err)
+	}
+	cont, err := New(
+		ft,
+		SplitVertical(
+			Left(
+				PlaceWidget(left),
+			),
+			Right(
+				ID("right"),
+				SplitHorizontal(
+					Top(
+						PlaceWidget(top),
+					),
+					Bottom(
+						Border(linestyle.Light),
+						PlaceWidget(bottom),
+					),
+				),
+			),
+		),
+	)
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+
+	var got []widgetapi.Widget
+	cont.Broadcast(func(w widgetapi.Widget) {
+		got = append(got, w)
+		// Must not deadlock when the function calls back into the container.
+		if w == bottom {
+			if err := cont.Update("right", Clear()); err != nil {
+				t.Errorf("Update => unexpected error: %v", err)
+			}
+		}
+	})
+	want := []widgetapi.Widget{left, top, bottom}
+	if len(got) != len(want) {
+		t.Fatalf("Broadcast called the function %d times, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Broadcast => widget at index %d is %p, want %p", i, got[i], want[i])
+		}
+	}
+
+	got = nil
+	cont.Broadcast(func(w widgetapi.Widget) {
+		got = append(got, w)
+	})
+	if len(got) != 1 || got[0] != left {
+		t.Errorf("Broadcast after Update => got %v, want only the left widget", got)
+	}
+}
+
+func TestWidgetArea(t *testing.T) {
+	ft, err := faketerm.New(image.Point{20, 10})
+	if err != nil {
+		t.Fatalf("faketerm.New => unexpected error: %v", err)
+	}
+	cont, err := New(
+		ft,
+		ID("root"),
+		SplitVertical(
+			Left(
+				ID("left"),
+				PlaceWidget(fakewidget.New(widgetapi.Options{})),
+			),
+			Right(
+				ID("right"),
+				Border(linestyle.Light),
+				PlaceWidget(fakewidget.New(widgetapi.Options{})),
+			),
+		),
+	)
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+
+	if _, ok := cont.WidgetArea("left"); ok {
+		t.Errorf("WidgetArea before Draw => ok:true, want false")
+	}
+
+	if err := cont.Draw(); err != nil {
+		t.Fatalf("Draw => unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		id     string
+		want   image.Rectangle
+		wantOK bool
+	}{
+		{
+			id:     "left",
+			want:   image.Rect(0, 0, 10, 10),
+			wantOK: true,
+		},
+		{
+			id:     "right",
+			want:   image.Rect(11, 1, 19, 9),
+			wantOK: true,
+		},
+		{
+			id:   "root",
+			want: image.ZR,
+		},
+		{
+			id:   "unknown",
+			want: image.ZR,
+		},
+	}
+	for _, tc := range tests {
+		got, gotOK := cont.WidgetArea(tc.id)
+		if got != tc.want || gotOK != tc.wantOK {
+			t.Errorf("WidgetArea(%q) => %v, %v, want %v, %v", tc.id, got, gotOK, tc.want, tc.wantOK)
+		}
+	}
+}
+
+func TestSplitRespectsMinimumSize(t *testing.T) {
+	tests := []struct {
+		desc      string
+		size      image.Point
+		container func(ft *faketerm.Terminal) (*Container, error)
+		// want are the expected widget areas keyed by container IDs.
+		want map[string]image.Rectangle
+	}{
+		{
+			desc: "percentage split unchanged when minimums are satisfied",
+			size: image.Point{40, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(ID("left"), PlaceWidget(fakewidget.New(widgetapi.Options{MinimumSize: image.Point{5, 5}}))),
+						Right(ID("right"), PlaceWidget(fakewidget.New(widgetapi.Options{MinimumSize: image.Point{5, 5}}))),
+						SplitPercent(25),
+					),
+				)
+			},
+			want: map[string]image.Rectangle{
+				"left":  image.Rect(0, 0, 10, 10),
+				"right": image.Rect(10, 0, 40, 10),
+			},
+		},
+		{
+			desc: "grows the left container to its minimum",
+			size: image.Point{40, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(ID("left"), PlaceWidget(fakewidget.New(widgetapi.Options{MinimumSize: image.Point{15, 5}}))),
+						Right(ID("right"), PlaceWidget(fakewidget.New(widgetapi.Options{}))),
+						SplitPercent(25),
+					),
+				)
+			},
+			want: map[string]image.Rectangle{
+				"left":  image.Rect(0, 0, 15, 10),
+				"right": image.Rect(15, 0, 40, 10),
+			},
+		},
+		{
+			desc: "grows the right container to its minimum including border and padding",
+			size: image.Point{50, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(ID("left"), PlaceWidget(fakewidget.New(widgetapi.Options{}))),
+						Right(
+							ID("right"),
+							Border(linestyle.Light),
+							PaddingLeft(1),
+							PlaceWidget(fakewidget.New(widgetapi.Options{MinimumSize: image.Point{30, 5}})),
+						),
+						SplitPercentFromEnd(50),
+					),
+				)
+			},
+			want: map[string]image.Rectangle{
+				"left":  image.Rect(0, 0, 17, 10),
+				"right": image.Rect(19, 1, 49, 9),
+			},
+		},
+		{
+			desc: "grows the top container to the minimum of its sub containers",
+			size: image.Point{20, 20},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitHorizontal(
+						Top(
+							SplitHorizontal(
+								Top(ID("top1"), PlaceWidget(fakewidget.New(widgetapi.Options{MinimumSize: image.Point{5, 4}}))),
+								Bottom(ID("top2"), PlaceWidget(fakewidget.New(widgetapi.Options{MinimumSize: image.Point{5, 4}}))),
+							),
+						),
+						Bottom(ID("bottom"), PlaceWidget(fakewidget.New(widgetapi.Options{}))),
+						SplitPercent(20),
+					),
+				)
+			},
+			want: map[string]image.Rectangle{
+				"top1":   image.Rect(0, 0, 20, 4),
+				"top2":   image.Rect(0, 4, 20, 8),
+				"bottom": image.Rect(0, 8, 20, 20),
+			},
+		},
+		{
+			desc: "keeps the percentage split when both minimums can't be satisfied",
+			size: image.Point{40, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(ID("left"), PlaceWidget(fakewidget.New(widgetapi.Options{MinimumSize: image.Point{30, 5}}))),
+						Right(ID("right"), PlaceWidget(fakewidget.New(widgetapi.Options{MinimumSize: image.Point{20, 5}}))),
+						SplitPercent(25),
+					),
+				)
+			},
+			want: map[string]image.Rectangle{
+				// The left widget is too small to be drawn.
+				"right": image.Rect(10, 0, 40, 10),
+			},
+		},
+		{
+			desc: "fixed splits aren't clamped",
+			size: image.Point{40, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(ID("left"), PlaceWidget(fakewidget.New(widgetapi.Options{MinimumSize: image.Point{15, 5}}))),
+						Right(ID("right"), PlaceWidget(fakewidget.New(widgetapi.Options{}))),
+						SplitFixed(10),
+					),
+				)
+			},
+			want: map[string]image.Rectangle{
+				"right": image.Rect(10, 0, 40, 10),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ft, err := faketerm.New(tc.size)
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			cont, err := tc.container(ft)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			for _, id := range []string{"left", "right", "top1", "top2", "bottom"} {
+				got, _ := cont.WidgetArea(id)
+				if want := tc.want[id]; got != want {
+					t.Errorf("WidgetArea(%q) => %v, want %v", id, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSetSplitPercent(t *testing.T) {
+	tests := []struct {
+		desc      string
+		splitOpts []SplitOption
+		id        string
+		p         int
+		wantLeft  image.Rectangle
+		wantErr   bool
+	}{
+		{
+			desc:    "fails on unknown ID",
+			id:      "unknown",
+			p:       30,
+			wantErr: true,
+		},
+		{
+			desc:    "fails on a container that isn't split",
+			id:      "left",
+			p:       30,
+			wantErr: true,
+		},
+		{
+			desc:    "fails on percentage too low",
+			id:      "root",
+			p:       0,
+			wantErr: true,
+		},
+		{
+			desc:    "fails on percentage too high",
+			id:      "root",
+			p:       100,
+			wantErr: true,
+		},
+		{
+			desc:     "changes the split percentage",
+			id:       "root",
+			p:        25,
+			wantLeft: image.Rect(0, 0, 10, 10),
+		},
+		{
+			desc:      "replaces a split from the end",
+			splitOpts: []SplitOption{SplitPercentFromEnd(10)},
+			id:        "root",
+			p:         75,
+			wantLeft:  image.Rect(0, 0, 30, 10),
+		},
+		{
+			desc:      "replaces a fixed split",
+			splitOpts: []SplitOption{SplitFixed(5)},
+			id:        "root",
+			p:         75,
+			wantLeft:  image.Rect(0, 0, 30, 10),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ft, err := faketerm.New(image.Point{40, 10})
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			cont, err := New(
+				ft,
+				ID("root"),
+				SplitVertical(
+					Left(ID("left"), PlaceWidget(fakewidget.New(widgetapi.Options{}))),
+					Right(ID("right"), PlaceWidget(fakewidget.New(widgetapi.Options{}))),
+					tc.splitOpts...,
+				),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			err = cont.SetSplitPercent(tc.id, tc.p)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("SetSplitPercent => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+			if got, _ := cont.WidgetArea("left"); got != tc.wantLeft {
+				t.Errorf("WidgetArea(%q) => %v, want %v", "left", got, tc.wantLeft)
+			}
+		})
+	}
+}
+
+func TestGrid(t *testing.T) {
+	tests := []struct {
+		desc  string
+		size  image.Point
+		rows  int
+		cols  int
+		cells int
+		// wantAreas are the areas of all the leaf containers in pre-order.
+		wantAreas []image.Rectangle
+		// wantWidgets is the number of leaf containers with a widget.
+		wantWidgets int
+		wantErr     bool
+	}{
+		{
+			desc:    "fails on zero rows",
+			size:    image.Point{30, 10},
+			rows:    0,
+			cols:    1,
+			wantErr: true,
+		},
+		{
+			desc:    "fails on negative cols",
+			size:    image.Point{30, 10},
+			rows:    1,
+			cols:    -1,
+			wantErr: true,
+		},
+		{
+			desc:    "fails on too many cells",
+			size:    image.Point{30, 10},
+			rows:    1,
+			cols:    2,
+			cells:   3,
+			wantErr: true,
+		},
+		{
+			desc:  "single cell",
+			size:  image.Point{30, 10},
+			rows:  1,
+			cols:  1,
+			cells: 1,
+			wantAreas: []image.Rectangle{
+				image.Rect(0, 0, 30, 10),
+			},
+			wantWidgets: 1,
+		},
+		{
+			desc:  "divides the rows and columns evenly",
+			size:  image.Point{30, 10},
+			rows:  3,
+			cols:  3,
+			cells: 9,
+			wantAreas: []image.Rectangle{
+				image.Rect(0, 0, 10, 3),
+				image.Rect(10, 0, 20, 3),
+				image.Rect(20, 0, 30, 3),
+				image.Rect(0, 3, 10, 6),
+				image.Rect(10, 3, 20, 6),
+				image.Rect(20, 3, 30, 6),
+				image.Rect(0, 6, 10, 10),
+				image.Rect(10, 6, 20, 10),
+				image.Rect(20, 6, 30, 10),
+			},
+			wantWidgets: 9,
+		},
+		{
+			desc:  "leaves trailing cells empty",
+			size:  image.Point{30, 10},
+			rows:  2,
+			cols:  3,
+			cells: 4,
+			wantAreas: []image.Rectangle{
+				image.Rect(0, 0, 10, 5),
+				image.Rect(10, 0, 20, 5),
+				image.Rect(20, 0, 30, 5),
+				image.Rect(0, 5, 10, 10),
+				image.Rect(10, 5, 20, 10),
+				image.Rect(20, 5, 30, 10),
+			},
+			wantWidgets: 4,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ft, err := faketerm.New(tc.size)
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			var cells []Option
+			for i := 0; i < tc.cells; i++ {
+				cells = append(cells, PlaceWidget(fakewidget.New(widgetapi.Options{})))
+			}
+			cont, err := New(ft, Grid(tc.rows, tc.cols, cells...))
+			if (err != nil) != tc.wantErr {
+				t.Errorf("New => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			var (
+				errStr     string
+				gotAreas   []image.Rectangle
+				gotWidgets int
+			)
+			preOrder(cont, &errStr, visitFunc(func(c *Container) error {
+				if !c.isLeaf() {
+					return nil
+				}
+				gotAreas = append(gotAreas, c.area)
+				if c.hasWidget() {
+					gotWidgets++
+				}
+				return nil
+			}))
+			if diff := pretty.Compare(tc.wantAreas, gotAreas); diff != "" {
+				t.Errorf("leaf areas => unexpected diff (-want, +got):\n%s", diff)
+			}
+			if gotWidgets != tc.wantWidgets {
+				t.Errorf("leaf containers with widgets => %d, want %d", gotWidgets, tc.wantWidgets)
+			}
+		})
+	}
+}
+
+func TestSetVisible(t *testing.T) {
+	tests := []struct {
+		desc string
+		// hide are the IDs of containers hidden in this order.
+		hide []string
+		// show are the IDs of containers shown again after hiding.
+		show []string
+		// wantAreas are the areas of the drawn widgets, widgets that weren't
+		// drawn are omitted.
+		wantAreas map[string]image.Rectangle
+		// wantTargets is the number of widgets receiving events.
+		wantTargets int
+		// wantFocused is the ID of the focused container, the container
+		// "left" is focused initially.
+		wantFocused string
+		wantErr     bool
+	}{
+		{
+			desc:    "fails on unknown ID",
+			hide:    []string{"unknown"},
+			wantErr: true,
+		},
+		{
+			desc: "all containers visible",
+			wantAreas: map[string]image.Rectangle{
+				"left":   image.Rect(0, 0, 20, 10),
+				"top":    image.Rect(20, 0, 40, 5),
+				"bottom": image.Rect(20, 5, 40, 10),
+			},
+			wantTargets: 3,
+			wantFocused: "left",
+		},
+		{
+			desc: "the sibling of a hidden container takes all the area of the split",
+			hide: []string{"left"},
+			wantAreas: map[string]image.Rectangle{
+				"top":    image.Rect(0, 0, 40, 5),
+				"bottom": image.Rect(0, 5, 40, 10),
+			},
+			wantTargets: 2,
+			wantFocused: "root",
+		},
+		{
+			desc: "hides all the sub containers",
+			hide: []string{"right"},
+			wantAreas: map[string]image.Rectangle{
+				"left": image.Rect(0, 0, 40, 10),
+			},
+			wantTargets: 1,
+			wantFocused: "left",
+		},
+		{
+			desc: "leaves the area blank when both sides of a split are hidden",
+			hide: []string{"top", "bottom"},
+			wantAreas: map[string]image.Rectangle{
+				"left": image.Rect(0, 0, 20, 10),
+			},
+			wantTargets: 1,
+			wantFocused: "left",
+		},
+		{
+			desc: "showing a hidden container restores the split",
+			hide: []string{"left"},
+			show: []string{"left"},
+			wantAreas: map[string]image.Rectangle{
+				"left":   image.Rect(0, 0, 20, 10),
+				"top":    image.Rect(20, 0, 40, 5),
+				"bottom": image.Rect(20, 5, 40, 10),
+			},
+			wantTargets: 3,
+			wantFocused: "root",
+		},
+		{
+			desc:        "hides everything when the root is hidden",
+			hide:        []string{"root"},
+			wantAreas:   map[string]image.Rectangle{},
+			wantTargets: 0,
+			wantFocused: "left",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ft, err := faketerm.New(image.Point{40, 10})
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			wOpts := widgetapi.Options{
+				WantKeyboard: widgetapi.KeyScopeGlobal,
+				WantMouse:    widgetapi.MouseScopeGlobal,
+			}
+			cont, err := New(
+				ft,
+				ID("root"),
+				SplitVertical(
+					Left(ID("left"), PlaceWidget(fakewidget.New(wOpts))),
+					Right(
+						ID("right"),
+						SplitHorizontal(
+							Top(ID("top"), PlaceWidget(fakewidget.New(wOpts))),
+							Bottom(ID("bottom"), PlaceWidget(fakewidget.New(wOpts))),
+						),
+					),
+				),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			left, err := findID(cont, "left")
+			if err != nil {
+				t.Fatalf("findID => unexpected error: %v", err)
+			}
+			cont.focusTracker.setActive(left)
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			for _, id := range tc.hide {
+				err = cont.SetVisible(id, false)
+				if err != nil {
+					break
+				}
+			}
+			if err == nil {
+				for _, id := range tc.show {
+					if err = cont.SetVisible(id, true); err != nil {
+						break
+					}
+				}
+			}
+			if (err != nil) != tc.wantErr {
+				t.Errorf("SetVisible => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+			gotAreas := map[string]image.Rectangle{}
+			for _, id := range []string{"left", "top", "bottom"} {
+				if ar, ok := cont.WidgetArea(id); ok {
+					gotAreas[id] = ar
+				}
+			}
+			if diff := pretty.Compare(tc.wantAreas, gotAreas); diff != "" {
+				t.Errorf("WidgetArea => unexpected diff (-want, +got):\n%s", diff)
+			}
+
+			if got := len(cont.keyEvTargets()); got != tc.wantTargets {
+				t.Errorf("keyEvTargets => got %d targets, want %d", got, tc.wantTargets)
+			}
+			mouseTargets, err := cont.mouseEvTargets(&terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonLeft})
+			if err != nil {
+				t.Fatalf("mouseEvTargets => unexpected error: %v", err)
+			}
+			if got := len(mouseTargets); got != tc.wantTargets {
+				t.Errorf("mouseEvTargets => got %d targets, want %d", got, tc.wantTargets)
+			}
+
+			if got := cont.focusTracker.active().opts.id; got != tc.wantFocused {
+				t.Errorf("focused container => %q, want %q", got, tc.wantFocused)
+			}
+		})
+	}
+}
+
+func TestRedrawInterval(t *testing.T) {
+	tests := []struct {
+		desc      string
+		container func(ft *faketerm.Terminal) (*Container, error)
+		// hide is the ID of a container to hide before the call.
+		hide string
+		want time.Duration
+	}{
+		{
+			desc: "zero without widgets",
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(ft)
+			},
+		},
+		{
+			desc: "zero when the widget doesn't request an interval",
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					PlaceWidget(fakewidget.New(widgetapi.Options{})),
+				)
+			},
+		},
+		{
+			desc: "interval requested by the only widget",
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					PlaceWidget(fakewidget.New(widgetapi.Options{RedrawInterval: time.Second})),
+				)
+			},
+			want: time.Second,
+		},
+		{
+			desc: "the shortest of the requested intervals",
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(
+							PlaceWidget(fakewidget.New(widgetapi.Options{RedrawInterval: time.Second})),
+						),
+						Right(
+							SplitHorizontal(
+								Top(
+									PlaceWidget(fakewidget.New(widgetapi.Options{})),
+								),
+								Bottom(
+									PlaceWidget(fakewidget.New(widgetapi.Options{RedrawInterval: 100 * time.Millisecond})),
+								),
+							),
+						),
+					),
+				)
+			},
+			want: 100 * time.Millisecond,
+		},
+		{
+			desc: "ignores hidden widgets",
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(
+							PlaceWidget(fakewidget.New(widgetapi.Options{RedrawInterval: time.Second})),
+						),
+						Right(
+							ID("fast"),
+							PlaceWidget(fakewidget.New(widgetapi.Options{RedrawInterval: 100 * time.Millisecond})),
+						),
+					),
+				)
+			},
+			hide: "fast",
+			want: time.Second,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ft, err := faketerm.New(image.Point{40, 20})
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			c, err := tc.container(ft)
+			if err != nil {
+				t.Fatalf("tc.container => unexpected error: %v", err)
+			}
+			if tc.hide != "" {
+				if err := c.SetVisible(tc.hide, false); err != nil {
+					t.Fatalf("SetVisible => unexpected error: %v", err)
+				}
+			}
+
+			if got := c.RedrawInterval(); got != tc.want {
+				t.Errorf("RedrawInterval => %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -40,12 +40,12 @@ func drawTree(c *Container) error {
 	}
 	root.area = ar
 
-	preOrder(root, &errStr, visitFunc(func(c *Container) error {
+	preOrderVisible(root, &errStr, visitFunc(func(c *Container) error {
 		first, second, err := c.split()
 		if err != nil {
 			return err
 		}
-		if c.first != nil {
+		if c.first != nil && !c.first.hidden {
 			ar, err := c.first.opts.margin.apply(first)
 			if err != nil {
 				return err
@@ -53,7 +53,7 @@ func drawTree(c *Container) error {
 			c.first.area = ar
 		}
 
-		if c.second != nil {
+		if c.second != nil && !c.second.hidden {
 			ar, err := c.second.opts.margin.apply(second)
 			if err != nil {
 				return err
@@ -101,9 +101,11 @@ func drawBorder(c *Container) error {
 		}
 	}
 
+	rightCOpts := append(append([]cell.Option{}, titleCOpts...), c.opts.borderTitleRightCellOpts...)
 	if err := draw.Border(cvs, ar,
 		draw.BorderLineStyle(c.opts.border),
 		draw.BorderTitle(c.opts.borderTitle, draw.OverrunModeThreeDot, titleCOpts...),
+		draw.BorderTitleRight(c.opts.borderTitleRight, draw.OverrunModeThreeDot, rightCOpts...),
 		draw.BorderTitleAlign(c.opts.borderTitleHAlign),
 		draw.BorderCellOpts(cOpts...),
 	); err != nil {
@@ -112,6 +114,32 @@ func drawBorder(c *Container) error {
 	return cvs.Apply(c.term)
 }
 
+// drawBackground fills the area inside the container's border with the
+// background color if requested.
+func drawBackground(c *Container) error {
+	if c.opts.background == cell.ColorDefault {
+		return nil
+	}
+
+	cvs, err := canvas.New(c.usable())
+	if err != nil {
+		return err
+	}
+	if err := fillBackground(c, cvs); err != nil {
+		return err
+	}
+	return cvs.Apply(c.term)
+}
+
+// fillBackground sets the container's background color on all the cells of
+// the canvas, so that the cells the widget doesn't paint keep the background.
+func fillBackground(c *Container, cvs *canvas.Canvas) error {
+	if c.opts.background == cell.ColorDefault {
+		return nil
+	}
+	return cvs.SetAreaCellOpts(cvs.Area(), cell.BgColor(c.opts.background))
+}
+
 // drawWidget requests the widget to draw on the canvas.
 func drawWidget(c *Container) error {
 	widgetArea, err := c.widgetArea()
@@ -132,6 +160,14 @@ func drawWidget(c *Container) error {
 		needSize = wOpts.MinimumSize
 	}
 
+	meta := &widgetapi.Meta{
+		Focused: c.focusTracker.isActive(c),
+	}
+	c.scrollHeight = 0
+	if c.opts.scrollable && widgetArea.Dy() < needSize.Y {
+		return drawScrolled(c, widgetArea, needSize, meta)
+	}
+
 	if widgetArea.Dx() < needSize.X || widgetArea.Dy() < needSize.Y {
 		return drawResize(c, c.usable())
 	}
@@ -140,14 +176,97 @@ func drawWidget(c *Container) error {
 	if err != nil {
 		return err
 	}
-
-	meta := &widgetapi.Meta{
-		Focused: c.focusTracker.isActive(c),
+	if err := fillBackground(c, cvs); err != nil {
+		return err
 	}
 
 	if err := c.opts.widget.Draw(cvs, meta); err != nil {
 		return err
 	}
+	c.widgetDrawnArea = widgetArea
+	return cvs.Apply(c.term)
+}
+
+// scrollbarWidth is the width of the scrollbar of scrollable containers.
+const scrollbarWidth = 1
+
+// Runes used to draw the scrollbar.
+const (
+	scrollbarTrackRune = '│'
+	scrollbarThumbRune = '█'
+)
+
+// drawScrolled draws the widget onto a canvas as tall as the widget's minimum
+// height and displays the part of it selected by the scroll offset in the
+// widget area, next to a scrollbar.
+func drawScrolled(c *Container, widgetArea image.Rectangle, needSize image.Point, meta *widgetapi.Meta) error {
+	view := image.Rect(widgetArea.Min.X, widgetArea.Min.Y, widgetArea.Max.X-scrollbarWidth, widgetArea.Max.Y)
+	if view.Dx() < needSize.X || view.Dy() < 1 {
+		return drawResize(c, c.usable())
+	}
+	c.scrollHeight = needSize.Y
+	c.scrollView = view
+	c.clampScroll()
+
+	scrolled, err := canvas.New(image.Rect(0, 0, view.Dx(), needSize.Y))
+	if err != nil {
+		return err
+	}
+	if err := fillBackground(c, scrolled); err != nil {
+		return err
+	}
+	if err := c.opts.widget.Draw(scrolled, meta); err != nil {
+		return err
+	}
+
+	cvs, err := canvas.New(view)
+	if err != nil {
+		return err
+	}
+	region := image.Rect(0, c.scrollOffset, view.Dx(), c.scrollOffset+view.Dy())
+	if err := scrolled.CopyRegionTo(region, cvs); err != nil {
+		return err
+	}
+	if err := cvs.Apply(c.term); err != nil {
+		return err
+	}
+
+	if err := drawScrollbar(c, image.Rect(view.Max.X, view.Min.Y, widgetArea.Max.X, view.Max.Y)); err != nil {
+		return err
+	}
+	c.widgetDrawnArea = view
+	return nil
+}
+
+// drawScrollbar draws the scrollbar of a scrollable container.
+// The size and position of the thumb reflect the part of the scrolled content
+// that is visible.
+func drawScrollbar(c *Container, ar image.Rectangle) error {
+	cvs, err := canvas.New(ar)
+	if err != nil {
+		return err
+	}
+
+	height := ar.Dy()
+	thumb := height * height / c.scrollHeight
+	if thumb < 1 {
+		thumb = 1
+	}
+	var pos int
+	if maxOff := c.scrollHeight - height; maxOff > 0 {
+		pos = c.scrollOffset * (height - thumb) / maxOff
+	}
+
+	cOpts := cell.FgColor(c.opts.inherited.borderColor)
+	for y := 0; y < height; y++ {
+		r := scrollbarTrackRune
+		if y >= pos && y < pos+thumb {
+			r = scrollbarThumbRune
+		}
+		if _, err := cvs.SetCell(image.Point{0, y}, r, cOpts); err != nil {
+			return err
+		}
+	}
 	return cvs.Apply(c.term)
 }
 
@@ -170,6 +289,7 @@ func drawResize(c *Container, area image.Rectangle) error {
 
 // drawCont draws the container and its widget.
 func drawCont(c *Container) error {
+	c.widgetDrawnArea = image.ZR
 	if us := c.usable(); us.Dx() <= 0 || us.Dy() <= 0 {
 		return drawResize(c, c.area)
 	}
@@ -177,9 +297,12 @@ func drawCont(c *Container) error {
 	if err := drawBorder(c); err != nil {
 		return fmt.Errorf("unable to draw container border: %v", err)
 	}
+	if err := drawBackground(c); err != nil {
+		return fmt.Errorf("unable to draw container background: %v", err)
+	}
 
 	if err := drawWidget(c); err != nil {
-		return fmt.Errorf("unable to draw widget %T: %v", c.opts.widget, err)
+		return fmt.Errorf("unable to draw %s: %v", widgetDesc(c), err)
 	}
 	return nil
 }
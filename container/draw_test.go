@@ -20,12 +20,15 @@ import (
 
 	"github.com/woodliu/termdash/align"
 	"github.com/woodliu/termdash/cell"
+	"github.com/woodliu/termdash/keyboard"
 	"github.com/woodliu/termdash/linestyle"
+	"github.com/woodliu/termdash/mouse"
 	"github.com/woodliu/termdash/private/canvas/testcanvas"
 	"github.com/woodliu/termdash/private/draw"
 	"github.com/woodliu/termdash/private/draw/testdraw"
 	"github.com/woodliu/termdash/private/faketerm"
 	"github.com/woodliu/termdash/private/fakewidget"
+	"github.com/woodliu/termdash/terminal/terminalapi"
 	"github.com/woodliu/termdash/widgetapi"
 )
 
@@ -251,6 +254,64 @@ func TestDrawWidget(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:     "fills the background inside the border and padding",
+			termSize: image.Point{20, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Light),
+					Background(cell.ColorBlue),
+					PlaceWidget(fakewidget.New(widgetapi.Options{})),
+					PaddingTop(1),
+					PaddingRight(1),
+					PaddingBottom(1),
+					PaddingLeft(1),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				// Container border.
+				testdraw.MustBorder(
+					cvs,
+					cvs.Area(),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+				testcanvas.MustSetAreaCells(cvs, image.Rect(1, 1, 19, 9), 0, cell.BgColor(cell.ColorBlue))
+
+				wAr := image.Rect(2, 2, 18, 8)
+				wCvs := testcanvas.MustNew(wAr)
+				testcanvas.MustSetAreaCells(wCvs, wCvs.Area(), 0, cell.BgColor(cell.ColorBlue))
+				// Fake widget border.
+				fakewidget.MustDraw(ft, wCvs, &widgetapi.Meta{Focused: true}, widgetapi.Options{})
+				testcanvas.MustCopyTo(wCvs, cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "background of a split container shows through the margins",
+			termSize: image.Point{10, 4},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Background(cell.ColorRed),
+					SplitVertical(
+						Left(MarginRight(1)),
+						Right(Background(cell.ColorBlue)),
+					),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testcanvas.MustSetAreaCells(cvs, image.Rect(0, 0, 5, 4), 0, cell.BgColor(cell.ColorRed))
+				testcanvas.MustSetAreaCells(cvs, image.Rect(5, 0, 10, 4), 0, cell.BgColor(cell.ColorBlue))
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
 		{
 			desc:     "draws padded widget, relative padding",
 			termSize: image.Point{20, 20},
@@ -502,6 +563,38 @@ func TestDrawWidget(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:     "draws widget with container border and titles on both ends",
+			termSize: image.Point{12, 5},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Light),
+					BorderTitle("Logs"),
+					BorderTitleAlignLeft(),
+					BorderTitleRight("PAUSED", cell.FgColor(cell.ColorRed)),
+					PlaceWidget(fakewidget.New(widgetapi.Options{})),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				// Container border.
+				testdraw.MustBorder(
+					cvs,
+					cvs.Area(),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+				testdraw.MustText(cvs, "Logs", image.Point{1, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorYellow)))
+				testdraw.MustText(cvs, "PAUS…", image.Point{6, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorRed)))
+
+				// Fake widget border.
+				testdraw.MustBorder(cvs, image.Rect(1, 1, 11, 4))
+				testdraw.MustText(cvs, "(10,3)", image.Point{2, 2})
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
 		{
 			desc:     "draws widget with container border and title with different color and focus color",
 			termSize: image.Point{9, 5},
@@ -994,6 +1087,152 @@ func TestDrawWidget(t *testing.T) {
 					widgetapi.Options{},
 				)
 
+				testcanvas.MustCopyTo(wCvs, cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "widget's canvas is capped by MaxWidth and MaxHeight and centered",
+			termSize: image.Point{22, 22},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Light),
+					PlaceWidget(fakewidget.New(widgetapi.Options{})),
+					MaxWidth(10),
+					MaxHeight(4),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				// Container border.
+				testdraw.MustBorder(
+					cvs,
+					cvs.Area(),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+
+				// Fake widget border.
+				wCvs := testcanvas.MustNew(image.Rect(6, 9, 16, 13))
+				fakewidget.MustDraw(
+					ft,
+					wCvs,
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{},
+				)
+
+				testcanvas.MustCopyTo(wCvs, cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "MaxWidth caps the area inside of the padding",
+			termSize: image.Point{30, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Light),
+					PlaceWidget(fakewidget.New(widgetapi.Options{})),
+					PaddingLeft(4),
+					MaxWidth(10),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				// Container border.
+				testdraw.MustBorder(
+					cvs,
+					cvs.Area(),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+
+				// Fake widget border.
+				wCvs := testcanvas.MustNew(image.Rect(12, 1, 22, 9))
+				fakewidget.MustDraw(
+					ft,
+					wCvs,
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{},
+				)
+
+				testcanvas.MustCopyTo(wCvs, cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "MaxWidth respects the alignment",
+			termSize: image.Point{22, 22},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Light),
+					PlaceWidget(fakewidget.New(widgetapi.Options{})),
+					MaxWidth(10),
+					AlignHorizontal(align.HorizontalRight),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				// Container border.
+				testdraw.MustBorder(
+					cvs,
+					cvs.Area(),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+
+				// Fake widget border.
+				wCvs := testcanvas.MustNew(image.Rect(11, 1, 21, 21))
+				fakewidget.MustDraw(
+					ft,
+					wCvs,
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{},
+				)
+
+				testcanvas.MustCopyTo(wCvs, cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "the smaller of MaxWidth and the widget's maximum width applies",
+			termSize: image.Point{22, 22},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Light),
+					PlaceWidget(fakewidget.New(widgetapi.Options{
+						MaximumSize: image.Point{8, 0},
+					})),
+					MaxWidth(12),
+					AlignHorizontal(align.HorizontalLeft),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				// Container border.
+				testdraw.MustBorder(
+					cvs,
+					cvs.Area(),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+
+				// Fake widget border.
+				wCvs := testcanvas.MustNew(image.Rect(1, 1, 9, 21))
+				fakewidget.MustDraw(
+					ft,
+					wCvs,
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{},
+				)
+
 				testcanvas.MustCopyTo(wCvs, cvs)
 				testcanvas.MustApply(cvs, ft)
 				return ft
@@ -1183,3 +1422,197 @@ func TestDrawHandlesTerminalResize(t *testing.T) {
 		})
 	}
 }
+
+func TestDrawScrollable(t *testing.T) {
+	// scrolledWant returns the expected terminal with the content of the
+	// fake widget drawn on a 29x8 canvas, scrolled by offset lines into
+	// a 29x4 view and a scrollbar with the thumb at thumbPos.
+	scrolledWant := func(size image.Point, offset, thumbPos int, events ...*fakewidget.Event) *faketerm.Terminal {
+		ft := faketerm.MustNew(size)
+		scrolled := testcanvas.MustNew(image.Rect(0, 0, 29, 8))
+		fakewidget.MustDraw(
+			faketerm.MustNew(scrolled.Size()),
+			scrolled,
+			&widgetapi.Meta{Focused: true},
+			widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget},
+			events...,
+		)
+
+		view := testcanvas.MustNew(image.Rect(0, 0, 29, 4))
+		if err := scrolled.CopyRegionTo(image.Rect(0, offset, 29, offset+4), view); err != nil {
+			panic(err)
+		}
+		testcanvas.MustApply(view, ft)
+
+		bar := testcanvas.MustNew(image.Rect(29, 0, 30, 4))
+		for y := 0; y < 4; y++ {
+			r := '│'
+			if y >= thumbPos && y < thumbPos+2 {
+				r = '█'
+			}
+			testcanvas.MustSetCell(bar, image.Point{0, y}, r)
+		}
+		testcanvas.MustApply(bar, ft)
+		return ft
+	}
+
+	tests := []struct {
+		desc      string
+		termSize  image.Point
+		container func(ft *faketerm.Terminal) (*Container, error)
+		events    []terminalapi.Event
+		want      func(size image.Point) *faketerm.Terminal
+	}{
+		{
+			desc:     "draws resize needed when not scrollable",
+			termSize: image.Point{30, 4},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					PlaceWidget(fakewidget.New(widgetapi.Options{
+						MinimumSize: image.Point{5, 8},
+						WantMouse:   widgetapi.MouseScopeWidget,
+					})),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustResizeNeeded(cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "draws resize needed when too narrow for the widget and the scrollbar",
+			termSize: image.Point{5, 4},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Scrollable(),
+					PlaceWidget(fakewidget.New(widgetapi.Options{
+						MinimumSize: image.Point{5, 8},
+					})),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustResizeNeeded(cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "draws the top of the content with a scrollbar",
+			termSize: image.Point{30, 4},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Scrollable(),
+					PlaceWidget(fakewidget.New(widgetapi.Options{
+						MinimumSize: image.Point{5, 8},
+						WantMouse:   widgetapi.MouseScopeWidget,
+					})),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				return scrolledWant(size, 0, 0)
+			},
+		},
+		{
+			desc:     "scrolls using the mouse wheel",
+			termSize: image.Point{30, 4},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Scrollable(),
+					PlaceWidget(fakewidget.New(widgetapi.Options{
+						MinimumSize: image.Point{5, 8},
+						WantMouse:   widgetapi.MouseScopeWidget,
+					})),
+				)
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{29, 0}, Button: mouse.ButtonWheelDown},
+				&terminalapi.Mouse{Position: image.Point{29, 0}, Button: mouse.ButtonWheelDown},
+				&terminalapi.Mouse{Position: image.Point{29, 0}, Button: mouse.ButtonWheelDown},
+				&terminalapi.Mouse{Position: image.Point{29, 0}, Button: mouse.ButtonWheelUp},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				return scrolledWant(size, 2, 1)
+			},
+		},
+		{
+			desc:     "scrolls by pages using the keyboard and stops at the end",
+			termSize: image.Point{30, 4},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Scrollable(),
+					PlaceWidget(fakewidget.New(widgetapi.Options{
+						MinimumSize: image.Point{5, 8},
+						WantMouse:   widgetapi.MouseScopeWidget,
+					})),
+				)
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyPgDn},
+				&terminalapi.Keyboard{Key: keyboard.KeyPgDn},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				return scrolledWant(size, 4, 2)
+			},
+		},
+		{
+			desc:     "adjusts mouse events for the scrolled content",
+			termSize: image.Point{30, 4},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Scrollable(),
+					PlaceWidget(fakewidget.New(widgetapi.Options{
+						MinimumSize: image.Point{5, 8},
+						WantMouse:   widgetapi.MouseScopeWidget,
+					})),
+				)
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{29, 0}, Button: mouse.ButtonWheelDown},
+				&terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonLeft},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				return scrolledWant(size, 1, 0, &fakewidget.Event{
+					Ev:   &terminalapi.Mouse{Position: image.Point{1, 2}, Button: mouse.ButtonLeft},
+					Meta: &widgetapi.EventMeta{Focused: true},
+				})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := faketerm.MustNew(tc.termSize)
+			c, err := tc.container(got)
+			if err != nil {
+				t.Fatalf("tc.container => unexpected error: %v", err)
+			}
+			// Draw once, so the container knows the size of the content.
+			if err := c.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+			for _, ev := range tc.events {
+				if err := c.processEvent(ev); err != nil {
+					t.Fatalf("processEvent => unexpected error: %v", err)
+				}
+			}
+			if err := c.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			if diff := faketerm.Diff(tc.want(got.Size()), got); diff != "" {
+				t.Errorf("Draw => %v", diff)
+			}
+		})
+	}
+}
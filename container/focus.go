@@ -32,7 +32,7 @@ func pointCont(c *Container, p image.Point) *Container {
 		errStr string
 		cont   *Container
 	)
-	postOrder(rootCont(c), &errStr, visitFunc(func(c *Container) error {
+	postOrderVisible(rootCont(c), &errStr, visitFunc(func(c *Container) error {
 		if p.In(c.area) && cont == nil {
 			cont = c
 		}
@@ -86,14 +86,16 @@ func (ft *focusTracker) setActive(c *Container) {
 // next moves focus to the next container.
 // If group is not nil, focus will only move between containers with a matching
 // focus group number.
-func (ft *focusTracker) next(group *FocusGroup) {
+// If wrap is true, the focus moves from the last container to the first one,
+// otherwise it stays on the last container.
+func (ft *focusTracker) next(group *FocusGroup, wrap bool) {
 	var (
 		errStr    string
 		firstCont *Container
 		nextCont  *Container
 		focusNext bool
 	)
-	preOrder(rootCont(ft.container), &errStr, visitFunc(func(c *Container) error {
+	preOrderVisible(rootCont(ft.container), &errStr, visitFunc(func(c *Container) error {
 		if nextCont != nil {
 			// Already found the next container, nothing to do.
 			return nil
@@ -128,7 +130,7 @@ func (ft *focusTracker) next(group *FocusGroup) {
 		return nil
 	}))
 
-	if nextCont == nil && firstCont != nil {
+	if nextCont == nil && firstCont != nil && wrap {
 		// If the traversal finishes without finding the next container, move
 		// focus back to the first container.
 		ft.setActive(firstCont)
@@ -140,14 +142,16 @@ func (ft *focusTracker) next(group *FocusGroup) {
 // previous moves focus to the previous container.
 // If group is not nil, focus will only move between containers with a matching
 // focus group number.
-func (ft *focusTracker) previous(group *FocusGroup) {
+// If wrap is true, the focus moves from the first container to the last one,
+// otherwise it stays on the first container.
+func (ft *focusTracker) previous(group *FocusGroup, wrap bool) {
 	var (
 		errStr      string
 		prevCont    *Container
 		lastCont    *Container
 		visitedCurr bool
 	)
-	preOrder(rootCont(ft.container), &errStr, visitFunc(func(c *Container) error {
+	preOrderVisible(rootCont(ft.container), &errStr, visitFunc(func(c *Container) error {
 		if ft.container == c {
 			visitedCurr = true
 		}
@@ -170,7 +174,7 @@ func (ft *focusTracker) previous(group *FocusGroup) {
 
 	if prevCont != nil {
 		ft.setActive(prevCont)
-	} else if lastCont != nil {
+	} else if lastCont != nil && wrap {
 		ft.setActive(lastCont)
 	}
 }
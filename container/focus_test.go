@@ -1261,6 +1261,105 @@ func TestFocusTrackerNextAndPrevious(t *testing.T) {
 			wantFocused:   contLocC,
 			wantProcessed: 3,
 		},
+		{
+			desc: "without wrap around, next stops at the last container",
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(),
+						Right(),
+					),
+					KeyFocusNext(keyNext),
+					KeyFocusWrap(false),
+				)
+			},
+			events: []*terminalapi.Keyboard{
+				{Key: keyNext},
+				{Key: keyNext},
+				{Key: keyNext},
+			},
+			wantFocused:   contLocC,
+			wantProcessed: 3,
+		},
+		{
+			desc: "without wrap around, previous stops at the first container",
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(),
+						Right(Focused()),
+					),
+					KeyFocusPrevious(keyPrevious),
+					KeyFocusWrap(false),
+				)
+			},
+			events: []*terminalapi.Keyboard{
+				{Key: keyPrevious},
+				{Key: keyPrevious},
+			},
+			wantFocused:   contLocB,
+			wantProcessed: 2,
+		},
+		{
+			desc: "without wrap around, next stops at the last container that isn't skipped",
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(),
+						Right(
+							KeyFocusSkip(),
+						),
+					),
+					KeyFocusNext(keyNext),
+					KeyFocusWrap(false),
+				)
+			},
+			events: []*terminalapi.Keyboard{
+				{Key: keyNext},
+				{Key: keyNext},
+			},
+			wantFocused:   contLocB,
+			wantProcessed: 2,
+		},
+		{
+			desc:     "without wrap around, focus group keys stop at the ends of the group",
+			contSize: contSize5,
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New( // contLocA
+					ft,
+					SplitVertical(
+						Left( // contLocB
+							SplitVertical(
+								Left( // contLocD
+									KeyFocusGroups(1),
+								),
+								Right( // contLocE
+									KeyFocusGroups(1, 2),
+								),
+							),
+						),
+						Right( // contLocC
+							KeyFocusGroups(2),
+						),
+					),
+					KeyFocusGroupsNext('n', 1),
+					KeyFocusGroupsPrevious(keyboard.KeyArrowLeft, 2),
+					KeyFocusWrap(false),
+					KeyFocusNext(keyNext),
+				)
+			},
+			events: []*terminalapi.Keyboard{
+				{Key: keyNext},               // focuses contLocD
+				{Key: 'n'},                   // focuses contLocE
+				{Key: 'n'},                   // stays on contLocE, the last in group 1
+				{Key: keyboard.KeyArrowLeft}, // stays on contLocE, the first in group 2
+			},
+			wantFocused:   contLocE,
+			wantProcessed: 4,
+		},
 		{
 			desc:     "same key and group, first group takes priority, group 1 is first",
 			contSize: contSize5,
@@ -112,6 +112,11 @@ type options struct {
 	splitReversed bool
 	splitPercent  int
 	splitFixed    int
+	// splitParts and splitTotal size the first container to splitParts out
+	// of splitTotal equal parts of the available cells, if splitTotal is
+	// positive. Used by Grid.
+	splitParts int
+	splitTotal int
 
 	// widget is the widget in the container.
 	// A container can have either two sub containers (left and right) or a
@@ -122,11 +127,21 @@ type options struct {
 	hAlign align.Horizontal
 	vAlign align.Vertical
 
+	// maxWidth and maxHeight cap the size of the widget's area.
+	// Zero if the size isn't capped.
+	maxWidth  int
+	maxHeight int
+
 	// border is the border around the container.
 	border            linestyle.LineStyle
 	borderTitle       string
 	borderTitleHAlign align.Horizontal
 
+	// borderTitleRight is the second title displayed on the right end of the
+	// top border.
+	borderTitleRight         string
+	borderTitleRightCellOpts []cell.Option
+
 	// padding is a space reserved between the outer edge of the container and
 	// its content (the widget or other sub-containers).
 	padding padding
@@ -134,11 +149,19 @@ type options struct {
 	// margin is a space reserved on the outside of the container.
 	margin margin
 
+	// background is the color that fills the area inside the border.
+	// The area isn't filled if this is cell.ColorDefault.
+	background cell.Color
+
 	// keyFocusSkip asserts whether this container should be skipped when focus
 	// is being moved using either of KeyFocusNext or KeyFocusPrevious.
 	keyFocusSkip bool
 	// keyFocusGroups are the focus groups this container belongs to.
 	keyFocusGroups []FocusGroup
+
+	// scrollable asserts whether the widget in this container can be
+	// scrolled vertically when it doesn't fit.
+	scrollable bool
 }
 
 // margin stores the configured margin for the container.
@@ -234,6 +257,9 @@ type globalOptions struct {
 	// container within a focus group to the focus groups they should work on
 	// in the order they were configured.
 	keyFocusGroupsPrevious map[keyboard.Key]focusGroups
+	// keyFocusWrap asserts whether the keys that move the focus wrap around
+	// from the last container to the first one and vice versa.
+	keyFocusWrap bool
 }
 
 // newOptions returns a new options instance with the default values.
@@ -244,6 +270,7 @@ func newOptions(parent *options) *options {
 		global: &globalOptions{
 			keyFocusGroupsNext:     map[keyboard.Key]focusGroups{},
 			keyFocusGroupsPrevious: map[keyboard.Key]focusGroups{},
+			keyFocusWrap:           DefaultKeyFocusWrap,
 		},
 		inherited: inherited{
 			focusedColor: cell.ColorYellow,
@@ -300,6 +327,13 @@ const DefaultSplitFixed = -1
 // container, the new bottom container gets the reminder of the size.
 // The provided value must be a positive number in the range 0 < p < 100.
 // If not provided, defaults to DefaultSplitPercent.
+//
+// The split is clamped so that neither of the new containers is smaller than
+// the minimum size of the widgets placed in it, see
+// widgetapi.Options.MinimumSize. If the available space is too small to
+// satisfy the minimum sizes of both containers, the split is done according
+// to the percentage and the widgets that don't fit display a request to
+// resize the terminal.
 func SplitPercent(p int) SplitOption {
 	return splitOption(func(opts *options) error {
 		if min, max := 0, 100; p <= min || p >= max {
@@ -318,6 +352,7 @@ func SplitPercent(p int) SplitOption {
 // container, the new top container gets the reminder of the size.
 // The provided value must be a positive number in the range 0 < p < 100.
 // If not provided, defaults to using SplitPercent with DefaultSplitPercent.
+// The split is clamped to the minimum sizes the same way as with SplitPercent.
 func SplitPercentFromEnd(p int) SplitOption {
 	return splitOption(func(opts *options) error {
 		if min, max := 0, 100; p <= min || p >= max {
@@ -373,10 +408,13 @@ func SplitFixedFromEnd(cells int) SplitOption {
 // SplitVertical splits the container along the vertical axis into two sub
 // containers. The use of this option removes any widget placed at this
 // container, containers with sub containers cannot contain widgets.
+// Unless a fixed size is set using SplitFixed or SplitFixedFromEnd, the
+// split respects the minimum sizes of the widgets, see SplitPercent.
 func SplitVertical(l LeftOption, r RightOption, opts ...SplitOption) Option {
 	return option(func(c *Container) error {
 		c.opts.split = splitTypeVertical
 		c.opts.widget = nil
+		c.opts.splitTotal = 0
 		for _, opt := range opts {
 			if err := opt.setSplit(c.opts); err != nil {
 				return err
@@ -393,10 +431,13 @@ func SplitVertical(l LeftOption, r RightOption, opts ...SplitOption) Option {
 // SplitHorizontal splits the container along the horizontal axis into two sub
 // containers. The use of this option removes any widget placed at this
 // container, containers with sub containers cannot contain widgets.
+// Unless a fixed size is set using SplitFixed or SplitFixedFromEnd, the
+// split respects the minimum sizes of the widgets, see SplitPercent.
 func SplitHorizontal(t TopOption, b BottomOption, opts ...SplitOption) Option {
 	return option(func(c *Container) error {
 		c.opts.split = splitTypeHorizontal
 		c.opts.widget = nil
+		c.opts.splitTotal = 0
 		for _, opt := range opts {
 			if err := opt.setSplit(c.opts); err != nil {
 				return err
@@ -411,6 +452,66 @@ func SplitHorizontal(t TopOption, b BottomOption, opts ...SplitOption) Option {
 	})
 }
 
+// splitParts sizes the first container of a split to parts out of total
+// equal parts of the available cells.
+func splitParts(parts, total int) SplitOption {
+	return splitOption(func(opts *options) error {
+		opts.splitParts = parts
+		opts.splitTotal = total
+		return nil
+	})
+}
+
+// Grid lays out the provided cells in an evenly divided grid of rows and cols
+// sub containers. The cells flow left-to-right and top-to-bottom, i.e. the
+// first cell is placed in the top left container, the cols+1st cell at the
+// start of the second row. Each cell is an option applied to its container,
+// e.g. PlaceWidget. Fewer than rows*cols cells may be provided, the trailing
+// containers are then left empty.
+//
+// The containers are created by splitting this container with SplitHorizontal
+// and SplitVertical, the sizes of the rows and columns differ by at most one
+// cell. Both rows and cols must be positive.
+func Grid(rows, cols int, cells ...Option) Option {
+	return option(func(c *Container) error {
+		if rows < 1 || cols < 1 {
+			return fmt.Errorf("invalid grid of %d rows and %d cols, both must be positive", rows, cols)
+		}
+		if max := rows * cols; len(cells) > max {
+			return fmt.Errorf("got %d cells, a grid of %d rows and %d cols fits at most %d", len(cells), rows, cols, max)
+		}
+
+		rowOpts := evenSplit(rows, splitTypeHorizontal, func(row int) []Option {
+			return evenSplit(cols, splitTypeVertical, func(col int) []Option {
+				if i := row*cols + col; i < len(cells) {
+					return []Option{cells[i]}
+				}
+				return nil
+			})
+		})
+		return applyOptions(c, rowOpts...)
+	})
+}
+
+// evenSplit returns options that split a container into n equal parts along
+// the axis of the split type. The options of each part are returned by the
+// part function, which receives the index of the part.
+func evenSplit(n int, st splitType, part func(i int) []Option) []Option {
+	if n == 1 {
+		return part(0)
+	}
+
+	half := n / 2
+	first := evenSplit(half, st, part)
+	second := evenSplit(n-half, st, func(i int) []Option {
+		return part(half + i)
+	})
+	if st == splitTypeVertical {
+		return []Option{SplitVertical(Left(first...), Right(second...), splitParts(half, n))}
+	}
+	return []Option{SplitHorizontal(Top(first...), Bottom(second...), splitParts(half, n))}
+}
+
 // ID sets an identifier for this container.
 // This ID can be later used to perform dynamic layout changes by passing new
 // options to this container. When provided, it must be a non-empty string that
@@ -723,6 +824,9 @@ func PaddingLeftPercent(perc int) Option {
 
 // AlignHorizontal sets the horizontal alignment for the widget placed in the
 // container. Has no effect if the container contains no widget.
+// The alignment only matters when the widget's canvas is narrower than the
+// container, e.g. because of MaxWidth, the widget's MaximumSize or when the
+// widget requests a Ratio and its canvas is letterboxed.
 // Defaults to alignment in the center.
 func AlignHorizontal(h align.Horizontal) Option {
 	return option(func(c *Container) error {
@@ -733,6 +837,9 @@ func AlignHorizontal(h align.Horizontal) Option {
 
 // AlignVertical sets the vertical alignment for the widget placed in the container.
 // Has no effect if the container contains no widget.
+// The alignment only matters when the widget's canvas is shorter than the
+// container, e.g. because of MaxHeight, the widget's MaximumSize or when the
+// widget requests a Ratio and its canvas is letterboxed.
 // Defaults to alignment in the middle.
 func AlignVertical(v align.Vertical) Option {
 	return option(func(c *Container) error {
@@ -741,7 +848,44 @@ func AlignVertical(v align.Vertical) Option {
 	})
 }
 
+// MaxWidth caps the width of the area given to the widget placed in the
+// container to the specified number of cells. When the container is wider, the
+// widget's area is aligned within it according to AlignHorizontal, which
+// centers it by default. The cap applies to the area inside of the padding,
+// i.e. the padding is reserved first and the capped area is aligned within the
+// remaining space. If the widget also specifies MaximumSize, the smaller of
+// the two applies.
+// The provided number must be a positive integer. Has no effect if the
+// container contains no widget.
+func MaxWidth(cells int) Option {
+	return option(func(c *Container) error {
+		if min := 1; cells < min {
+			return fmt.Errorf("invalid MaxWidth(%d), must be in range %d <= value", cells, min)
+		}
+		c.opts.maxWidth = cells
+		return nil
+	})
+}
+
+// MaxHeight caps the height of the area given to the widget placed in the
+// container to the specified number of cells. When the container is taller,
+// the widget's area is aligned within it according to AlignVertical, which
+// places it in the middle by default. Interacts with the padding and the
+// widget's MaximumSize in the same way as MaxWidth.
+// The provided number must be a positive integer. Has no effect if the
+// container contains no widget.
+func MaxHeight(cells int) Option {
+	return option(func(c *Container) error {
+		if min := 1; cells < min {
+			return fmt.Errorf("invalid MaxHeight(%d), must be in range %d <= value", cells, min)
+		}
+		c.opts.maxHeight = cells
+		return nil
+	})
+}
+
 // Border configures the container to have a border of the specified style.
+// Use linestyle.Round for a border with rounded corners.
 func Border(ls linestyle.LineStyle) Option {
 	return option(func(c *Container) error {
 		c.opts.border = ls
@@ -757,6 +901,22 @@ func BorderTitle(title string) Option {
 	})
 }
 
+// BorderTitleRight sets a second text title displayed on the right end of the
+// top border, e.g. a status next to the title set with BorderTitle. The cell
+// options are applied on top of the colors of the title, see TitleColor and
+// TitleFocusedColor.
+// The title set with BorderTitle takes precedence when the space is tight,
+// the right title only gets the remaining space and is trimmed with an
+// ellipsis or omitted if it doesn't fit. Aligning the other title to the right
+// places it just before the right title.
+func BorderTitleRight(title string, opts ...cell.Option) Option {
+	return option(func(c *Container) error {
+		c.opts.borderTitleRight = title
+		c.opts.borderTitleRightCellOpts = opts
+		return nil
+	})
+}
+
 // BorderTitleAlignLeft aligns the border title on the left.
 func BorderTitleAlignLeft() Option {
 	return option(func(c *Container) error {
@@ -947,7 +1107,8 @@ func Bottom(opts ...Option) BottomOption {
 // Containers are organized in a binary tree, when the focus moves to the next
 // container, it targets the next leaf container in a DFS (Depth-first search) traversal.
 // Non-leaf containers are skipped. If the currently focused container is the
-// last container, the focus moves back to the first container.
+// last container, the focus moves back to the first container, unless
+// disabled with the KeyFocusWrap option.
 //
 // This option is global and applies to all created containers.
 // If neither of (KeyFocusNext, KeyFocusPrevious) is specified, the keyboard
@@ -965,7 +1126,8 @@ func KeyFocusNext(key keyboard.Key) Option {
 // Containers are organized in a binary tree, when the focus moves to the previous
 // container, it targets the previous leaf container in a DFS (Depth-first search) traversal.
 // Non-leaf containers are skipped. If the currently focused container is the
-// first container, the focus moves back to the last container.
+// first container, the focus moves back to the last container, unless
+// disabled with the KeyFocusWrap option.
 //
 // This option is global and applies to all created containers.
 // If neither of (KeyFocusNext, KeyFocusPrevious) is specified, the keyboard
@@ -977,6 +1139,29 @@ func KeyFocusPrevious(key keyboard.Key) Option {
 	})
 }
 
+// DefaultKeyFocusWrap is the default value for the KeyFocusWrap option.
+const DefaultKeyFocusWrap = true
+
+// KeyFocusWrap configures whether the keys that move the keyboard focus wrap
+// around. If true, moving the focus past the last container focuses the first
+// one and vice versa. If false, the focus stops at the first and the last
+// container, e.g. to prevent cycling through the fields of a form.
+//
+// The first and the last container are determined among the containers the
+// key moves the focus between, i.e. containers configured with KeyFocusSkip are
+// ignored by KeyFocusNext and KeyFocusPrevious and the keys configured by
+// KeyFocusGroupsNext and KeyFocusGroupsPrevious only consider the containers
+// in the focus group.
+//
+// This option is global and applies to all created containers.
+// Defaults to DefaultKeyFocusWrap.
+func KeyFocusWrap(wrap bool) Option {
+	return option(func(c *Container) error {
+		c.opts.global.keyFocusWrap = wrap
+		return nil
+	})
+}
+
 // KeyFocusSkip indicates that this container should never receive the keyboard
 // focus when KeyFocusNext or KeyFocusPrevious is pressed.
 //
@@ -990,6 +1175,38 @@ func KeyFocusSkip() Option {
 	})
 }
 
+// Scrollable makes the widget placed in this container vertically scrollable
+// when the container is shorter than the widget's minimum height, see
+// widgetapi.Options.MinimumSize. Instead of requesting a terminal resize, the
+// container then gives the widget a canvas as tall as its minimum height,
+// displays the part of it that fits and a scrollbar on its right edge.
+//
+// The content is scrolled by one line using the mouse wheel over the
+// container and by one page using the PgUp and PgDn keys while the container
+// is focused. These events are still delivered to the widget, the positions
+// of mouse events are adjusted for the scrolled content.
+//
+// Has no effect on containers that are split into sub containers.
+func Scrollable() Option {
+	return option(func(c *Container) error {
+		c.opts.scrollable = true
+		return nil
+	})
+}
+
+// Background fills the area of the container inside its border, including the
+// padding, with the background color before the widget is drawn. Cells the
+// widget doesn't paint keep the background, so widgets sit on a consistent
+// background. Useful to visually group regions of the dashboard.
+// The background isn't inherited by child containers, it shows through their
+// margins. Setting cell.ColorDefault removes the background.
+func Background(color cell.Color) Option {
+	return option(func(c *Container) error {
+		c.opts.background = color
+		return nil
+	})
+}
+
 // FocusGroup represents a group of containers that can have the keyboard focus
 // moved between them sharing the same keyboard key.
 type FocusGroup int
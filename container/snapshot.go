@@ -0,0 +1,148 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+// snapshot.go contains code that saves and restores the layout of the
+// container tree.
+
+import (
+	"errors"
+	"image"
+
+	"github.com/woodliu/termdash/cell"
+)
+
+// LayoutSnapshot is a saved copy of the layout of a container tree, i.e. the
+// splits, options and placed widgets of all the containers and the focused
+// container. See Container.Snapshot and Container.Restore.
+//
+// The widgets aren't copied, the snapshot refers to the same widget instances
+// as the container tree it was taken from.
+// The zero value is an empty snapshot that cannot be restored.
+type LayoutSnapshot struct {
+	// from is the root container the snapshot was taken from.
+	from *Container
+	// root is a detached copy of the container tree.
+	root *Container
+	// focused is the copy of the container that was focused.
+	focused *Container
+}
+
+// Snapshot returns a copy of the current layout of this container tree.
+// Later changes to the layout, e.g. using Update, don't affect the snapshot.
+// The snapshot can be restored with Restore to quickly switch between
+// multiple pre-built layouts.
+func (c *Container) Snapshot() LayoutSnapshot {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	root, focused := cloneTree(c, nil, c.focusTracker.active())
+	return LayoutSnapshot{
+		from:    c,
+		root:    root,
+		focused: focused,
+	}
+}
+
+// Restore replaces the layout of this container tree with the one saved in
+// the snapshot and moves the focus to the container that was focused when the
+// snapshot was taken. The snapshot remains valid and can be restored again.
+//
+// The snapshot must have been taken from this container by a call to
+// Snapshot. The change takes effect the next time the container is drawn.
+func (c *Container) Restore(ls LayoutSnapshot) error {
+	if ls.root == nil {
+		return errors.New("cannot restore an empty LayoutSnapshot")
+	}
+	if ls.from != c {
+		return errors.New("cannot restore a LayoutSnapshot taken from a different container")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	root, focused := cloneTree(ls.root, nil, ls.focused)
+	// Validate the copy before replacing the live tree, so that a failed
+	// restore leaves the layout unchanged.
+	if err := validateOptions(root); err != nil {
+		return err
+	}
+	c.opts = root.opts
+	c.hidden = root.hidden
+	c.scrollOffset = root.scrollOffset
+	c.first = root.first
+	c.second = root.second
+
+	var errStr string
+	preOrder(c, &errStr, visitFunc(func(cur *Container) error {
+		if cur.first != nil {
+			cur.first.parent = cur
+		}
+		if cur.second != nil {
+			cur.second.parent = cur
+		}
+		cur.term = c.term
+		cur.focusTracker = c.focusTracker
+		cur.mu = c.mu
+		cur.area = image.ZR
+		cur.widgetDrawnArea = image.ZR
+		return nil
+	}))
+
+	if focused == root {
+		focused = c
+	}
+	c.focusTracker.setActive(focused)
+	c.clearNeeded = true
+	return nil
+}
+
+// cloneTree returns a detached copy of the container tree rooted at src whose
+// root has the provided parent. Also returns the copy of the focused
+// container or nil if it isn't in the tree.
+// The copies share the widgets and the global options with the originals.
+func cloneTree(src, parent, focused *Container) (*Container, *Container) {
+	if src == nil {
+		return nil, nil
+	}
+
+	opts := *src.opts
+	opts.keyFocusGroups = append([]FocusGroup(nil), src.opts.keyFocusGroups...)
+	opts.borderTitleRightCellOpts = append([]cell.Option(nil), src.opts.borderTitleRightCellOpts...)
+	dst := &Container{
+		parent:       parent,
+		term:         src.term,
+		focusTracker: src.focusTracker,
+		opts:         &opts,
+		hidden:       src.hidden,
+		scrollOffset: src.scrollOffset,
+		mu:           src.mu,
+	}
+
+	var dstFocused *Container
+	if src == focused {
+		dstFocused = dst
+	}
+	var firstFocused, secondFocused *Container
+	dst.first, firstFocused = cloneTree(src.first, dst, focused)
+	dst.second, secondFocused = cloneTree(src.second, dst, focused)
+	switch {
+	case firstFocused != nil:
+		dstFocused = firstFocused
+	case secondFocused != nil:
+		dstFocused = secondFocused
+	}
+	return dst, dstFocused
+}
@@ -0,0 +1,225 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"image"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/woodliu/termdash/linestyle"
+	"github.com/woodliu/termdash/private/faketerm"
+	"github.com/woodliu/termdash/private/fakewidget"
+	"github.com/woodliu/termdash/widgetapi"
+)
+
+// widgetAreas returns the areas of the widgets in the containers with the
+// provided IDs, widgets that weren't drawn are omitted.
+func widgetAreas(c *Container, ids ...string) map[string]image.Rectangle {
+	got := map[string]image.Rectangle{}
+	for _, id := range ids {
+		if ar, ok := c.WidgetArea(id); ok {
+			got[id] = ar
+		}
+	}
+	return got
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	tests := []struct {
+		desc string
+		// before modifies the layout before the snapshot is taken.
+		before func(cont *Container) error
+		// change modifies the layout after the snapshot is taken.
+		change func(cont *Container) error
+		// restores is the number of times the snapshot is restored.
+		restores    int
+		wantAreas   map[string]image.Rectangle
+		wantFocused string
+	}{
+		{
+			desc:     "restores an unchanged layout",
+			restores: 1,
+			wantAreas: map[string]image.Rectangle{
+				"left":   image.Rect(0, 0, 20, 10),
+				"top":    image.Rect(20, 0, 40, 5),
+				"bottom": image.Rect(20, 5, 40, 10),
+			},
+			wantFocused: "left",
+		},
+		{
+			desc: "the snapshot isn't affected by later changes",
+			change: func(cont *Container) error {
+				if err := cont.Update("top", Border(linestyle.Light)); err != nil {
+					return err
+				}
+				return cont.SetSplitPercent("root", 80)
+			},
+			restores: 1,
+			wantAreas: map[string]image.Rectangle{
+				"left":   image.Rect(0, 0, 20, 10),
+				"top":    image.Rect(20, 0, 40, 5),
+				"bottom": image.Rect(20, 5, 40, 10),
+			},
+			wantFocused: "left",
+		},
+		{
+			desc: "restores a replaced layout and the focus",
+			change: func(cont *Container) error {
+				return cont.Update("root", PlaceWidget(fakewidget.New(widgetapi.Options{})))
+			},
+			restores: 2,
+			wantAreas: map[string]image.Rectangle{
+				"left":   image.Rect(0, 0, 20, 10),
+				"top":    image.Rect(20, 0, 40, 5),
+				"bottom": image.Rect(20, 5, 40, 10),
+			},
+			wantFocused: "left",
+		},
+		{
+			desc: "restores hidden containers",
+			before: func(cont *Container) error {
+				return cont.SetVisible("left", false)
+			},
+			change: func(cont *Container) error {
+				return cont.SetVisible("left", true)
+			},
+			restores: 1,
+			wantAreas: map[string]image.Rectangle{
+				"top":    image.Rect(0, 0, 40, 5),
+				"bottom": image.Rect(0, 5, 40, 10),
+			},
+			wantFocused: "root",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ft, err := faketerm.New(image.Point{40, 10})
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			cont, err := New(
+				ft,
+				ID("root"),
+				SplitVertical(
+					Left(ID("left"), PlaceWidget(fakewidget.New(widgetapi.Options{}))),
+					Right(
+						ID("right"),
+						SplitHorizontal(
+							Top(ID("top"), PlaceWidget(fakewidget.New(widgetapi.Options{}))),
+							Bottom(ID("bottom"), PlaceWidget(fakewidget.New(widgetapi.Options{}))),
+						),
+					),
+				),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			left, err := findID(cont, "left")
+			if err != nil {
+				t.Fatalf("findID => unexpected error: %v", err)
+			}
+			cont.focusTracker.setActive(left)
+
+			if tc.before != nil {
+				if err := tc.before(cont); err != nil {
+					t.Fatalf("before => unexpected error: %v", err)
+				}
+			}
+
+			ls := cont.Snapshot()
+			if tc.change != nil {
+				if err := tc.change(cont); err != nil {
+					t.Fatalf("change => unexpected error: %v", err)
+				}
+				cont.focusTracker.setActive(cont)
+			}
+			for i := 0; i < tc.restores; i++ {
+				if err := cont.Restore(ls); err != nil {
+					t.Fatalf("Restore => unexpected error: %v", err)
+				}
+			}
+
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+			got := widgetAreas(cont, "left", "top", "bottom")
+			if diff := pretty.Compare(tc.wantAreas, got); diff != "" {
+				t.Errorf("WidgetArea => unexpected diff (-want, +got):\n%s", diff)
+			}
+			if got := cont.focusTracker.active().opts.id; got != tc.wantFocused {
+				t.Errorf("focused container => %q, want %q", got, tc.wantFocused)
+			}
+			if !cont.focusTracker.reachableFrom(cont) {
+				t.Errorf("focused container isn't reachable from the root")
+			}
+		})
+	}
+}
+
+func TestRestoreFails(t *testing.T) {
+	ft, err := faketerm.New(image.Point{10, 10})
+	if err != nil {
+		t.Fatalf("faketerm.New => unexpected error: %v", err)
+	}
+	cont, err := New(ft)
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+	other, err := New(ft)
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+
+	if err := cont.Restore(LayoutSnapshot{}); err == nil {
+		t.Errorf("Restore(empty snapshot) => got nil err, want one")
+	}
+	if err := cont.Restore(other.Snapshot()); err == nil {
+		t.Errorf("Restore(snapshot from another container) => got nil err, want one")
+	}
+}
+
+func TestRestoreInvalidLeavesLayoutUnchanged(t *testing.T) {
+	ft, err := faketerm.New(image.Point{10, 10})
+	if err != nil {
+		t.Fatalf("faketerm.New => unexpected error: %v", err)
+	}
+	cont, err := New(
+		ft,
+		SplitVertical(
+			Left(ID("left")),
+			Right(ID("right")),
+		),
+	)
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+
+	ls := cont.Snapshot()
+	// Make the saved layout invalid by duplicating an ID.
+	ls.root.second.opts.id = "left"
+	first, second := cont.first, cont.second
+
+	if err := cont.Restore(ls); err == nil {
+		t.Fatalf("Restore(invalid snapshot) => got nil err, want one")
+	}
+	if cont.first != first || cont.second != second {
+		t.Errorf("Restore(invalid snapshot) => replaced the layout, want it unchanged")
+	}
+	if first.parent != cont || second.parent != cont {
+		t.Errorf("Restore(invalid snapshot) => re-parented the containers, want them unchanged")
+	}
+}
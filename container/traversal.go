@@ -62,6 +62,36 @@ func postOrder(c *Container, errStr *string, visit visitFunc) {
 	}
 }
 
+// preOrderVisible is like preOrder, but skips hidden containers and all of
+// their sub containers, see Container.SetVisible.
+func preOrderVisible(c *Container, errStr *string, visit visitFunc) {
+	if c == nil || c.hidden || *errStr != "" {
+		return
+	}
+
+	if err := visit(c); err != nil {
+		*errStr = err.Error()
+		return
+	}
+	preOrderVisible(c.first, errStr, visit)
+	preOrderVisible(c.second, errStr, visit)
+}
+
+// postOrderVisible is like postOrder, but skips hidden containers and all of
+// their sub containers, see Container.SetVisible.
+func postOrderVisible(c *Container, errStr *string, visit visitFunc) {
+	if c == nil || c.hidden || *errStr != "" {
+		return
+	}
+
+	postOrderVisible(c.first, errStr, visit)
+	postOrderVisible(c.second, errStr, visit)
+	if err := visit(c); err != nil {
+		*errStr = err.Error()
+		return
+	}
+}
+
 // findID finds container with the provided ID.
 // Returns an error of there is no container with the specified ID.
 func findID(root *Container, id string) (*Container, error) {
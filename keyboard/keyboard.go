@@ -90,6 +90,10 @@ var buttonNames = map[Key]string{
 	KeyCtrl7:      "KeyCtrl7",
 	KeySpace:      "KeySpace",
 	KeyBackspace2: "KeyBackspace2",
+
+	KeyCtrlArrowLeft:  "KeyCtrlArrowLeft",
+	KeyCtrlArrowRight: "KeyCtrlArrowRight",
+	KeyCtrlBackspace:  "KeyCtrlBackspace",
 }
 
 // Printable characters, but worth having constants for them.
@@ -155,6 +159,12 @@ const (
 	KeyCtrl6
 	KeyCtrl7
 	KeyBackspace2
+
+	// Keys pressed together with the Ctrl modifier. These are only reported
+	// by terminals that distinguish them from the keys without the modifier.
+	KeyCtrlArrowLeft
+	KeyCtrlArrowRight
+	KeyCtrlBackspace
 )
 
 // Keys declared as duplicates by termbox.
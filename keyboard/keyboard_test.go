@@ -47,3 +47,63 @@ func TestString(t *testing.T) {
 		})
 	}
 }
+
+func TestModifierString(t *testing.T) {
+	tests := []struct {
+		desc string
+		mod  Modifier
+		want string
+	}{
+		{
+			desc: "no modifiers",
+			mod:  ModNone,
+			want: "ModNone",
+		},
+		{
+			desc: "single modifier",
+			mod:  ModCtrl,
+			want: "ModCtrl",
+		},
+		{
+			desc: "multiple modifiers",
+			mod:  ModShift | ModAlt,
+			want: "ModShift|ModAlt",
+		},
+		{
+			desc: "unknown bits",
+			mod:  ModMeta | 1<<10,
+			want: "ModMeta|ModUnknown",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := tc.mod.String(); got != tc.want {
+				t.Errorf("String => %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestModifierHas(t *testing.T) {
+	mod := ModShift | ModCtrl
+	tests := []struct {
+		has  Modifier
+		want bool
+	}{
+		{ModShift, true},
+		{ModCtrl, true},
+		{ModShift | ModCtrl, true},
+		{ModAlt, false},
+		{ModCtrl | ModAlt, false},
+		{ModNone, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.has.String(), func(t *testing.T) {
+			if got := mod.Has(tc.has); got != tc.want {
+				t.Errorf("(%v).Has(%v) => %v, want %v", mod, tc.has, got, tc.want)
+			}
+		})
+	}
+}
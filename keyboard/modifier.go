@@ -0,0 +1,67 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyboard
+
+// modifier.go defines the modifier keys held while a key is pressed.
+
+import "strings"
+
+// Modifier is a bit mask of the modifier keys that were held while a key was
+// pressed. Not all terminals report modifiers, see the documentation of the
+// terminal implementations.
+type Modifier int
+
+// String implements fmt.Stringer()
+func (m Modifier) String() string {
+	if m == ModNone {
+		return "ModNone"
+	}
+
+	var names []string
+	for _, mod := range []Modifier{ModShift, ModCtrl, ModAlt, ModMeta} {
+		if m&mod != 0 {
+			names = append(names, modifierNames[mod])
+			m &^= mod
+		}
+	}
+	if m != 0 {
+		names = append(names, "ModUnknown")
+	}
+	return strings.Join(names, "|")
+}
+
+// modifierNames maps Modifier values to human readable names.
+var modifierNames = map[Modifier]string{
+	ModShift: "ModShift",
+	ModCtrl:  "ModCtrl",
+	ModAlt:   "ModAlt",
+	ModMeta:  "ModMeta",
+}
+
+// ModNone indicates that no modifier keys were held.
+const ModNone Modifier = 0
+
+// The supported modifier keys.
+const (
+	ModShift Modifier = 1 << iota
+	ModCtrl
+	ModAlt
+	ModMeta
+)
+
+// Has asserts whether all the provided modifiers are held.
+func (m Modifier) Has(mod Modifier) bool {
+	return m&mod == mod
+}
@@ -32,6 +32,8 @@ var lineStyleNames = map[LineStyle]string{
 	Light:  "LineStyleLight",
 	Double: "LineStyleDouble",
 	Round:  "LineStyleRound",
+	Dashed: "LineStyleDashed",
+	Dotted: "LineStyleDotted",
 }
 
 // Supported line styles.
@@ -48,4 +50,12 @@ const (
 
 	// Round is line style using the rounded corners '╭' characters.
 	Round
+
+	// Dashed is line style using the '─' characters separated by spaces.
+	// Corners and crossings of lines use the Light characters.
+	Dashed
+
+	// Dotted is line style using the '·' characters separated by spaces.
+	// Corners and crossings of lines use the Light characters.
+	Dotted
 )
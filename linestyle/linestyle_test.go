@@ -47,6 +47,16 @@ func TestLineStyleName(t *testing.T) {
 			ls:   Round,
 			want: "LineStyleRound",
 		},
+		{
+			desc: "dashed",
+			ls:   Dashed,
+			want: "LineStyleDashed",
+		},
+		{
+			desc: "dotted",
+			ls:   Dotted,
+			want: "LineStyleDotted",
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,262 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gesture synthesizes double-clicks and drags from raw mouse events.
+//
+// Terminals only report presses and releases of the mouse buttons. Widgets
+// that want higher level gestures can opt in by forwarding every mouse event
+// they receive to a Detector.
+package gesture
+
+import (
+	"fmt"
+	"image"
+	"time"
+
+	"github.com/woodliu/termdash/mouse"
+	"github.com/woodliu/termdash/terminal/terminalapi"
+)
+
+// Kind identifies the type of a gesture.
+type Kind int
+
+// String implements fmt.Stringer()
+func (k Kind) String() string {
+	if n, ok := kindNames[k]; ok {
+		return n
+	}
+	return "KindUnknown"
+}
+
+// kindNames maps Kind values to human readable names.
+var kindNames = map[Kind]string{
+	KindDoubleClick: "KindDoubleClick",
+	KindDrag:        "KindDrag",
+	KindDragEnd:     "KindDragEnd",
+}
+
+// Kinds of gestures recognized by the Detector.
+const (
+	kindUnknown Kind = iota
+
+	// KindDoubleClick is reported when a button is pressed for the second
+	// time at the same position within the double-click interval.
+	KindDoubleClick
+
+	// KindDrag is reported each time the mouse moves to a new position while
+	// a button is held down.
+	KindDrag
+
+	// KindDragEnd is reported when the button is released after a drag.
+	KindDragEnd
+)
+
+// Event is a gesture synthesized from mouse events.
+type Event struct {
+	// Kind is the kind of the gesture.
+	Kind Kind
+
+	// Button is the mouse button that performed the gesture.
+	Button mouse.Button
+
+	// Start is the position where the button was pressed.
+	Start image.Point
+
+	// Position is the current position of the mouse.
+	// Equal to Start for double-clicks.
+	Position image.Point
+}
+
+// String implements fmt.Stringer()
+func (e Event) String() string {
+	return fmt.Sprintf("Gesture{Kind: %v, Button: %v, Start: %v, Position: %v}", e.Kind, e.Button, e.Start, e.Position)
+}
+
+// Option is used to provide options to NewDetector.
+type Option interface {
+	// set sets the provided option.
+	set(*options)
+}
+
+// options stores the provided options.
+type options struct {
+	doubleClickInterval time.Duration
+}
+
+// validate validates the provided options.
+func (o *options) validate() error {
+	if min := time.Duration(0); o.doubleClickInterval <= min {
+		return fmt.Errorf("invalid DoubleClickInterval %v, must be a positive duration", o.doubleClickInterval)
+	}
+	return nil
+}
+
+// option implements Option.
+type option func(*options)
+
+// set implements Option.set.
+func (o option) set(opts *options) {
+	o(opts)
+}
+
+// DefaultDoubleClickInterval is the default value for the
+// DoubleClickInterval option.
+const DefaultDoubleClickInterval = 500 * time.Millisecond
+
+// DoubleClickInterval sets the maximum time between the release of the first
+// click and the press of the second click for the two to be recognized as a
+// double-click.
+// Defaults to DefaultDoubleClickInterval.
+func DoubleClickInterval(d time.Duration) Option {
+	return option(func(opts *options) {
+		opts.doubleClickInterval = d
+	})
+}
+
+// Detector recognizes gestures in a stream of mouse events.
+//
+// This object is not thread-safe.
+type Detector struct {
+	// opts are the provided options.
+	opts *options
+
+	// now returns the current time, overridden in tests.
+	now func() time.Time
+
+	// pressed indicates that a button is currently held down.
+	pressed bool
+	// button is the button held down.
+	button mouse.Button
+	// start is where the button was pressed.
+	start image.Point
+	// pos is the last known position of the held button.
+	pos image.Point
+	// dragging indicates that the mouse moved while the button was held.
+	dragging bool
+	// doubled indicates that the current press completed a double-click.
+	doubled bool
+
+	// lastClick is the time when the last click was released, zero if the
+	// next press cannot complete a double-click.
+	lastClick time.Time
+	// lastButton is the button of the last click.
+	lastButton mouse.Button
+	// lastPos is the position of the last click.
+	lastPos image.Point
+}
+
+// NewDetector returns a new gesture detector.
+func NewDetector(opts ...Option) (*Detector, error) {
+	o := &options{
+		doubleClickInterval: DefaultDoubleClickInterval,
+	}
+	for _, opt := range opts {
+		opt.set(o)
+	}
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	return &Detector{
+		opts: o,
+		now:  time.Now,
+	}, nil
+}
+
+// Event forwards a mouse event to the detector.
+// Returns the recognized gesture and true if the event completed one.
+func (d *Detector) Event(m *terminalapi.Mouse) (Event, bool) {
+	switch m.Button {
+	case mouse.ButtonLeft, mouse.ButtonRight, mouse.ButtonMiddle:
+		if d.pressed && m.Button == d.button {
+			return d.move(m.Position)
+		}
+		return d.press(m.Button, m.Position)
+
+	case mouse.ButtonRelease:
+		return d.release(m.Position)
+
+	default:
+		return Event{}, false
+	}
+}
+
+// press processes a press of a button that wasn't held down before.
+func (d *Detector) press(b mouse.Button, p image.Point) (Event, bool) {
+	d.pressed = true
+	d.button = b
+	d.start = p
+	d.pos = p
+	d.dragging = false
+	d.doubled = false
+
+	if d.lastClick.IsZero() || d.lastButton != b || d.lastPos != p {
+		return Event{}, false
+	}
+	if d.now().Sub(d.lastClick) > d.opts.doubleClickInterval {
+		return Event{}, false
+	}
+	d.lastClick = time.Time{}
+	d.doubled = true
+	return Event{
+		Kind:     KindDoubleClick,
+		Button:   b,
+		Start:    p,
+		Position: p,
+	}, true
+}
+
+// move processes an event reported while the button is held down.
+// Some terminals report the mouse moving with a held button as a series of
+// presses, one per position.
+func (d *Detector) move(p image.Point) (Event, bool) {
+	if p == d.pos {
+		return Event{}, false
+	}
+	d.pos = p
+	d.dragging = true
+	return Event{
+		Kind:     KindDrag,
+		Button:   d.button,
+		Start:    d.start,
+		Position: p,
+	}, true
+}
+
+// release processes a release of the held button.
+func (d *Detector) release(p image.Point) (Event, bool) {
+	if !d.pressed {
+		return Event{}, false
+	}
+	d.pressed = false
+
+	if d.dragging {
+		d.lastClick = time.Time{}
+		return Event{
+			Kind:     KindDragEnd,
+			Button:   d.button,
+			Start:    d.start,
+			Position: p,
+		}, true
+	}
+
+	if d.doubled {
+		// The second click of a double-click doesn't start another one.
+		d.lastClick = time.Time{}
+		return Event{}, false
+	}
+	d.lastClick = d.now()
+	d.lastButton = d.button
+	d.lastPos = d.start
+	return Event{}, false
+}
@@ -0,0 +1,242 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gesture
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/woodliu/termdash/mouse"
+	"github.com/woodliu/termdash/terminal/terminalapi"
+)
+
+// timedEvent is a mouse event delivered at a specific offset from the start
+// of the test.
+type timedEvent struct {
+	at time.Duration
+	m  *terminalapi.Mouse
+}
+
+func ev(at time.Duration, x, y int, b mouse.Button) timedEvent {
+	return timedEvent{
+		at: at,
+		m:  &terminalapi.Mouse{Position: image.Point{x, y}, Button: b},
+	}
+}
+
+func TestDetector(t *testing.T) {
+	tests := []struct {
+		desc    string
+		opts    []Option
+		events  []timedEvent
+		want    []Event
+		wantErr bool
+	}{
+		{
+			desc: "fails on invalid interval",
+			opts: []Option{
+				DoubleClickInterval(0),
+			},
+			wantErr: true,
+		},
+		{
+			desc: "single click isn't a gesture",
+			events: []timedEvent{
+				ev(0, 1, 1, mouse.ButtonLeft),
+				ev(0, 1, 1, mouse.ButtonRelease),
+			},
+		},
+		{
+			desc: "double-click within the interval",
+			events: []timedEvent{
+				ev(0, 1, 1, mouse.ButtonLeft),
+				ev(10*time.Millisecond, 1, 1, mouse.ButtonRelease),
+				ev(100*time.Millisecond, 1, 1, mouse.ButtonLeft),
+				ev(110*time.Millisecond, 1, 1, mouse.ButtonRelease),
+			},
+			want: []Event{
+				{Kind: KindDoubleClick, Button: mouse.ButtonLeft, Start: image.Point{1, 1}, Position: image.Point{1, 1}},
+			},
+		},
+		{
+			desc: "double-click with the right button",
+			events: []timedEvent{
+				ev(0, 1, 1, mouse.ButtonRight),
+				ev(0, 1, 1, mouse.ButtonRelease),
+				ev(0, 1, 1, mouse.ButtonRight),
+			},
+			want: []Event{
+				{Kind: KindDoubleClick, Button: mouse.ButtonRight, Start: image.Point{1, 1}, Position: image.Point{1, 1}},
+			},
+		},
+		{
+			desc: "clicks too far apart in time",
+			events: []timedEvent{
+				ev(0, 1, 1, mouse.ButtonLeft),
+				ev(0, 1, 1, mouse.ButtonRelease),
+				ev(DefaultDoubleClickInterval+time.Millisecond, 1, 1, mouse.ButtonLeft),
+			},
+		},
+		{
+			desc: "custom interval",
+			opts: []Option{
+				DoubleClickInterval(time.Second),
+			},
+			events: []timedEvent{
+				ev(0, 1, 1, mouse.ButtonLeft),
+				ev(0, 1, 1, mouse.ButtonRelease),
+				ev(time.Second, 1, 1, mouse.ButtonLeft),
+			},
+			want: []Event{
+				{Kind: KindDoubleClick, Button: mouse.ButtonLeft, Start: image.Point{1, 1}, Position: image.Point{1, 1}},
+			},
+		},
+		{
+			desc: "clicks at different positions",
+			events: []timedEvent{
+				ev(0, 1, 1, mouse.ButtonLeft),
+				ev(0, 1, 1, mouse.ButtonRelease),
+				ev(0, 2, 1, mouse.ButtonLeft),
+			},
+		},
+		{
+			desc: "clicks with different buttons",
+			events: []timedEvent{
+				ev(0, 1, 1, mouse.ButtonLeft),
+				ev(0, 1, 1, mouse.ButtonRelease),
+				ev(0, 1, 1, mouse.ButtonRight),
+			},
+		},
+		{
+			desc: "triple click is a single double-click",
+			events: []timedEvent{
+				ev(0, 1, 1, mouse.ButtonLeft),
+				ev(0, 1, 1, mouse.ButtonRelease),
+				ev(0, 1, 1, mouse.ButtonLeft),
+				ev(0, 1, 1, mouse.ButtonRelease),
+				ev(0, 1, 1, mouse.ButtonLeft),
+				ev(0, 1, 1, mouse.ButtonRelease),
+			},
+			want: []Event{
+				{Kind: KindDoubleClick, Button: mouse.ButtonLeft, Start: image.Point{1, 1}, Position: image.Point{1, 1}},
+			},
+		},
+		{
+			desc: "repeated presses at the same position aren't a drag",
+			events: []timedEvent{
+				ev(0, 1, 1, mouse.ButtonLeft),
+				ev(0, 1, 1, mouse.ButtonLeft),
+				ev(0, 1, 1, mouse.ButtonRelease),
+			},
+		},
+		{
+			desc: "drag reports start and current positions",
+			events: []timedEvent{
+				ev(0, 1, 1, mouse.ButtonLeft),
+				ev(0, 2, 1, mouse.ButtonLeft),
+				ev(0, 2, 1, mouse.ButtonLeft),
+				ev(0, 3, 2, mouse.ButtonLeft),
+				ev(0, 3, 2, mouse.ButtonRelease),
+			},
+			want: []Event{
+				{Kind: KindDrag, Button: mouse.ButtonLeft, Start: image.Point{1, 1}, Position: image.Point{2, 1}},
+				{Kind: KindDrag, Button: mouse.ButtonLeft, Start: image.Point{1, 1}, Position: image.Point{3, 2}},
+				{Kind: KindDragEnd, Button: mouse.ButtonLeft, Start: image.Point{1, 1}, Position: image.Point{3, 2}},
+			},
+		},
+		{
+			desc: "drag doesn't count as a click",
+			events: []timedEvent{
+				ev(0, 1, 1, mouse.ButtonLeft),
+				ev(0, 2, 1, mouse.ButtonLeft),
+				ev(0, 1, 1, mouse.ButtonLeft),
+				ev(0, 1, 1, mouse.ButtonRelease),
+				ev(0, 1, 1, mouse.ButtonLeft),
+			},
+			want: []Event{
+				{Kind: KindDrag, Button: mouse.ButtonLeft, Start: image.Point{1, 1}, Position: image.Point{2, 1}},
+				{Kind: KindDrag, Button: mouse.ButtonLeft, Start: image.Point{1, 1}, Position: image.Point{1, 1}},
+				{Kind: KindDragEnd, Button: mouse.ButtonLeft, Start: image.Point{1, 1}, Position: image.Point{1, 1}},
+			},
+		},
+		{
+			desc: "pressing another button starts a new press",
+			events: []timedEvent{
+				ev(0, 1, 1, mouse.ButtonLeft),
+				ev(0, 2, 1, mouse.ButtonRight),
+				ev(0, 3, 1, mouse.ButtonRight),
+				ev(0, 3, 1, mouse.ButtonRelease),
+			},
+			want: []Event{
+				{Kind: KindDrag, Button: mouse.ButtonRight, Start: image.Point{2, 1}, Position: image.Point{3, 1}},
+				{Kind: KindDragEnd, Button: mouse.ButtonRight, Start: image.Point{2, 1}, Position: image.Point{3, 1}},
+			},
+		},
+		{
+			desc: "ignores wheel and stray releases",
+			events: []timedEvent{
+				ev(0, 1, 1, mouse.ButtonRelease),
+				ev(0, 1, 1, mouse.ButtonWheelUp),
+				ev(0, 1, 1, mouse.ButtonWheelDown),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			d, err := NewDetector(tc.opts...)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("NewDetector => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			base := time.Unix(1000, 0)
+			var got []Event
+			for _, te := range tc.events {
+				d.now = func() time.Time { return base.Add(te.at) }
+				if g, ok := d.Event(te.m); ok {
+					got = append(got, g)
+				}
+			}
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("Event => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestKindString(t *testing.T) {
+	tests := []struct {
+		kind Kind
+		want string
+	}{
+		{Kind(-1), "KindUnknown"},
+		{KindDoubleClick, "KindDoubleClick"},
+		{KindDrag, "KindDrag"},
+		{KindDragEnd, "KindDragEnd"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.want, func(t *testing.T) {
+			if got := tc.kind.String(); got != tc.want {
+				t.Errorf("String => %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -35,6 +35,16 @@ type Canvas struct {
 
 	// buffer is where the drawing happens.
 	buffer buffer.Buffer
+
+	// cursor is the requested position of the terminal cursor relative to
+	// this canvas, nil if the cursor wasn't requested.
+	cursor *image.Point
+
+	// parent is the canvas this canvas is a view of, nil unless this canvas
+	// was created by SubCanvas.
+	parent *Canvas
+	// origin is the top left corner of this canvas on the parent canvas.
+	origin image.Point
 }
 
 // New returns a new Canvas with a buffer for the provided area.
@@ -53,6 +63,38 @@ func New(ar image.Rectangle) (*Canvas, error) {
 	}, nil
 }
 
+// SubCanvas returns a view of the provided area of this canvas. The area must
+// be non-empty and fall inside of this canvas. The returned canvas shares its
+// cells with this canvas and its coordinates are relative to the top left
+// corner of the area. Writes to cells that fall outside of the sub-canvas are
+// clipped, i.e. silently ignored instead of returning an error.
+func (c *Canvas) SubCanvas(ar image.Rectangle) (*Canvas, error) {
+	if ar.Empty() || !ar.In(c.Area()) {
+		return nil, fmt.Errorf("the sub-canvas area %v must be a non-empty area inside of the canvas area %v", ar, c.Area())
+	}
+
+	b := make(buffer.Buffer, ar.Dx())
+	for col := range b {
+		// Slicing shares the cells with this canvas.
+		b[col] = c.buffer[ar.Min.X+col][ar.Min.Y:ar.Max.Y]
+	}
+	return &Canvas{
+		area:   ar.Add(c.area.Min),
+		buffer: b,
+		parent: c,
+		origin: ar.Min,
+	}, nil
+}
+
+// clipped determines if writing the rune at the point falls outside of the
+// canvas and should be silently skipped. Only sub-canvases clip.
+func (c *Canvas) clipped(p image.Point, r rune) bool {
+	if c.parent == nil {
+		return false
+	}
+	return !p.In(c.Area()) || p.X+runewidth.RuneWidth(r) > c.Area().Max.X
+}
+
 // Size returns the size of the 2-D canvas.
 func (c *Canvas) Size() image.Point {
 	return c.buffer.Size()
@@ -65,21 +107,54 @@ func (c *Canvas) Area() image.Rectangle {
 }
 
 // Clear clears all the content on the canvas.
+// The cells are cleared in place, so that sub-canvases remain views of this
+// canvas.
 func (c *Canvas) Clear() error {
-	b, err := buffer.New(c.Size())
-	if err != nil {
-		return err
+	for col := range c.buffer {
+		for row := range c.buffer[col] {
+			c.buffer[col][row] = buffer.NewCell(0)
+		}
+	}
+	c.cursor = nil
+	return nil
+}
+
+// SetCursor requests the terminal cursor to be displayed at the specified
+// point of the canvas when the canvas is applied to the terminal.
+func (c *Canvas) SetCursor(p image.Point) error {
+	if ar := c.Area(); !p.In(ar) {
+		return fmt.Errorf("cursor point %v falls outside of the canvas area %v", p, ar)
+	}
+	c.cursor = &p
+	if c.parent != nil {
+		return c.parent.SetCursor(p.Add(c.origin))
 	}
-	c.buffer = b
 	return nil
 }
 
+// Cursor returns the point where the terminal cursor was requested by
+// SetCursor. The returned bool is false if the cursor wasn't requested.
+func (c *Canvas) Cursor() (image.Point, bool) {
+	if c.cursor == nil {
+		return image.Point{}, false
+	}
+	return *c.cursor, true
+}
+
 // SetCell sets the rune of the specified cell on the canvas. Returns the
 // number of cells the rune occupies, wide runes can occupy multiple cells when
 // printed on the terminal. See http://www.unicode.org/reports/tr11/.
 // Use the options to specify which attributes to modify, if an attribute
 // option isn't specified, the attribute retains its previous value.
+// On a sub-canvas, runes that don't fit are skipped and the number of cells
+// they would occupy is returned.
 func (c *Canvas) SetCell(p image.Point, r rune, opts ...cell.Option) (int, error) {
+	if c.clipped(p, r) {
+		if rw := runewidth.RuneWidth(r); rw > 1 {
+			return rw, nil
+		}
+		return 1, nil
+	}
 	return c.buffer.SetCell(p, r, opts...)
 }
 
@@ -101,6 +176,9 @@ func (c *Canvas) Cell(p image.Point) (*buffer.Cell, error) {
 // Sets the default cell options if no options are provided.
 // This method is idempotent.
 func (c *Canvas) SetCellOpts(p image.Point, opts ...cell.Option) error {
+	if c.clipped(p, 0) {
+		return nil
+	}
 	curCell, err := c.Cell(p)
 	if err != nil {
 		return err
@@ -124,6 +202,9 @@ func (c *Canvas) SetCellOpts(p image.Point, opts ...cell.Option) error {
 // This method is idempotent.
 func (c *Canvas) SetAreaCells(cellArea image.Rectangle, r rune, opts ...cell.Option) error {
 	haveArea := c.Area()
+	if c.parent != nil {
+		cellArea = cellArea.Intersect(haveArea)
+	}
 	if !cellArea.In(haveArea) {
 		return fmt.Errorf("unable to set cell runes in area %v, it must fit inside the available cell area is %v", cellArea, haveArea)
 	}
@@ -149,6 +230,9 @@ func (c *Canvas) SetAreaCells(cellArea image.Rectangle, r rune, opts ...cell.Opt
 // the cells within the provided area.
 func (c *Canvas) SetAreaCellOpts(cellArea image.Rectangle, opts ...cell.Option) error {
 	haveArea := c.Area()
+	if c.parent != nil {
+		cellArea = cellArea.Intersect(haveArea)
+	}
 	if !cellArea.In(haveArea) {
 		return fmt.Errorf("unable to set cell options in area %v, it must fit inside the available cell area is %v", cellArea, haveArea)
 	}
@@ -208,7 +292,13 @@ func (c *Canvas) Apply(t terminalapi.Terminal) error {
 	// image.Point{0, 0} on the terminal.
 	// Depends on area assigned by the container.
 	offset := c.area.Min
-	return c.copyTo(offset, t.SetCell)
+	if err := c.copyTo(offset, t.SetCell); err != nil {
+		return err
+	}
+	if c.cursor != nil {
+		t.SetCursor(c.cursor.Add(offset))
+	}
+	return nil
 }
 
 // CopyTo copies the content of this canvas onto the destination canvas.
@@ -236,5 +326,53 @@ func (c *Canvas) CopyTo(dst *Canvas) error {
 	// canvas. Copying this sub-canvas back onto the parent accounts for this
 	// offset.
 	offset := c.area.Min
-	return c.copyTo(offset, fn)
+	if err := c.copyTo(offset, fn); err != nil {
+		return err
+	}
+	if c.cursor != nil {
+		return dst.SetCursor(c.cursor.Add(offset))
+	}
+	return nil
+}
+
+// CopyRegionTo copies the content of a region of this canvas onto the
+// destination canvas. The region is zero-based relative to this canvas and its
+// top left corner is copied onto the top left corner of the destination
+// canvas. Useful to display a scrolled window of a larger canvas.
+// Parts of the region that don't fit onto the destination canvas are skipped,
+// including full-width runes that would only partially fit.
+func (c *Canvas) CopyRegionTo(region image.Rectangle, dst *Canvas) error {
+	cvsAr, err := area.FromSize(c.Size())
+	if err != nil {
+		return err
+	}
+	if !region.In(cvsAr) {
+		return fmt.Errorf("the region %v doesn't fit into the canvas area %v", region, cvsAr)
+	}
+	dstAr, err := area.FromSize(dst.Size())
+	if err != nil {
+		return err
+	}
+
+	fn := setCellFunc(func(p image.Point, r rune, opts ...cell.Option) error {
+		if !p.Add(region.Min).In(region) || !p.In(dstAr) {
+			return nil
+		}
+		if p.X+runewidth.RuneWidth(r) > dstAr.Max.X {
+			return nil
+		}
+		if _, err := dst.SetCell(p, r, opts...); err != nil {
+			return fmt.Errorf("dst.SetCell => %v", err)
+		}
+		return nil
+	})
+	if err := c.copyTo(image.Point{}.Sub(region.Min), fn); err != nil {
+		return err
+	}
+	if c.cursor != nil && c.cursor.In(region) {
+		if p := c.cursor.Sub(region.Min); p.In(dstAr) {
+			return dst.SetCursor(p)
+		}
+	}
+	return nil
 }
@@ -15,6 +15,7 @@
 package canvas
 
 import (
+	"fmt"
 	"image"
 	"testing"
 
@@ -1154,3 +1155,351 @@ func TestCopyTo(t *testing.T) {
 		})
 	}
 }
+
+func TestCopyRegionTo(t *testing.T) {
+	tests := []struct {
+		desc    string
+		src     *Canvas
+		region  image.Rectangle
+		dst     *Canvas
+		want    *Canvas
+		wantErr bool
+	}{
+		{
+			desc:    "fails when the region falls outside of the canvas",
+			src:     mustNew(image.Rect(0, 0, 3, 3)),
+			region:  image.Rect(0, 1, 3, 4),
+			dst:     mustNew(image.Rect(0, 0, 3, 3)),
+			wantErr: true,
+		},
+		{
+			desc: "copies a region onto the top left corner",
+			src: func() *Canvas {
+				c := mustNew(image.Rect(0, 0, 3, 4))
+				mustSetCell(c, image.Point{0, 0}, 'A')
+				mustSetCell(c, image.Point{1, 2}, 'B', cell.FgColor(cell.ColorRed))
+				mustSetCell(c, image.Point{2, 3}, 'C')
+				return c
+			}(),
+			region: image.Rect(0, 2, 3, 4),
+			dst:    mustNew(image.Rect(2, 2, 5, 4)),
+			want: func() *Canvas {
+				c := mustNew(image.Rect(2, 2, 5, 4))
+				mustSetCell(c, image.Point{1, 0}, 'B', cell.FgColor(cell.ColorRed))
+				mustSetCell(c, image.Point{2, 1}, 'C')
+				return c
+			}(),
+		},
+		{
+			desc: "skips parts that don't fit onto the destination",
+			src: func() *Canvas {
+				c := mustNew(image.Rect(0, 0, 4, 2))
+				mustSetCell(c, image.Point{0, 0}, 'A')
+				mustSetCell(c, image.Point{1, 1}, '界')
+				mustSetCell(c, image.Point{3, 0}, 'B')
+				return c
+			}(),
+			region: image.Rect(0, 0, 4, 2),
+			dst:    mustNew(image.Rect(0, 0, 2, 2)),
+			want: func() *Canvas {
+				c := mustNew(image.Rect(0, 0, 2, 2))
+				mustSetCell(c, image.Point{0, 0}, 'A')
+				return c
+			}(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.src.CopyRegionTo(tc.region, tc.dst)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("CopyRegionTo => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			ftSize := image.Point{10, 10}
+			got, err := faketerm.New(ftSize)
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			if err := tc.dst.Apply(got); err != nil {
+				t.Fatalf("tc.dst.Apply => unexpected error: %v", err)
+			}
+
+			want, err := faketerm.New(ftSize)
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			if err := tc.want.Apply(want); err != nil {
+				t.Fatalf("tc.want.Apply => unexpected error: %v", err)
+			}
+
+			if diff := faketerm.Diff(want, got); diff != "" {
+				t.Errorf("CopyRegionTo => %v", diff)
+			}
+		})
+	}
+}
+
+func TestCursor(t *testing.T) {
+	t.Run("fails when the cursor falls outside of the canvas", func(t *testing.T) {
+		c := mustNew(image.Rect(1, 1, 3, 3))
+		if err := c.SetCursor(image.Point{2, 0}); err == nil {
+			t.Errorf("SetCursor => got nil err, want an error")
+		}
+		if _, ok := c.Cursor(); ok {
+			t.Errorf("Cursor => got ok true, want false")
+		}
+	})
+
+	t.Run("apply sets the cursor on the terminal", func(t *testing.T) {
+		ft := faketerm.MustNew(image.Point{5, 5})
+		c := mustNew(image.Rect(1, 2, 3, 4))
+		if err := c.Apply(ft); err != nil {
+			t.Fatalf("Apply => unexpected error: %v", err)
+		}
+		if p, ok := ft.Cursor(); ok {
+			t.Errorf("Cursor => %v, want the cursor hidden", p)
+		}
+
+		if err := c.SetCursor(image.Point{1, 0}); err != nil {
+			t.Fatalf("SetCursor => unexpected error: %v", err)
+		}
+		if err := c.Apply(ft); err != nil {
+			t.Fatalf("Apply => unexpected error: %v", err)
+		}
+		want := image.Point{2, 2}
+		if p, ok := ft.Cursor(); !ok || !p.Eq(want) {
+			t.Errorf("Cursor => %v, %v, want %v, true", p, ok, want)
+		}
+	})
+
+	t.Run("clear removes the cursor", func(t *testing.T) {
+		c := mustNew(image.Rect(0, 0, 2, 2))
+		if err := c.SetCursor(image.Point{1, 1}); err != nil {
+			t.Fatalf("SetCursor => unexpected error: %v", err)
+		}
+		if err := c.Clear(); err != nil {
+			t.Fatalf("Clear => unexpected error: %v", err)
+		}
+		if p, ok := c.Cursor(); ok {
+			t.Errorf("Cursor => %v, want no cursor", p)
+		}
+	})
+
+	t.Run("copy to offsets the cursor", func(t *testing.T) {
+		src := mustNew(image.Rect(1, 1, 3, 3))
+		if err := src.SetCursor(image.Point{1, 0}); err != nil {
+			t.Fatalf("SetCursor => unexpected error: %v", err)
+		}
+		dst := mustNew(image.Rect(0, 0, 4, 4))
+		if err := src.CopyTo(dst); err != nil {
+			t.Fatalf("CopyTo => unexpected error: %v", err)
+		}
+		want := image.Point{2, 1}
+		if p, ok := dst.Cursor(); !ok || !p.Eq(want) {
+			t.Errorf("Cursor => %v, %v, want %v, true", p, ok, want)
+		}
+	})
+
+	t.Run("copy region to copies the cursor inside the region", func(t *testing.T) {
+		src := mustNew(image.Rect(0, 0, 2, 4))
+		if err := src.SetCursor(image.Point{1, 3}); err != nil {
+			t.Fatalf("SetCursor => unexpected error: %v", err)
+		}
+		dst := mustNew(image.Rect(0, 0, 2, 2))
+		if err := src.CopyRegionTo(image.Rect(0, 2, 2, 4), dst); err != nil {
+			t.Fatalf("CopyRegionTo => unexpected error: %v", err)
+		}
+		want := image.Point{1, 1}
+		if p, ok := dst.Cursor(); !ok || !p.Eq(want) {
+			t.Errorf("Cursor => %v, %v, want %v, true", p, ok, want)
+		}
+	})
+
+	t.Run("copy region to skips the cursor outside the region", func(t *testing.T) {
+		src := mustNew(image.Rect(0, 0, 2, 4))
+		if err := src.SetCursor(image.Point{1, 0}); err != nil {
+			t.Fatalf("SetCursor => unexpected error: %v", err)
+		}
+		dst := mustNew(image.Rect(0, 0, 2, 2))
+		if err := src.CopyRegionTo(image.Rect(0, 2, 2, 4), dst); err != nil {
+			t.Fatalf("CopyRegionTo => unexpected error: %v", err)
+		}
+		if p, ok := dst.Cursor(); ok {
+			t.Errorf("Cursor => %v, want no cursor", p)
+		}
+	})
+}
+
+func TestSubCanvas(t *testing.T) {
+	tests := []struct {
+		desc string
+		// parent is the canvas the sub-canvas is created from.
+		parent *Canvas
+		ar     image.Rectangle
+		// draw draws on the sub-canvas.
+		draw    func(sub *Canvas) error
+		want    func() *Canvas
+		wantErr bool
+	}{
+		{
+			desc:    "fails when the area falls outside of the canvas",
+			parent:  mustNew(image.Rect(0, 0, 3, 3)),
+			ar:      image.Rect(1, 1, 4, 3),
+			wantErr: true,
+		},
+		{
+			desc:    "fails on an empty area",
+			parent:  mustNew(image.Rect(0, 0, 3, 3)),
+			ar:      image.Rect(1, 1, 1, 3),
+			wantErr: true,
+		},
+		{
+			desc:   "coordinates are relative to the sub-canvas",
+			parent: mustNew(image.Rect(1, 1, 6, 4)),
+			ar:     image.Rect(1, 1, 4, 3),
+			draw: func(sub *Canvas) error {
+				if got, want := sub.Size(), (image.Point{3, 2}); !got.Eq(want) {
+					return fmt.Errorf("Size => %v, want %v", got, want)
+				}
+				if _, err := sub.SetCell(image.Point{0, 0}, 'A', cell.FgColor(cell.ColorRed)); err != nil {
+					return err
+				}
+				_, err := sub.SetCell(image.Point{2, 1}, 'B')
+				return err
+			},
+			want: func() *Canvas {
+				c := mustNew(image.Rect(1, 1, 6, 4))
+				mustSetCell(c, image.Point{1, 1}, 'A', cell.FgColor(cell.ColorRed))
+				mustSetCell(c, image.Point{3, 2}, 'B')
+				return c
+			},
+		},
+		{
+			desc:   "clips cells outside of the sub-canvas",
+			parent: mustNew(image.Rect(0, 0, 5, 3)),
+			ar:     image.Rect(1, 1, 3, 2),
+			draw: func(sub *Canvas) error {
+				for _, p := range []image.Point{{-1, 0}, {2, 0}, {0, 1}} {
+					cells, err := sub.SetCell(p, 'X')
+					if err != nil {
+						return err
+					}
+					if cells != 1 {
+						return fmt.Errorf("SetCell(%v) => %d cells, want 1", p, cells)
+					}
+				}
+				// Only half of the full-width rune would fit.
+				if _, err := sub.SetCell(image.Point{1, 0}, '界'); err != nil {
+					return err
+				}
+				if err := sub.SetCellOpts(image.Point{3, 3}, cell.Bold()); err != nil {
+					return err
+				}
+				return sub.SetAreaCells(image.Rect(0, 0, 5, 5), 'Y')
+			},
+			want: func() *Canvas {
+				c := mustNew(image.Rect(0, 0, 5, 3))
+				mustSetCell(c, image.Point{1, 1}, 'Y')
+				mustSetCell(c, image.Point{2, 1}, 'Y')
+				return c
+			},
+		},
+		{
+			desc: "clear only clears the sub-canvas",
+			parent: func() *Canvas {
+				c := mustNew(image.Rect(0, 0, 3, 1))
+				mustSetCell(c, image.Point{0, 0}, 'A')
+				mustSetCell(c, image.Point{1, 0}, 'B')
+				mustSetCell(c, image.Point{2, 0}, 'C')
+				return c
+			}(),
+			ar: image.Rect(1, 0, 2, 1),
+			draw: func(sub *Canvas) error {
+				return sub.Clear()
+			},
+			want: func() *Canvas {
+				c := mustNew(image.Rect(0, 0, 3, 1))
+				mustSetCell(c, image.Point{0, 0}, 'A')
+				mustSetCell(c, image.Point{2, 0}, 'C')
+				return c
+			},
+		},
+		{
+			desc:   "sets the cursor on the parent",
+			parent: mustNew(image.Rect(0, 0, 4, 4)),
+			ar:     image.Rect(1, 2, 3, 4),
+			draw: func(sub *Canvas) error {
+				return sub.SetCursor(image.Point{1, 0})
+			},
+			want: func() *Canvas {
+				c := mustNew(image.Rect(0, 0, 4, 4))
+				if err := c.SetCursor(image.Point{2, 2}); err != nil {
+					panic(err)
+				}
+				return c
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			sub, err := tc.parent.SubCanvas(tc.ar)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("SubCanvas => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if tc.draw != nil {
+				if err := tc.draw(sub); err != nil {
+					t.Fatalf("draw => unexpected error: %v", err)
+				}
+			}
+
+			ftSize := image.Point{10, 10}
+			got := faketerm.MustNew(ftSize)
+			if err := tc.parent.Apply(got); err != nil {
+				t.Fatalf("Apply => unexpected error: %v", err)
+			}
+			want := faketerm.MustNew(ftSize)
+			if err := tc.want().Apply(want); err != nil {
+				t.Fatalf("Apply => unexpected error: %v", err)
+			}
+			if diff := faketerm.Diff(want, got); diff != "" {
+				t.Errorf("SubCanvas => %v", diff)
+			}
+			gotCur, gotOK := got.Cursor()
+			wantCur, wantOK := want.Cursor()
+			if gotOK != wantOK || !gotCur.Eq(wantCur) {
+				t.Errorf("Cursor => %v, %v, want %v, %v", gotCur, gotOK, wantCur, wantOK)
+			}
+		})
+	}
+}
+
+func TestSubCanvasApply(t *testing.T) {
+	parent := mustNew(image.Rect(1, 1, 5, 5))
+	sub, err := parent.SubCanvas(image.Rect(1, 2, 3, 3))
+	if err != nil {
+		t.Fatalf("SubCanvas => unexpected error: %v", err)
+	}
+	mustSetCell(sub, image.Point{1, 0}, 'A')
+
+	got := faketerm.MustNew(image.Point{6, 6})
+	if err := sub.Apply(got); err != nil {
+		t.Fatalf("Apply => unexpected error: %v", err)
+	}
+
+	want := faketerm.MustNew(image.Point{6, 6})
+	if err := want.SetCell(image.Point{3, 3}, 'A'); err != nil {
+		t.Fatalf("SetCell => unexpected error: %v", err)
+	}
+	if diff := faketerm.Diff(want, got); diff != "" {
+		t.Errorf("Apply => %v", diff)
+	}
+}
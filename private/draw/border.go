@@ -25,6 +25,7 @@ import (
 	"github.com/woodliu/termdash/linestyle"
 	"github.com/woodliu/termdash/private/alignfor"
 	"github.com/woodliu/termdash/private/canvas"
+	"github.com/woodliu/termdash/private/runewidth"
 )
 
 // BorderOption is used to provide options to Border().
@@ -41,6 +42,10 @@ type borderOptions struct {
 	titleOM       OverrunMode
 	titleCellOpts []cell.Option
 	titleHAlign   align.Horizontal
+
+	rightTitle         string
+	rightTitleOM       OverrunMode
+	rightTitleCellOpts []cell.Option
 }
 
 // borderOption implements BorderOption.
@@ -77,6 +82,19 @@ func BorderTitle(title string, overrun OverrunMode, opts ...cell.Option) BorderO
 	})
 }
 
+// BorderTitleRight sets a second title for the border, displayed on the right
+// end of the top border next to the title set with BorderTitle. The title set
+// with BorderTitle takes precedence, the right title only gets the space the
+// other title doesn't need and is trimmed according to the overrun mode if it
+// doesn't fit. The right title isn't drawn if there is no space left for it.
+func BorderTitleRight(title string, overrun OverrunMode, opts ...cell.Option) BorderOption {
+	return borderOption(func(bOpts *borderOptions) {
+		bOpts.rightTitle = title
+		bOpts.rightTitleOM = overrun
+		bOpts.rightTitleCellOpts = opts
+	})
+}
+
 // BorderTitleAlign configures the horizontal alignment for the title.
 func BorderTitleAlign(h align.Horizontal) BorderOption {
 	return borderOption(func(bOpts *borderOptions) {
@@ -105,7 +123,25 @@ func borderChar(p image.Point, border image.Rectangle, parts map[linePart]rune)
 	return -1
 }
 
-// drawTitle draws a text title at the top of the border.
+// borderGap determines if the point falls onto a gap of a dashed or dotted
+// border. The corners are always drawn.
+func borderGap(p image.Point, border image.Rectangle, ls linestyle.LineStyle) bool {
+	onX := p.X == border.Min.X || p.X == border.Max.X-1
+	onY := p.Y == border.Min.Y || p.Y == border.Max.Y-1
+	switch {
+	case onX && onY:
+		return false
+	case onY:
+		return lineGap(ls, p, true)
+	default:
+		return lineGap(ls, p, false)
+	}
+}
+
+// rightTitleGap is the number of cells between the title and the right title.
+const rightTitleGap = 1
+
+// drawTitle draws the text titles at the top of the border.
 func drawTitle(c *canvas.Canvas, border image.Rectangle, opt *borderOptions) error {
 	// Don't attempt to draw the title if there isn't space for at least one rune.
 	// The title must not overwrite any of the corner runes on the border so we
@@ -121,6 +157,20 @@ func drawTitle(c *canvas.Canvas, border image.Rectangle, opt *borderOptions) err
 		border.Max.X-1, // One space for the top right corner char.
 		border.Min.Y+1,
 	)
+
+	if opt.rightTitle != "" {
+		rightAr, err := drawRightTitle(c, available, opt)
+		if err != nil {
+			return err
+		}
+		if !rightAr.Empty() {
+			available.Max.X = rightAr.Min.X - rightTitleGap
+		}
+	}
+	if opt.title == "" {
+		return nil
+	}
+
 	start, err := alignfor.Text(available, opt.title, opt.titleHAlign, align.VerticalTop)
 	if err != nil {
 		return err
@@ -134,6 +184,34 @@ func drawTitle(c *canvas.Canvas, border image.Rectangle, opt *borderOptions) err
 	)
 }
 
+// drawRightTitle draws the right title at the right end of the available area
+// in the space not needed by the title. Returns the area occupied by the
+// right title or an empty area if there wasn't space to draw it.
+func drawRightTitle(c *canvas.Canvas, available image.Rectangle, opt *borderOptions) (image.Rectangle, error) {
+	space := available.Dx()
+	if tw := runewidth.StringWidth(opt.title); tw > 0 {
+		space -= tw + rightTitleGap
+	}
+	if space < 1 {
+		return image.ZR, nil
+	}
+
+	trimmed, err := TrimText(opt.rightTitle, space, opt.rightTitleOM)
+	if err != nil {
+		return image.ZR, err
+	}
+	rw := runewidth.StringWidth(trimmed)
+	ar := image.Rect(available.Max.X-rw, available.Min.Y, available.Max.X, available.Max.Y)
+	if err := Text(
+		c, trimmed, ar.Min,
+		TextCellOpts(opt.rightTitleCellOpts...),
+		TextMaxX(ar.Max.X),
+	); err != nil {
+		return image.ZR, err
+	}
+	return ar, nil
+}
+
 // Border draws a border on the canvas.
 func Border(c *canvas.Canvas, border image.Rectangle, opts ...BorderOption) error {
 	if ar := c.Area(); !border.In(ar) {
@@ -161,7 +239,7 @@ func Border(c *canvas.Canvas, border image.Rectangle, opts ...BorderOption) erro
 		for row := border.Min.Y; row < border.Max.Y; row++ {
 			p := image.Point{col, row}
 			r := borderChar(p, border, parts)
-			if r == -1 {
+			if r == -1 || borderGap(p, border, opt.lineStyle) {
 				continue
 			}
 
@@ -175,7 +253,7 @@ func Border(c *canvas.Canvas, border image.Rectangle, opts ...BorderOption) erro
 		}
 	}
 
-	if opt.title != "" {
+	if opt.title != "" || opt.rightTitle != "" {
 		return drawTitle(c, border, opt)
 	}
 	return nil
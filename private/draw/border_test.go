@@ -155,6 +155,32 @@ func TestBorder(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:   "draws dashed border around the canvas",
+			canvas: image.Rect(0, 0, 5, 5),
+			border: image.Rect(0, 0, 5, 5),
+			opts: []BorderOption{
+				BorderLineStyle(linestyle.Dashed),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, '┌')
+				testcanvas.MustSetCell(c, image.Point{0, 2}, '│')
+				testcanvas.MustSetCell(c, image.Point{0, 4}, '└')
+
+				testcanvas.MustSetCell(c, image.Point{2, 0}, '─')
+				testcanvas.MustSetCell(c, image.Point{2, 4}, '─')
+
+				testcanvas.MustSetCell(c, image.Point{4, 0}, '┐')
+				testcanvas.MustSetCell(c, image.Point{4, 2}, '│')
+				testcanvas.MustSetCell(c, image.Point{4, 4}, '┘')
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc:   "draws border in the canvas",
 			canvas: image.Rect(0, 0, 4, 4),
@@ -501,3 +527,141 @@ func TestBorder(t *testing.T) {
 		})
 	}
 }
+
+// mustBorder draws border on the canvas or panics.
+func mustBorder(c *canvas.Canvas, border image.Rectangle, opts ...BorderOption) {
+	if err := Border(c, border, opts...); err != nil {
+		panic(err)
+	}
+}
+
+// mustText draws the text on the canvas or panics.
+func mustText(c *canvas.Canvas, text string, start image.Point, opts ...TextOption) {
+	if err := Text(c, text, start, opts...); err != nil {
+		panic(err)
+	}
+}
+
+func TestBorderTitleRight(t *testing.T) {
+	tests := []struct {
+		desc    string
+		opts    []BorderOption
+		want    func(size image.Point) *faketerm.Terminal
+		wantErr bool
+	}{
+		{
+			desc: "draws the right title alone",
+			opts: []BorderOption{
+				BorderTitleRight("ok", OverrunModeStrict),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				mustBorder(c, c.Area())
+				mustText(c, "ok", image.Point{7, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "draws both titles",
+			opts: []BorderOption{
+				BorderTitle("ab", OverrunModeStrict),
+				BorderTitleRight("cd", OverrunModeStrict, cell.FgColor(cell.ColorRed)),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				mustBorder(c, c.Area())
+				mustText(c, "ab", image.Point{1, 0})
+				mustText(c, "cd", image.Point{7, 0}, TextCellOpts(cell.FgColor(cell.ColorRed)))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "title aligned to the right is placed before the right title",
+			opts: []BorderOption{
+				BorderTitle("ab", OverrunModeStrict),
+				BorderTitleAlign(align.HorizontalRight),
+				BorderTitleRight("cd", OverrunModeStrict),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				mustBorder(c, c.Area())
+				mustText(c, "ab", image.Point{4, 0})
+				mustText(c, "cd", image.Point{7, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "trims the right title when the space is tight",
+			opts: []BorderOption{
+				BorderTitle("abcde", OverrunModeStrict),
+				BorderTitleRight("xyz", OverrunModeThreeDot),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				mustBorder(c, c.Area())
+				mustText(c, "abcde", image.Point{1, 0})
+				mustText(c, "x…", image.Point{7, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "fails when the right title doesn't fit in strict mode",
+			opts: []BorderOption{
+				BorderTitle("abcde", OverrunModeStrict),
+				BorderTitleRight("xyz", OverrunModeStrict),
+			},
+			wantErr: true,
+		},
+		{
+			desc: "omits the right title when there is no space left",
+			opts: []BorderOption{
+				BorderTitle("abcdefg", OverrunModeStrict),
+				BorderTitleRight("xyz", OverrunModeThreeDot),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				mustBorder(c, c.Area())
+				mustText(c, "abcdefg", image.Point{1, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			c, err := canvas.New(image.Rect(0, 0, 10, 3))
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+
+			err = Border(c, c.Area(), tc.opts...)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Border => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := faketerm.New(c.Size())
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			if err := c.Apply(got); err != nil {
+				t.Fatalf("Apply => unexpected error: %v", err)
+			}
+			if diff := faketerm.Diff(tc.want(c.Size()), got); diff != "" {
+				t.Errorf("Border => %v", diff)
+			}
+		})
+	}
+}
@@ -209,6 +209,16 @@ func fillCircle(bc *braille.Canvas, points []image.Point, mid image.Point, radiu
 	return nil
 }
 
+// BrailleArc draws an approximated arc of the circle with the specified mid
+// point and radius between the two provided angles in degrees, e.g. to draw
+// custom gauges or radial indicators. This is a shorthand for BrailleCircle
+// with the BrailleCircleArcOnly option and the same requirements apply.
+// Use BrailleCircle to draw full circles.
+func BrailleArc(bc *braille.Canvas, mid image.Point, radius, startDegree, endDegree int, opts ...BrailleCircleOption) error {
+	arc := []BrailleCircleOption{BrailleCircleArcOnly(startDegree, endDegree)}
+	return BrailleCircle(bc, mid, radius, append(arc, opts...)...)
+}
+
 // openingPoints returns points on the lines from the mid point to the circle
 // opening when drawing an incomplete circle.
 func openingPoints(mid image.Point, radius int, opt *brailleCircleOptions) []image.Point {
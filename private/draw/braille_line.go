@@ -59,6 +59,7 @@ type BrailleLineOption interface {
 type brailleLineOptions struct {
 	cellOpts    []cell.Option
 	pixelChange braillePixelChange
+	antiAlias   bool
 }
 
 // newBrailleLineOptions returns a new brailleLineOptions instance.
@@ -94,6 +95,18 @@ func BrailleLineClearPixels() BrailleLineOption {
 	})
 }
 
+// BrailleLineAntiAlias changes the behavior of BrailleLine, so that it
+// softens diagonal lines. Where the line passes between two pixels, both of
+// them are set unless the line runs close to one of them. Braille pixels
+// can't be partially set, so this is the closest a braille canvas gets to the
+// shading used by SmoothLine. The line appears slightly thicker in exchange
+// for less visible steps.
+func BrailleLineAntiAlias() BrailleLineOption {
+	return brailleLineOption(func(opts *brailleLineOptions) {
+		opts.antiAlias = true
+	})
+}
+
 // BrailleLine draws an approximated line segment on the braille canvas between
 // the two provided points.
 // Both start and end must be valid points within the canvas. Start and end can
@@ -113,7 +126,12 @@ func BrailleLine(bc *braille.Canvas, start, end image.Point, opts ...BrailleLine
 		o.set(opt)
 	}
 
-	points := brailleLinePoints(start, end)
+	var points []image.Point
+	if opt.antiAlias {
+		points = brailleLineAAPoints(start, end)
+	} else {
+		points = brailleLinePoints(start, end)
+	}
 	for _, p := range points {
 		switch opt.pixelChange {
 		case braillePixelChangeSet:
@@ -148,6 +166,25 @@ func brailleLinePoints(start, end image.Point) []image.Point {
 	return lineHigh(start.X, start.Y, end.X, end.Y)
 }
 
+// aaMinCoverage is the minimum coverage of a pixel by the line for the pixel
+// to be set when anti-aliasing.
+const aaMinCoverage = 1.0 / 3
+
+// brailleLineAAPoints returns the points to set when drawing an anti-aliased
+// line. The points along the line are determined the same way as by
+// SmoothLine, the pixels that are covered by the line enough are set.
+func brailleLineAAPoints(start, end image.Point) []image.Point {
+	var res []image.Point
+	// Never fails, the function doesn't return errors.
+	_ = wuLine(start, end, func(p image.Point, coverage float64) error {
+		if coverage >= aaMinCoverage {
+			res = append(res, p)
+		}
+		return nil
+	})
+	return res
+}
+
 // lineLow returns points that create a line whose horizontal projection
 // (end.X - start.X) is longer than its vertical projection
 // (end.Y - start.Y).
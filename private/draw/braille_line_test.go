@@ -412,6 +412,121 @@ func TestBrailleLine(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:   "anti-aliased horizontal line matches the crisp line",
+			canvas: image.Rect(0, 0, 2, 1),
+			start:  image.Point{0, 1},
+			end:    image.Point{3, 1},
+			opts: []BrailleLineOption{
+				BrailleLineAntiAlias(),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				bc := testbraille.MustNew(ft.Area())
+
+				for x := 0; x <= 3; x++ {
+					testbraille.MustSetPixel(bc, image.Point{x, 1})
+				}
+
+				testbraille.MustApply(bc, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "anti-aliased low line sets both pixels where it passes between them",
+			canvas: image.Rect(0, 0, 3, 1),
+			start:  image.Point{4, 2},
+			end:    image.Point{0, 0},
+			opts: []BrailleLineOption{
+				BrailleLineAntiAlias(),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				bc := testbraille.MustNew(ft.Area())
+
+				testbraille.MustSetPixel(bc, image.Point{0, 0})
+				testbraille.MustSetPixel(bc, image.Point{1, 0})
+				testbraille.MustSetPixel(bc, image.Point{1, 1})
+				testbraille.MustSetPixel(bc, image.Point{2, 1})
+				testbraille.MustSetPixel(bc, image.Point{3, 1})
+				testbraille.MustSetPixel(bc, image.Point{3, 2})
+				testbraille.MustSetPixel(bc, image.Point{4, 2})
+
+				testbraille.MustApply(bc, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "anti-aliased high line sets both pixels where it passes between them",
+			canvas: image.Rect(0, 0, 2, 2),
+			start:  image.Point{0, 0},
+			end:    image.Point{2, 4},
+			opts: []BrailleLineOption{
+				BrailleLineAntiAlias(),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				bc := testbraille.MustNew(ft.Area())
+
+				testbraille.MustSetPixel(bc, image.Point{0, 0})
+				testbraille.MustSetPixel(bc, image.Point{0, 1})
+				testbraille.MustSetPixel(bc, image.Point{1, 1})
+				testbraille.MustSetPixel(bc, image.Point{1, 2})
+				testbraille.MustSetPixel(bc, image.Point{1, 3})
+				testbraille.MustSetPixel(bc, image.Point{2, 3})
+				testbraille.MustSetPixel(bc, image.Point{2, 4})
+
+				testbraille.MustApply(bc, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "anti-aliased line sets only the pixel the line runs close to",
+			canvas: image.Rect(0, 0, 3, 1),
+			start:  image.Point{0, 0},
+			end:    image.Point{4, 1},
+			opts: []BrailleLineOption{
+				BrailleLineAntiAlias(),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				bc := testbraille.MustNew(ft.Area())
+
+				// The line is at y=0.25 at x=1 and at y=0.75 at x=3.
+				testbraille.MustSetPixel(bc, image.Point{0, 0})
+				testbraille.MustSetPixel(bc, image.Point{1, 0})
+				testbraille.MustSetPixel(bc, image.Point{2, 0})
+				testbraille.MustSetPixel(bc, image.Point{2, 1})
+				testbraille.MustSetPixel(bc, image.Point{3, 1})
+				testbraille.MustSetPixel(bc, image.Point{4, 1})
+
+				testbraille.MustApply(bc, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "anti-aliased line clears pixels",
+			canvas: image.Rect(0, 0, 3, 1),
+			start:  image.Point{0, 0},
+			end:    image.Point{4, 2},
+			prepare: func(bc *braille.Canvas) error {
+				return BrailleLine(bc, image.Point{0, 0}, image.Point{4, 2}, BrailleLineAntiAlias())
+			},
+			opts: []BrailleLineOption{
+				BrailleLineAntiAlias(),
+				BrailleLineClearPixels(),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				bc := testbraille.MustNew(ft.Area())
+				for _, p := range []image.Point{{0, 0}, {2, 0}, {4, 0}} {
+					testbraille.MustSetPixel(bc, p)
+					testbraille.MustClearPixel(bc, p)
+				}
+				testbraille.MustApply(bc, ft)
+				return ft
+			},
+		},
 	}
 
 	for _, tc := range tests {
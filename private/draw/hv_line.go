@@ -83,6 +83,8 @@ type HVLine struct {
 // or a cross). Each line must be at least two cells long. Both start and end
 // must be on the same horizontal (same X coordinate) or same vertical (same Y
 // coordinate) line.
+// Dashed and dotted lines leave every other cell untouched, their ends and the
+// locations where lines cross are always drawn.
 func HVLines(c *canvas.Canvas, lines []HVLine, opts ...HVLineOption) error {
 	opt := newHVLineOptions()
 	for _, o := range opts {
@@ -101,8 +103,10 @@ func HVLines(c *canvas.Canvas, lines []HVLine, opts ...HVLineOption) error {
 		case line.horizontal():
 			for curX := line.start.X; ; curX++ {
 				cur := image.Point{curX, line.start.Y}
-				if _, err := c.SetCell(cur, line.mainPart, opt.cellOpts...); err != nil {
-					return err
+				if cur == line.start || cur == line.end || !lineGap(opt.lineStyle, cur, true) {
+					if _, err := c.SetCell(cur, line.mainPart, opt.cellOpts...); err != nil {
+						return err
+					}
 				}
 
 				if curX == line.end.X {
@@ -113,8 +117,10 @@ func HVLines(c *canvas.Canvas, lines []HVLine, opts ...HVLineOption) error {
 		case line.vertical():
 			for curY := line.start.Y; ; curY++ {
 				cur := image.Point{line.start.X, curY}
-				if _, err := c.SetCell(cur, line.mainPart, opt.cellOpts...); err != nil {
-					return err
+				if cur == line.start || cur == line.end || !lineGap(opt.lineStyle, cur, false) {
+					if _, err := c.SetCell(cur, line.mainPart, opt.cellOpts...); err != nil {
+						return err
+					}
 				}
 
 				if curY == line.end.Y {
@@ -125,6 +131,9 @@ func HVLines(c *canvas.Canvas, lines []HVLine, opts ...HVLineOption) error {
 	}
 
 	for _, n := range g.multiEdgeNodes() {
+		if n.straightGap(opt.lineStyle) {
+			continue
+		}
 		r, err := n.rune(opt.lineStyle)
 		if err != nil {
 			return err
@@ -198,10 +207,10 @@ func newHVLine(c *canvas.Canvas, start, end image.Point, opts *hVLineOptions) (*
 
 // horizontal determines if this is a horizontal line.
 func (hvl *hVLine) horizontal() bool {
-	return hvl.mainPart == lineStyleChars[hvl.opts.lineStyle][hLine]
+	return hvl.start.Y == hvl.end.Y
 }
 
 // vertical determines if this is a vertical line.
 func (hvl *hVLine) vertical() bool {
-	return hvl.mainPart == lineStyleChars[hvl.opts.lineStyle][vLine]
+	return hvl.start.X == hvl.end.X
 }
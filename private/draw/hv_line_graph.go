@@ -89,6 +89,22 @@ func (n *hVLineNode) hasRight() bool {
 	return ok
 }
 
+// straightGap determines if this node is in the middle of a straight dashed or
+// dotted line and falls onto one of its gaps.
+func (n *hVLineNode) straightGap(ls linestyle.LineStyle) bool {
+	if len(n.edges) != 2 {
+		return false
+	}
+	switch {
+	case n.hasLeft() && n.hasRight():
+		return lineGap(ls, n.p, true)
+	case n.hasUp() && n.hasDown():
+		return lineGap(ls, n.p, false)
+	default:
+		return false
+	}
+}
+
 // rune, given the selected line style returns the correct line character to
 // represent this node.
 // Only handles nodes with two or more edges, as returned by multiEdgeNodes().
@@ -559,6 +559,88 @@ func TestHVLines(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:   "draws dashed horizontal line",
+			canvas: image.Rect(0, 0, 6, 1),
+			lines: []HVLine{
+				{
+					Start: image.Point{0, 0},
+					End:   image.Point{5, 0},
+				},
+			},
+			opts: []HVLineOption{
+				HVLineStyle(linestyle.Dashed),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, '─')
+				testcanvas.MustSetCell(c, image.Point{2, 0}, '─')
+				testcanvas.MustSetCell(c, image.Point{4, 0}, '─')
+				testcanvas.MustSetCell(c, image.Point{5, 0}, '─')
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "draws dotted vertical line",
+			canvas: image.Rect(0, 0, 1, 5),
+			lines: []HVLine{
+				{
+					Start: image.Point{0, 0},
+					End:   image.Point{0, 4},
+				},
+			},
+			opts: []HVLineOption{
+				HVLineStyle(linestyle.Dotted),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, '·')
+				testcanvas.MustSetCell(c, image.Point{0, 2}, '·')
+				testcanvas.MustSetCell(c, image.Point{0, 4}, '·')
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "dashed lines always draw crossings",
+			canvas: image.Rect(0, 0, 5, 5),
+			lines: []HVLine{
+				{
+					Start: image.Point{0, 1},
+					End:   image.Point{4, 1},
+				},
+				{
+					Start: image.Point{1, 0},
+					End:   image.Point{1, 4},
+				},
+			},
+			opts: []HVLineOption{
+				HVLineStyle(linestyle.Dashed),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 1}, '─')
+				testcanvas.MustSetCell(c, image.Point{1, 1}, '┼')
+				testcanvas.MustSetCell(c, image.Point{2, 1}, '─')
+				testcanvas.MustSetCell(c, image.Point{4, 1}, '─')
+
+				testcanvas.MustSetCell(c, image.Point{1, 0}, '│')
+				testcanvas.MustSetCell(c, image.Point{1, 2}, '│')
+				testcanvas.MustSetCell(c, image.Point{1, 4}, '│')
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc:   "draws a cross",
 			canvas: image.Rect(0, 0, 3, 3),
@@ -636,6 +718,60 @@ func TestHVLines(t *testing.T) {
 				testcanvas.MustSetCell(c, image.Point{1, 2}, parts[hAndUp])
 				testcanvas.MustSetCell(c, image.Point{2, 2}, parts[bottomRightCorner])
 
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "draws multiple crossings with rounded corners",
+			canvas: image.Rect(0, 0, 3, 3),
+			lines: []HVLine{
+				// Three horizontal lines.
+				{
+					Start: image.Point{0, 0},
+					End:   image.Point{2, 0},
+				},
+				{
+					Start: image.Point{0, 1},
+					End:   image.Point{2, 1},
+				},
+				{
+					Start: image.Point{0, 2},
+					End:   image.Point{2, 2},
+				},
+				// Three vertical lines.
+				{
+					Start: image.Point{0, 0},
+					End:   image.Point{0, 2},
+				},
+				{
+					Start: image.Point{1, 0},
+					End:   image.Point{1, 2},
+				},
+				{
+					Start: image.Point{2, 0},
+					End:   image.Point{2, 2},
+				},
+			},
+			opts: []HVLineOption{
+				HVLineStyle(linestyle.Round),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, '╭')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, '┬')
+				testcanvas.MustSetCell(c, image.Point{2, 0}, '╮')
+
+				testcanvas.MustSetCell(c, image.Point{0, 1}, '├')
+				testcanvas.MustSetCell(c, image.Point{1, 1}, '┼')
+				testcanvas.MustSetCell(c, image.Point{2, 1}, '┤')
+
+				testcanvas.MustSetCell(c, image.Point{0, 2}, '╰')
+				testcanvas.MustSetCell(c, image.Point{1, 2}, '┴')
+				testcanvas.MustSetCell(c, image.Point{2, 2}, '╯')
+
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
@@ -16,6 +16,7 @@ package draw
 
 import (
 	"fmt"
+	"image"
 
 	"github.com/woodliu/termdash/linestyle"
 	"github.com/woodliu/termdash/private/runewidth"
@@ -66,6 +67,32 @@ var lineStyleChars = map[linestyle.LineStyle]map[linePart]rune{
 		vAndRight:         '├',
 		vAndH:             '┼',
 	},
+	linestyle.Dashed: {
+		hLine:             '─',
+		vLine:             '│',
+		topLeftCorner:     '┌',
+		topRightCorner:    '┐',
+		bottomLeftCorner:  '└',
+		bottomRightCorner: '┘',
+		hAndUp:            '┴',
+		hAndDown:          '┬',
+		vAndLeft:          '┤',
+		vAndRight:         '├',
+		vAndH:             '┼',
+	},
+	linestyle.Dotted: {
+		hLine:             '·',
+		vLine:             '·',
+		topLeftCorner:     '┌',
+		topRightCorner:    '┐',
+		bottomLeftCorner:  '└',
+		bottomRightCorner: '┘',
+		hAndUp:            '┴',
+		hAndDown:          '┬',
+		vAndLeft:          '┤',
+		vAndRight:         '├',
+		vAndH:             '┼',
+	},
 }
 
 // init verifies that all line parts are half-width runes (occupy only one
@@ -89,6 +116,20 @@ func lineParts(ls linestyle.LineStyle) (map[linePart]rune, error) {
 	return parts, nil
 }
 
+// lineGap determines if the cell at point p of a straight horizontal or
+// vertical line should be left empty. Dashed and dotted lines leave every
+// other cell empty, the gaps are aligned to the canvas so that parallel lines
+// have their gaps next to each other.
+func lineGap(ls linestyle.LineStyle, p image.Point, horizontal bool) bool {
+	if ls != linestyle.Dashed && ls != linestyle.Dotted {
+		return false
+	}
+	if horizontal {
+		return p.X%2 == 1
+	}
+	return p.Y%2 == 1
+}
+
 // linePart identifies individual line parts.
 type linePart int
 
@@ -34,6 +34,13 @@ type RectangleOption interface {
 type rectOptions struct {
 	cellOpts []cell.Option
 	char     rune
+	gradient *rectGradient
+}
+
+// rectGradient is a gradient of the background color of a rectangle.
+type rectGradient struct {
+	from, to  cell.Color
+	direction GradientDirection
 }
 
 // rectOption implements RectangleOption.
@@ -61,6 +68,59 @@ func RectChar(c rune) RectangleOption {
 	})
 }
 
+// GradientDirection is the direction in which the color of a gradient changes.
+type GradientDirection int
+
+// String implements fmt.Stringer()
+func (gd GradientDirection) String() string {
+	if n, ok := gradientDirectionNames[gd]; ok {
+		return n
+	}
+	return "GradientDirectionUnknown"
+}
+
+// gradientDirectionNames maps GradientDirection values to human readable names.
+var gradientDirectionNames = map[GradientDirection]string{
+	GradientHorizontal: "GradientHorizontal",
+	GradientVertical:   "GradientVertical",
+}
+
+const (
+	// GradientHorizontal changes the color from the left column to the right
+	// column.
+	GradientHorizontal GradientDirection = iota
+
+	// GradientVertical changes the color from the top row to the bottom row.
+	GradientVertical
+)
+
+// RectGradient fills the background of the rectangle with a linear gradient
+// from the color from to the color to in the specified direction. The first
+// column (or row) has the color from, the last one the color to. The colors
+// in between are computed by cell.Lerp.
+// Overrides the background color set by RectCellOpts.
+func RectGradient(from, to cell.Color, direction GradientDirection) RectangleOption {
+	return rectOption(func(rOpts *rectOptions) {
+		rOpts.gradient = &rectGradient{
+			from:      from,
+			to:        to,
+			direction: direction,
+		}
+	})
+}
+
+// colorAt returns the color of the gradient at the point p of the rectangle r.
+func (rg *rectGradient) colorAt(r image.Rectangle, p image.Point) cell.Color {
+	pos, size := p.X-r.Min.X, r.Dx()
+	if rg.direction == GradientVertical {
+		pos, size = p.Y-r.Min.Y, r.Dy()
+	}
+	if size < 2 {
+		return rg.from
+	}
+	return cell.Lerp(rg.from, rg.to, float64(pos)/float64(size-1))
+}
+
 // Rectangle draws a filled rectangle on the canvas.
 func Rectangle(c *canvas.Canvas, r image.Rectangle, opts ...RectangleOption) error {
 	opt := &rectOptions{
@@ -77,10 +137,20 @@ func Rectangle(c *canvas.Canvas, r image.Rectangle, opts ...RectangleOption) err
 	if r.Dx() < 1 || r.Dy() < 1 {
 		return fmt.Errorf("the rectangle must be at least 1x1 cell, got %v", r)
 	}
+	if g := opt.gradient; g != nil {
+		if _, ok := gradientDirectionNames[g.direction]; !ok {
+			return fmt.Errorf("unsupported gradient direction %v", g.direction)
+		}
+	}
 
 	for col := r.Min.X; col < r.Max.X; col++ {
 		for row := r.Min.Y; row < r.Max.Y; row++ {
-			cells, err := c.SetCell(image.Point{col, row}, opt.char, opt.cellOpts...)
+			p := image.Point{col, row}
+			cOpts := opt.cellOpts
+			if opt.gradient != nil {
+				cOpts = append(cOpts[:len(cOpts):len(cOpts)], cell.BgColor(opt.gradient.colorAt(r, p)))
+			}
+			cells, err := c.SetCell(p, opt.char, cOpts...)
 			if err != nil {
 				return err
 			}
@@ -126,6 +126,82 @@ func TestRectangle(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:   "fails on unsupported gradient direction",
+			canvas: image.Rect(0, 0, 2, 2),
+			rect:   image.Rect(0, 0, 2, 2),
+			opts: []RectangleOption{
+				RectGradient(cell.ColorBlack, cell.ColorWhite, GradientDirection(-1)),
+			},
+			wantErr: true,
+		},
+		{
+			desc:   "fills horizontal gradient",
+			canvas: image.Rect(0, 0, 3, 2),
+			rect:   image.Rect(0, 0, 3, 2),
+			opts: []RectangleOption{
+				RectChar('x'),
+				RectCellOpts(
+					cell.FgColor(cell.ColorBlue),
+					cell.BgColor(cell.ColorRed),
+				),
+				RectGradient(cell.ColorRGB(0, 0, 0), cell.ColorRGB(200, 100, 50), GradientHorizontal),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				colors := []cell.Color{
+					cell.ColorRGB(0, 0, 0),
+					cell.ColorRGB(100, 50, 25),
+					cell.ColorRGB(200, 100, 50),
+				}
+				for x, color := range colors {
+					for y := 0; y < 2; y++ {
+						testcanvas.MustSetCell(c, image.Point{x, y}, 'x',
+							cell.FgColor(cell.ColorBlue),
+							cell.BgColor(color),
+						)
+					}
+				}
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "fills vertical gradient between numbered colors",
+			canvas: image.Rect(0, 0, 2, 4),
+			rect:   image.Rect(1, 1, 2, 4),
+			opts: []RectangleOption{
+				RectGradient(cell.ColorRed, cell.ColorBlue, GradientVertical),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{1, 1}, ' ', cell.BgColor(cell.ColorRed))
+				testcanvas.MustSetCell(c, image.Point{1, 2}, ' ', cell.BgColor(cell.ColorRGB(127, 0, 128)))
+				testcanvas.MustSetCell(c, image.Point{1, 3}, ' ', cell.BgColor(cell.ColorBlue))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "gradient in a single column uses the first color",
+			canvas: image.Rect(0, 0, 1, 1),
+			rect:   image.Rect(0, 0, 1, 1),
+			opts: []RectangleOption{
+				RectGradient(cell.ColorRed, cell.ColorBlue, GradientHorizontal),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, ' ', cell.BgColor(cell.ColorRed))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 	}
 
 	for _, tc := range tests {
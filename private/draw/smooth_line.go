@@ -0,0 +1,153 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package draw
+
+// smooth_line.go contains code that draws lines approximating anti-aliasing
+// with shading characters.
+
+import (
+	"fmt"
+	"image"
+	"math"
+
+	"github.com/woodliu/termdash/cell"
+	"github.com/woodliu/termdash/private/canvas"
+	"github.com/woodliu/termdash/private/numbers"
+)
+
+// SmoothLineOption is used to provide options to SmoothLine().
+type SmoothLineOption interface {
+	// set sets the provided option.
+	set(*smoothLineOptions)
+}
+
+// smoothLineOptions stores the provided options.
+type smoothLineOptions struct {
+	cellOpts []cell.Option
+}
+
+// newSmoothLineOptions returns a new smoothLineOptions instance.
+func newSmoothLineOptions() *smoothLineOptions {
+	return &smoothLineOptions{}
+}
+
+// smoothLineOption implements SmoothLineOption.
+type smoothLineOption func(*smoothLineOptions)
+
+// set implements SmoothLineOption.set.
+func (o smoothLineOption) set(opts *smoothLineOptions) {
+	o(opts)
+}
+
+// SmoothLineCellOpts sets options on the cells that contain the line.
+func SmoothLineCellOpts(cOpts ...cell.Option) SmoothLineOption {
+	return smoothLineOption(func(opts *smoothLineOptions) {
+		opts.cellOpts = cOpts
+	})
+}
+
+// shades are the runes used to draw a cell covered by the line, ordered from
+// the lowest to the highest coverage.
+var shades = []rune{'░', '▒', '▓', '█'}
+
+// shadeRune returns the shading rune that best represents a cell covered by
+// the line to the specified degree in the range 0 <= coverage <= 1.
+// Returns false if the coverage is too low for the cell to be drawn.
+func shadeRune(coverage float64) (rune, bool) {
+	// Each shade represents a quarter of the coverage range, centered around
+	// its nominal value, i.e. 1/4, 2/4, 3/4 and 4/4.
+	idx := int(math.Round(coverage*float64(len(shades)))) - 1
+	if idx < 0 {
+		return 0, false
+	}
+	if idx >= len(shades) {
+		idx = len(shades) - 1
+	}
+	return shades[idx], true
+}
+
+// SmoothLine draws an approximation of a straight line on the canvas using
+// shading characters. This is a variant of the Xiaolin Wu's line algorithm,
+// the cells along the line are shaded in proportion to how much the line
+// covers them, which gives smoother diagonals than a line made of full cells.
+// Both the start and the end of the line must fall within the canvas area.
+// The start and the end cells are always drawn at full intensity.
+func SmoothLine(c *canvas.Canvas, start, end image.Point, opts ...SmoothLineOption) error {
+	if ar := c.Area(); !start.In(ar) || !end.In(ar) {
+		return fmt.Errorf("both the start%v and the end%v must be in the canvas area: %v", start, end, ar)
+	}
+
+	opt := newSmoothLineOptions()
+	for _, o := range opts {
+		o.set(opt)
+	}
+
+	return wuLine(start, end, func(p image.Point, coverage float64) error {
+		r, ok := shadeRune(coverage)
+		if !ok {
+			return nil
+		}
+		_, err := c.SetCell(p, r, opt.cellOpts...)
+		return err
+	})
+}
+
+// wuLine calls the function with each point along the line between start and
+// end and with the degree to which the line covers the point in the range
+// 0 <= coverage <= 1. This is a variant of the Xiaolin Wu's line algorithm,
+// for each step along the major axis of the line, the function is called with
+// the two points that the line passes between. Stops and returns the error if
+// the function returns one.
+func wuLine(start, end image.Point, fn func(p image.Point, coverage float64) error) error {
+	vertical := numbers.Abs(end.Y-start.Y) > numbers.Abs(end.X-start.X)
+	// The algorithm iterates over the major axis, swap the coordinates so
+	// that the major axis is always X.
+	if vertical {
+		start = image.Point{start.Y, start.X}
+		end = image.Point{end.Y, end.X}
+	}
+	if start.X > end.X {
+		start, end = end, start
+	}
+
+	var gradient float64
+	if dx := end.X - start.X; dx != 0 {
+		gradient = float64(end.Y-start.Y) / float64(dx)
+	}
+
+	for x := start.X; x <= end.X; x++ {
+		y := float64(start.Y) + gradient*float64(x-start.X)
+		floorY := math.Floor(y)
+		frac := y - floorY
+
+		points := []struct {
+			p        image.Point
+			coverage float64
+		}{
+			{image.Point{x, int(floorY)}, 1 - frac},
+			{image.Point{x, int(floorY) + 1}, frac},
+		}
+		for _, wp := range points {
+			p := wp.p
+			if vertical {
+				p = image.Point{p.Y, p.X}
+			}
+			if err := fn(p, wp.coverage); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
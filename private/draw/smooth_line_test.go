@@ -0,0 +1,207 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package draw
+
+import (
+	"image"
+	"testing"
+
+	"github.com/woodliu/termdash/cell"
+	"github.com/woodliu/termdash/private/canvas"
+	"github.com/woodliu/termdash/private/canvas/testcanvas"
+	"github.com/woodliu/termdash/private/faketerm"
+)
+
+func TestSmoothLine(t *testing.T) {
+	tests := []struct {
+		desc    string
+		canvas  image.Rectangle
+		start   image.Point
+		end     image.Point
+		opts    []SmoothLineOption
+		want    func(size image.Point) *faketerm.Terminal
+		wantErr bool
+	}{
+		{
+			desc:    "fails when start isn't in the canvas",
+			canvas:  image.Rect(0, 0, 3, 3),
+			start:   image.Point{-1, 0},
+			end:     image.Point{2, 2},
+			wantErr: true,
+		},
+		{
+			desc:    "fails when end isn't in the canvas",
+			canvas:  image.Rect(0, 0, 3, 3),
+			start:   image.Point{0, 0},
+			end:     image.Point{3, 2},
+			wantErr: true,
+		},
+		{
+			desc:   "draws a single cell when start equals end",
+			canvas: image.Rect(0, 0, 3, 3),
+			start:  image.Point{1, 1},
+			end:    image.Point{1, 1},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{1, 1}, '█')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "draws a horizontal line right to left",
+			canvas: image.Rect(0, 0, 3, 3),
+			start:  image.Point{2, 1},
+			end:    image.Point{0, 1},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 1}, '█')
+				testcanvas.MustSetCell(c, image.Point{1, 1}, '█')
+				testcanvas.MustSetCell(c, image.Point{2, 1}, '█')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "draws a diagonal line with the slope of one",
+			canvas: image.Rect(0, 0, 3, 3),
+			start:  image.Point{0, 0},
+			end:    image.Point{2, 2},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, '█')
+				testcanvas.MustSetCell(c, image.Point{1, 1}, '█')
+				testcanvas.MustSetCell(c, image.Point{2, 2}, '█')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "shades cells the line passes between",
+			canvas: image.Rect(0, 0, 5, 3),
+			start:  image.Point{0, 0},
+			end:    image.Point{4, 2},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, '█')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, '▒')
+				testcanvas.MustSetCell(c, image.Point{1, 1}, '▒')
+				testcanvas.MustSetCell(c, image.Point{2, 1}, '█')
+				testcanvas.MustSetCell(c, image.Point{3, 1}, '▒')
+				testcanvas.MustSetCell(c, image.Point{3, 2}, '▒')
+				testcanvas.MustSetCell(c, image.Point{4, 2}, '█')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "uses lighter and darker shades depending on the coverage",
+			canvas: image.Rect(0, 0, 5, 2),
+			start:  image.Point{0, 0},
+			end:    image.Point{4, 1},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, '█')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, '▓')
+				testcanvas.MustSetCell(c, image.Point{1, 1}, '░')
+				testcanvas.MustSetCell(c, image.Point{2, 0}, '▒')
+				testcanvas.MustSetCell(c, image.Point{2, 1}, '▒')
+				testcanvas.MustSetCell(c, image.Point{3, 0}, '░')
+				testcanvas.MustSetCell(c, image.Point{3, 1}, '▓')
+				testcanvas.MustSetCell(c, image.Point{4, 1}, '█')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "draws a steep line along the vertical axis",
+			canvas: image.Rect(0, 0, 3, 5),
+			start:  image.Point{2, 4},
+			end:    image.Point{0, 0},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, '█')
+				testcanvas.MustSetCell(c, image.Point{0, 1}, '▒')
+				testcanvas.MustSetCell(c, image.Point{1, 1}, '▒')
+				testcanvas.MustSetCell(c, image.Point{1, 2}, '█')
+				testcanvas.MustSetCell(c, image.Point{1, 3}, '▒')
+				testcanvas.MustSetCell(c, image.Point{2, 3}, '▒')
+				testcanvas.MustSetCell(c, image.Point{2, 4}, '█')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "sets cell options",
+			canvas: image.Rect(0, 0, 2, 1),
+			start:  image.Point{0, 0},
+			end:    image.Point{1, 0},
+			opts: []SmoothLineOption{
+				SmoothLineCellOpts(cell.FgColor(cell.ColorRed)),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, '█', cell.FgColor(cell.ColorRed))
+				testcanvas.MustSetCell(c, image.Point{1, 0}, '█', cell.FgColor(cell.ColorRed))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			c, err := canvas.New(tc.canvas)
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+
+			err = SmoothLine(c, tc.start, tc.end, tc.opts...)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("SmoothLine => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := faketerm.New(c.Size())
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+
+			if err := c.Apply(got); err != nil {
+				t.Fatalf("Apply => unexpected error: %v", err)
+			}
+
+			if diff := faketerm.Diff(tc.want(c.Size()), got); diff != "" {
+				t.Errorf("SmoothLine => %v", diff)
+			}
+		})
+	}
+}
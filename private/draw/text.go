@@ -68,6 +68,8 @@ type textOptions struct {
 	cellOpts    []cell.Option
 	maxX        int
 	overrunMode OverrunMode
+	fillBG      bool
+	fillBGColor cell.Color
 }
 
 // textOption implements TextOption.
@@ -104,6 +106,18 @@ func TextOverrunMode(om OverrunMode) TextOption {
 	})
 }
 
+// TextFillBG fills the cells from the end of the text up to TextMaxX, or the
+// width of the canvas if TextMaxX() isn't specified, with spaces in the
+// provided background color, e.g. to draw a header bar. The cells that
+// contain the text get the same background color unless TextCellOpts
+// specifies a different one.
+func TextFillBG(color cell.Color) TextOption {
+	return textOption(func(tOpts *textOptions) {
+		tOpts.fillBG = true
+		tOpts.fillBGColor = color
+	})
+}
+
 // TrimText trims the provided text so that it fits the specified amount of cells.
 func TrimText(text string, maxCells int, om OverrunMode) (string, error) {
 	if maxCells < 1 {
@@ -177,14 +191,26 @@ func Text(c *canvas.Canvas, text string, start image.Point, opts ...TextOption)
 		return err
 	}
 
+	cellOpts := opt.cellOpts
+	if opt.fillBG {
+		cellOpts = append([]cell.Option{cell.BgColor(opt.fillBGColor)}, opt.cellOpts...)
+	}
 	cur := start
 	for _, r := range trimmed {
-		cells, err := c.SetCell(cur, r, opt.cellOpts...)
+		cells, err := c.SetCell(cur, r, cellOpts...)
 		if err != nil {
 			return err
 		}
 		cur = image.Point{cur.X + cells, cur.Y}
 	}
+
+	if opt.fillBG {
+		for ; cur.X < wantMaxX; cur.X++ {
+			if _, err := c.SetCell(cur, ' ', cell.BgColor(opt.fillBGColor)); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -521,6 +521,86 @@ func TestText(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:   "fills the background to the end of the canvas",
+			canvas: image.Rect(0, 0, 5, 2),
+			text:   "ab",
+			start:  image.Point{1, 1},
+			opts: []TextOption{
+				TextFillBG(cell.ColorBlue),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{1, 1}, 'a', cell.BgColor(cell.ColorBlue))
+				testcanvas.MustSetCell(c, image.Point{2, 1}, 'b', cell.BgColor(cell.ColorBlue))
+				testcanvas.MustSetCell(c, image.Point{3, 1}, ' ', cell.BgColor(cell.ColorBlue))
+				testcanvas.MustSetCell(c, image.Point{4, 1}, ' ', cell.BgColor(cell.ColorBlue))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "fills the background up to TextMaxX",
+			canvas: image.Rect(0, 0, 5, 1),
+			text:   "a",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextMaxX(3),
+				TextFillBG(cell.ColorBlue),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, 'a', cell.BgColor(cell.ColorBlue))
+				testcanvas.MustSetCell(c, image.Point{1, 0}, ' ', cell.BgColor(cell.ColorBlue))
+				testcanvas.MustSetCell(c, image.Point{2, 0}, ' ', cell.BgColor(cell.ColorBlue))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "background color from the cell options takes precedence on the text",
+			canvas: image.Rect(0, 0, 3, 1),
+			text:   "a",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextCellOpts(cell.FgColor(cell.ColorRed), cell.BgColor(cell.ColorGreen)),
+				TextFillBG(cell.ColorBlue),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, 'a', cell.FgColor(cell.ColorRed), cell.BgColor(cell.ColorGreen))
+				testcanvas.MustSetCell(c, image.Point{1, 0}, ' ', cell.BgColor(cell.ColorBlue))
+				testcanvas.MustSetCell(c, image.Point{2, 0}, ' ', cell.BgColor(cell.ColorBlue))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "fills the background after trimmed full-width text",
+			canvas: image.Rect(0, 0, 4, 1),
+			text:   "你好",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextMaxX(3),
+				TextOverrunMode(OverrunModeTrim),
+				TextFillBG(cell.ColorBlue),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, '你', cell.BgColor(cell.ColorBlue))
+				testcanvas.MustSetCell(c, image.Point{2, 0}, ' ', cell.BgColor(cell.ColorBlue))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc:   "draws a half-width unicode character",
 			canvas: image.Rect(0, 0, 1, 1),
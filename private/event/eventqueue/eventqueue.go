@@ -37,7 +37,9 @@ type node struct {
 type Unbound struct {
 	first *node
 	last  *node
-	// mu protects first and last.
+	// len is the number of events in the queue.
+	len int
+	// mu protects first, last and len.
 	mu sync.Mutex
 
 	// cond is used to notify any callers waiting on a call to Pull().
@@ -89,6 +91,13 @@ func (u *Unbound) empty() bool {
 	return u.first == nil
 }
 
+// Len returns the number of events in the queue.
+func (u *Unbound) Len() int {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.len
+}
+
 // Push pushes an event onto the queue.
 func (u *Unbound) Push(e terminalapi.Event) {
 	u.mu.Lock()
@@ -111,9 +120,35 @@ func (u *Unbound) push(e terminalapi.Event) {
 		u.last = n
 		u.last.prev = prev
 	}
+	u.len++
 	u.cond.Signal()
 }
 
+// Remove removes all the events for which the match function returns true
+// from the queue. The order of the remaining events is preserved.
+func (u *Unbound) Remove(match func(terminalapi.Event) bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	for n := u.first; n != nil; n = n.next {
+		if !match(n.event) {
+			continue
+		}
+
+		if n.prev == nil {
+			u.first = n.next
+		} else {
+			n.prev.next = n.next
+		}
+		if n.next == nil {
+			u.last = n.prev
+		} else {
+			n.next.prev = n.prev
+		}
+		u.len--
+	}
+}
+
 // Pop pops an event from the queue. Returns nil if the queue is empty.
 func (u *Unbound) Pop() terminalapi.Event {
 	u.mu.Lock()
@@ -125,9 +160,12 @@ func (u *Unbound) Pop() terminalapi.Event {
 
 	n := u.first
 	u.first = u.first.next
+	u.len--
 
 	if u.empty() {
 		u.last = nil
+	} else {
+		u.first.prev = nil
 	}
 	return n.event
 }
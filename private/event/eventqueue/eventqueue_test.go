@@ -77,6 +77,95 @@ func TestQueue(t *testing.T) {
 	}
 }
 
+func TestLenAndRemove(t *testing.T) {
+	isError2 := func(e terminalapi.Event) bool {
+		err, ok := e.(*terminalapi.Error)
+		return ok && *err == "error2"
+	}
+
+	tests := []struct {
+		desc     string
+		pushes   []terminalapi.Event
+		pops     int
+		remove   func(terminalapi.Event) bool
+		wantLen  int
+		wantPops []terminalapi.Event
+	}{
+		{
+			desc:    "empty queue",
+			wantLen: 0,
+		},
+		{
+			desc: "counts pushed and popped events",
+			pushes: []terminalapi.Event{
+				terminalapi.NewError("error1"),
+				terminalapi.NewError("error2"),
+				terminalapi.NewError("error3"),
+			},
+			pops:    1,
+			wantLen: 2,
+			wantPops: []terminalapi.Event{
+				terminalapi.NewError("error2"),
+				terminalapi.NewError("error3"),
+				nil,
+			},
+		},
+		{
+			desc: "removes matching events in the middle and at the ends",
+			pushes: []terminalapi.Event{
+				terminalapi.NewError("error2"),
+				terminalapi.NewError("error1"),
+				terminalapi.NewError("error2"),
+				terminalapi.NewError("error3"),
+				terminalapi.NewError("error2"),
+			},
+			remove:  isError2,
+			wantLen: 2,
+			wantPops: []terminalapi.Event{
+				terminalapi.NewError("error1"),
+				terminalapi.NewError("error3"),
+				nil,
+			},
+		},
+		{
+			desc: "removes all the events",
+			pushes: []terminalapi.Event{
+				terminalapi.NewError("error2"),
+				terminalapi.NewError("error2"),
+			},
+			remove:   isError2,
+			wantLen:  0,
+			wantPops: []terminalapi.Event{nil},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			q := New()
+			defer q.Close()
+			for _, ev := range tc.pushes {
+				q.Push(ev)
+			}
+			for i := 0; i < tc.pops; i++ {
+				q.Pop()
+			}
+			if tc.remove != nil {
+				q.Remove(tc.remove)
+			}
+
+			if got := q.Len(); got != tc.wantLen {
+				t.Errorf("Len => %d, want %d", got, tc.wantLen)
+			}
+			for i, want := range tc.wantPops {
+				got := q.Pop()
+				if diff := pretty.Compare(want, got); diff != "" {
+					t.Errorf("Pop[%d] => unexpected diff (-want, +got):\n%s", i, diff)
+				}
+			}
+		})
+	}
+}
+
 func TestPullEventAvailable(t *testing.T) {
 	q := New()
 	defer q.Close()
@@ -109,3 +109,132 @@ func Diff(want, got *Terminal) string {
 	}
 	return b.String()
 }
+
+// diffMarker is the rune that highlights unexpected cells.
+const diffMarker = '࿃'
+
+// DiffDetailed is like Diff, but displays the two terminals and the cells
+// that differ side by side and lists each difference on a separate line, e.g.:
+//
+//	(1,0): want 'A' got 'B'
+//	(2,0): want FgColor ColorRed got ColorBlue
+//
+// Returns an empty string if there is no difference.
+func DiffDetailed(want, got *Terminal) string {
+	if reflect.DeepEqual(want.BackBuffer(), got.BackBuffer()) {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("found differences between the two fake terminals.\n")
+	wantSize, gotSize := want.Size(), got.Size()
+	if !wantSize.Eq(gotSize) {
+		b.WriteString(fmt.Sprintf("  the sizes differ, got %v, want %v\n", gotSize, wantSize))
+		b.WriteString("   got:\n")
+		b.WriteString(got.String())
+		b.WriteString("  want:\n")
+		b.WriteString(want.String())
+		return b.String()
+	}
+
+	// Each of the grids is at least as wide as its heading.
+	width := gotSize.X
+	if width < len("want") {
+		width = len("want")
+	}
+	pad := strings.Repeat(" ", width-gotSize.X)
+	b.WriteString(fmt.Sprintf("      %-*s | %-*s | diff (unexpected cells highlighted with rune '%c')\n", width, "got", width, "want", diffMarker))
+	for row := 0; row < gotSize.Y; row++ {
+		b.WriteString(fmt.Sprintf("  %3d ", row))
+		b.WriteString(gridRow(got, row, nil) + pad + " | ")
+		b.WriteString(gridRow(want, row, nil) + pad + " | ")
+		b.WriteString(gridRow(got, row, want))
+		b.WriteRune('\n')
+	}
+
+	b.WriteString("  differences (x,y):\n")
+	for row := 0; row < gotSize.Y; row++ {
+		for col := 0; col < gotSize.X; col++ {
+			gotCell := got.BackBuffer()[col][row]
+			wantCell := want.BackBuffer()[col][row]
+			if gotCell.Rune != wantCell.Rune {
+				b.WriteString(fmt.Sprintf("  (%d,%d): want %q got %q\n", col, row, wantCell.Rune, gotCell.Rune))
+			}
+			for _, d := range fieldDiffs(wantCell.Opts, gotCell.Opts) {
+				b.WriteString(fmt.Sprintf("  (%d,%d): %s\n", col, row, d))
+			}
+		}
+	}
+	return b.String()
+}
+
+// CellColorDiff compares the foreground and background colors of the cell at
+// the point with the provided colors. Complements Diff when a test only needs
+// to assert the colors of some of the cells, e.g.:
+//
+//	(1,0): want FgColor ColorRed got ColorBlue
+//
+// Returns an empty string if the colors match.
+func CellColorDiff(t *Terminal, p image.Point, fg, bg cell.Color) string {
+	if ar := t.Area(); !p.In(ar) {
+		return fmt.Sprintf("(%d,%d): the cell falls outside of the terminal area %v", p.X, p.Y, ar)
+	}
+
+	opts := t.BackBuffer()[p.X][p.Y].Opts
+	if opts == nil {
+		opts = &cell.Options{}
+	}
+	var res []string
+	if opts.FgColor != fg {
+		res = append(res, fmt.Sprintf("(%d,%d): want FgColor %v got %v", p.X, p.Y, fg, opts.FgColor))
+	}
+	if opts.BgColor != bg {
+		res = append(res, fmt.Sprintf("(%d,%d): want BgColor %v got %v", p.X, p.Y, bg, opts.BgColor))
+	}
+	return strings.Join(res, "\n")
+}
+
+// gridRow returns the runes on the specified row of the terminal. If other
+// isn't nil, the runes that differ from the other terminal are replaced with
+// the diffMarker.
+func gridRow(t *Terminal, row int, other *Terminal) string {
+	var b strings.Builder
+	for col := 0; col < t.Size().X; col++ {
+		p := image.Point{col, row}
+		partial, err := t.BackBuffer().IsPartial(p)
+		if err != nil {
+			panic(fmt.Errorf("unable to determine if point %v is a partial rune: %v", p, err))
+		}
+
+		r := t.BackBuffer()[col][row].Rune
+		switch {
+		case other != nil && r != other.BackBuffer()[col][row].Rune:
+			r = diffMarker
+		case r == 0 && !partial:
+			r = ' '
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// fieldDiffs returns a description of each of the cell options that differ,
+// e.g. "want FgColor ColorRed got ColorBlue".
+func fieldDiffs(want, got *cell.Options) []string {
+	if want == nil {
+		want = &cell.Options{}
+	}
+	if got == nil {
+		got = &cell.Options{}
+	}
+
+	var res []string
+	wv, gv := reflect.ValueOf(*want), reflect.ValueOf(*got)
+	for i := 0; i < wv.NumField(); i++ {
+		wf, gf := wv.Field(i).Interface(), gv.Field(i).Interface()
+		if wf != gf {
+			res = append(res, fmt.Sprintf("want %s %v got %v", wv.Type().Field(i).Name, wf, gf))
+		}
+	}
+	return res
+}
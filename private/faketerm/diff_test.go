@@ -70,6 +70,20 @@ func TestDiff(t *testing.T) {
 			}(),
 			wantDiff: true,
 		},
+		{
+			desc: "reports diff on when blink and strikethrough differ",
+			term1: func() *Terminal {
+				t := MustNew(image.Point{2, 2})
+				t.SetCell(image.Point{0, 0}, 'a', cell.Bold(), cell.Blink())
+				return t
+			}(),
+			term2: func() *Terminal {
+				t := MustNew(image.Point{2, 2})
+				t.SetCell(image.Point{0, 0}, 'a', cell.Bold(), cell.Strikethrough())
+				return t
+			}(),
+			wantDiff: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -81,3 +95,121 @@ func TestDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffDetailed(t *testing.T) {
+	tests := []struct {
+		desc string
+		want *Terminal
+		got  *Terminal
+		diff string
+	}{
+		{
+			desc: "no diff on equal terminals",
+			want: func() *Terminal {
+				t := MustNew(image.Point{2, 2})
+				t.SetCell(image.Point{0, 0}, 'a')
+				return t
+			}(),
+			got: func() *Terminal {
+				t := MustNew(image.Point{2, 2})
+				t.SetCell(image.Point{0, 0}, 'a')
+				return t
+			}(),
+		},
+		{
+			desc: "lists cells whose runes and options differ",
+			want: func() *Terminal {
+				t := MustNew(image.Point{3, 2})
+				t.SetCell(image.Point{0, 0}, 'a')
+				t.SetCell(image.Point{1, 1}, 'b', cell.FgColor(cell.ColorRed))
+				return t
+			}(),
+			got: func() *Terminal {
+				t := MustNew(image.Point{3, 2})
+				t.SetCell(image.Point{0, 0}, 'x')
+				t.SetCell(image.Point{1, 1}, 'b', cell.FgColor(cell.ColorBlue), cell.Bold())
+				return t
+			}(),
+			diff: "found differences between the two fake terminals.\n" +
+				"      got  | want | diff (unexpected cells highlighted with rune '࿃')\n" +
+				"    0 x    | a    | ࿃  \n" +
+				"    1  b   |  b   |  b \n" +
+				"  differences (x,y):\n" +
+				"  (0,0): want 'a' got 'x'\n" +
+				"  (1,1): want FgColor ColorRed got ColorBlue\n" +
+				"  (1,1): want Bold false got true\n",
+		},
+		{
+			desc: "reports terminals of different sizes",
+			want: MustNew(image.Point{2, 1}),
+			got:  MustNew(image.Point{1, 1}),
+			diff: "found differences between the two fake terminals.\n" +
+				"  the sizes differ, got (1,1), want (2,1)\n" +
+				"   got:\n" +
+				" \n" +
+				"  want:\n" +
+				"  \n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := DiffDetailed(tc.want, tc.got); got != tc.diff {
+				t.Errorf("DiffDetailed => got:\n%s\nwant:\n%s", got, tc.diff)
+			}
+		})
+	}
+}
+
+func TestCellColorDiff(t *testing.T) {
+	ft := MustNew(image.Point{2, 1})
+	ft.SetCell(image.Point{0, 0}, 'a', cell.FgColor(cell.ColorRed), cell.BgColor(cell.ColorBlue))
+
+	tests := []struct {
+		desc   string
+		point  image.Point
+		fg, bg cell.Color
+		diff   string
+	}{
+		{
+			desc:  "no diff when the colors match",
+			point: image.Point{0, 0},
+			fg:    cell.ColorRed,
+			bg:    cell.ColorBlue,
+		},
+		{
+			desc:  "no diff for default colors of an empty cell",
+			point: image.Point{1, 0},
+			fg:    cell.ColorDefault,
+			bg:    cell.ColorDefault,
+		},
+		{
+			desc:  "reports differing foreground color",
+			point: image.Point{0, 0},
+			fg:    cell.ColorGreen,
+			bg:    cell.ColorBlue,
+			diff:  "(0,0): want FgColor ColorGreen got ColorRed",
+		},
+		{
+			desc:  "reports both differing colors",
+			point: image.Point{1, 0},
+			fg:    cell.ColorRed,
+			bg:    cell.ColorBlue,
+			diff: "(1,0): want FgColor ColorRed got ColorDefault\n" +
+				"(1,0): want BgColor ColorBlue got ColorDefault",
+		},
+		{
+			desc:  "reports point outside of the terminal",
+			point: image.Point{2, 0},
+			diff:  "(2,0): the cell falls outside of the terminal area (0,0)-(2,1)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := CellColorDiff(ft, tc.point, tc.fg, tc.bg); got != tc.diff {
+				t.Errorf("CellColorDiff => got:\n%s\nwant:\n%s", got, tc.diff)
+			}
+		})
+	}
+}
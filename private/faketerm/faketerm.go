@@ -19,7 +19,6 @@ import (
 	"context"
 	"fmt"
 	"image"
-	"log"
 	"strings"
 	"sync"
 
@@ -61,7 +60,10 @@ type Terminal struct {
 	// events is a queue of input events.
 	events *eventqueue.Unbound
 
-	// mu protects the buffer.
+	// cursor is the position of the cursor, nil if the cursor is hidden.
+	cursor *image.Point
+
+	// mu protects the buffer and the cursor.
 	mu sync.Mutex
 }
 
@@ -171,12 +173,30 @@ func (t *Terminal) Flush() error {
 
 // SetCursor implements terminalapi.Terminal.SetCursor.
 func (t *Terminal) SetCursor(p image.Point) {
-	log.Fatal("unimplemented")
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.cursor = &p
 }
 
 // HideCursor implements terminalapi.Terminal.HideCursor.
 func (t *Terminal) HideCursor() {
-	log.Fatal("unimplemented")
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.cursor = nil
+}
+
+// Cursor returns the position of the cursor. The returned bool is false if
+// the cursor is hidden.
+func (t *Terminal) Cursor() (image.Point, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.cursor == nil {
+		return image.Point{}, false
+	}
+	return *t.cursor, true
 }
 
 // SetCell implements terminalapi.Terminal.SetCell.
@@ -0,0 +1,180 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package framebudget implements a terminal that limits the number of cell
+// changes forwarded to the underlying terminal on each flush.
+package framebudget
+
+import (
+	"fmt"
+	"image"
+	"sync"
+
+	"github.com/woodliu/termdash/cell"
+	"github.com/woodliu/termdash/terminal/terminalapi"
+)
+
+// cellState is the content of a single cell.
+type cellState struct {
+	r    rune
+	opts cell.Options
+}
+
+// Terminal wraps another terminal and limits the number of changed cells it
+// forwards to it on each call to Flush. Cells that didn't fit the budget
+// remain dirty and are forwarded on subsequent calls to Flush.
+//
+// Implements terminalapi.Terminal. This object is thread-safe.
+type Terminal struct {
+	terminalapi.Terminal
+
+	// maxCells is the maximum number of cells forwarded on each flush.
+	maxCells int
+
+	// want is the content of the cells as set by the callers.
+	// Indexed as [x][y], same as buffer.Buffer.
+	want [][]cellState
+	// have is the content of the cells as forwarded to the wrapped terminal.
+	have [][]cellState
+	// next is the index of the cell where the next flush starts looking for
+	// changed cells, counted row by row from the top left cell. Resuming
+	// where the previous flush stopped ensures that cells which change on
+	// every frame don't starve the remaining cells.
+	next int
+
+	// mu protects the Terminal.
+	mu sync.Mutex
+}
+
+// New returns a new Terminal that wraps the provided terminal and forwards at
+// most maxCells changed cells on each call to Flush.
+func New(t terminalapi.Terminal, maxCells int) (*Terminal, error) {
+	if maxCells < 1 {
+		return nil, fmt.Errorf("invalid maxCells %d, must be a positive integer", maxCells)
+	}
+	ft := &Terminal{
+		Terminal: t,
+		maxCells: maxCells,
+	}
+	ft.reset(cell.NewOptions())
+	return ft, nil
+}
+
+// newCells returns a new two-dimensional slice of cells of the provided size
+// with the provided options.
+func newCells(size image.Point, opts *cell.Options) [][]cellState {
+	cells := make([][]cellState, size.X)
+	for x := range cells {
+		cells[x] = make([]cellState, size.Y)
+		for y := range cells[x] {
+			cells[x][y].opts = *opts
+		}
+	}
+	return cells
+}
+
+// reset resets both the wanted and the forwarded content to empty cells with
+// the provided options, sized to the current size of the wrapped terminal.
+func (t *Terminal) reset(opts *cell.Options) {
+	size := t.Terminal.Size()
+	t.want = newCells(size, opts)
+	t.have = newCells(size, opts)
+	t.next = 0
+}
+
+// Clear implements terminalapi.Terminal.Clear.
+// The wrapped terminal is cleared immediately.
+func (t *Terminal) Clear(opts ...cell.Option) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.Terminal.Clear(opts...); err != nil {
+		return err
+	}
+	t.reset(cell.NewOptions(opts...))
+	return nil
+}
+
+// SetCell implements terminalapi.Terminal.SetCell.
+// The cell is only forwarded to the wrapped terminal on a call to Flush.
+func (t *Terminal) SetCell(p image.Point, r rune, opts ...cell.Option) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if p.X < 0 || p.X >= len(t.want) || p.Y < 0 || p.Y >= len(t.want[p.X]) {
+		// The wrapped terminal was resized and we weren't cleared yet.
+		// Let the wrapped terminal deal with the point.
+		return t.Terminal.SetCell(p, r, opts...)
+	}
+
+	c := &t.want[p.X][p.Y]
+	c.r = r
+	for _, opt := range opts {
+		opt.Set(&c.opts)
+	}
+	return nil
+}
+
+// Flush implements terminalapi.Terminal.Flush.
+// Forwards at most the configured number of changed cells to the wrapped
+// terminal and then flushes it. Each flush continues after the last cell
+// forwarded by the previous one and wraps around at the end of the terminal.
+func (t *Terminal) Flush() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var width, height int
+	if width = len(t.want); width > 0 {
+		height = len(t.want[0])
+	}
+	total := width * height
+
+	var sent int
+	for i := 0; i < total && sent < t.maxCells; i++ {
+		idx := (t.next + i) % total
+		x, y := idx%width, idx/width
+		w := t.want[x][y]
+		if w == t.have[x][y] {
+			continue
+		}
+
+		opts := w.opts
+		if err := t.Terminal.SetCell(image.Point{x, y}, w.r, &opts); err != nil {
+			return err
+		}
+		t.have[x][y] = w
+		sent++
+		if sent == t.maxCells {
+			t.next = (idx + 1) % total
+		}
+	}
+	return t.Terminal.Flush()
+}
+
+// Dirty returns the number of changed cells that weren't forwarded to the
+// wrapped terminal yet.
+func (t *Terminal) Dirty() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var dirty int
+	for x := range t.want {
+		for y := range t.want[x] {
+			if t.want[x][y] != t.have[x][y] {
+				dirty++
+			}
+		}
+	}
+	return dirty
+}
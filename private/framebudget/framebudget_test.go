@@ -0,0 +1,261 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framebudget
+
+import (
+	"image"
+	"testing"
+
+	"github.com/woodliu/termdash/cell"
+	"github.com/woodliu/termdash/private/faketerm"
+)
+
+// setCell is a call to SetCell.
+type setCell struct {
+	p    image.Point
+	r    rune
+	opts []cell.Option
+}
+
+func TestTerminal(t *testing.T) {
+	tests := []struct {
+		desc     string
+		size     image.Point
+		maxCells int
+		// frames are the cells set before each call to Flush.
+		frames    [][]setCell
+		want      func(size image.Point) *faketerm.Terminal
+		wantDirty int
+		wantErr   bool
+	}{
+		{
+			desc:     "fails on zero maxCells",
+			size:     image.Point{3, 2},
+			maxCells: 0,
+			wantErr:  true,
+		},
+		{
+			desc:     "forwards all cells that fit the budget",
+			size:     image.Point{3, 2},
+			maxCells: 6,
+			frames: [][]setCell{
+				{
+					{p: image.Point{0, 0}, r: 'a'},
+					{p: image.Point{2, 1}, r: 'b', opts: []cell.Option{cell.FgColor(cell.ColorRed)}},
+				},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				ft.SetCell(image.Point{0, 0}, 'a')
+				ft.SetCell(image.Point{2, 1}, 'b', cell.FgColor(cell.ColorRed))
+				return ft
+			},
+		},
+		{
+			desc:     "defers cells beyond the budget in row-major order",
+			size:     image.Point{3, 2},
+			maxCells: 2,
+			frames: [][]setCell{
+				{
+					{p: image.Point{0, 1}, r: 'c'},
+					{p: image.Point{1, 0}, r: 'b'},
+					{p: image.Point{0, 0}, r: 'a'},
+				},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				ft.SetCell(image.Point{0, 0}, 'a')
+				ft.SetCell(image.Point{1, 0}, 'b')
+				return ft
+			},
+			wantDirty: 1,
+		},
+		{
+			desc:     "forwards deferred cells on the next flush",
+			size:     image.Point{3, 2},
+			maxCells: 2,
+			frames: [][]setCell{
+				{
+					{p: image.Point{0, 1}, r: 'c'},
+					{p: image.Point{1, 0}, r: 'b'},
+					{p: image.Point{0, 0}, r: 'a'},
+				},
+				nil,
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				ft.SetCell(image.Point{0, 0}, 'a')
+				ft.SetCell(image.Point{1, 0}, 'b')
+				ft.SetCell(image.Point{0, 1}, 'c')
+				return ft
+			},
+		},
+		{
+			desc:     "doesn't spend the budget on unchanged cells",
+			size:     image.Point{3, 2},
+			maxCells: 1,
+			frames: [][]setCell{
+				{
+					{p: image.Point{0, 0}, r: 'a'},
+				},
+				{
+					{p: image.Point{0, 0}, r: 'a'},
+					{p: image.Point{1, 0}, r: 'b'},
+				},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				ft.SetCell(image.Point{0, 0}, 'a')
+				ft.SetCell(image.Point{1, 0}, 'b')
+				return ft
+			},
+		},
+		{
+			desc:     "cell with changed options only is dirty",
+			size:     image.Point{3, 2},
+			maxCells: 1,
+			frames: [][]setCell{
+				{
+					{p: image.Point{0, 0}, r: 'a'},
+				},
+				{
+					{p: image.Point{0, 0}, r: 'a', opts: []cell.Option{cell.BgColor(cell.ColorBlue)}},
+				},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				ft.SetCell(image.Point{0, 0}, 'a', cell.BgColor(cell.ColorBlue))
+				return ft
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := faketerm.MustNew(tc.size)
+			bt, err := New(got, tc.maxCells)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("New => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			for _, frame := range tc.frames {
+				for _, sc := range frame {
+					if err := bt.SetCell(sc.p, sc.r, sc.opts...); err != nil {
+						t.Fatalf("SetCell => unexpected error: %v", err)
+					}
+				}
+				if err := bt.Flush(); err != nil {
+					t.Fatalf("Flush => unexpected error: %v", err)
+				}
+			}
+
+			if diff := faketerm.Diff(tc.want(tc.size), got); diff != "" {
+				t.Errorf("Flush => %v", diff)
+			}
+			if gotDirty := bt.Dirty(); gotDirty != tc.wantDirty {
+				t.Errorf("Dirty => %d, want %d", gotDirty, tc.wantDirty)
+			}
+		})
+	}
+}
+
+func TestClear(t *testing.T) {
+	got := faketerm.MustNew(image.Point{3, 2})
+	bt, err := New(got, 1)
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+
+	if err := bt.SetCell(image.Point{0, 0}, 'a'); err != nil {
+		t.Fatalf("SetCell => unexpected error: %v", err)
+	}
+	if err := bt.SetCell(image.Point{1, 0}, 'b'); err != nil {
+		t.Fatalf("SetCell => unexpected error: %v", err)
+	}
+	if err := bt.Flush(); err != nil {
+		t.Fatalf("Flush => unexpected error: %v", err)
+	}
+
+	// Clearing discards the deferred cell and clears the wrapped terminal.
+	if err := bt.Clear(); err != nil {
+		t.Fatalf("Clear => unexpected error: %v", err)
+	}
+	if err := bt.Flush(); err != nil {
+		t.Fatalf("Flush => unexpected error: %v", err)
+	}
+
+	want := faketerm.MustNew(image.Point{3, 2})
+	if diff := faketerm.Diff(want, got); diff != "" {
+		t.Errorf("Clear => %v", diff)
+	}
+	if gotDirty := bt.Dirty(); gotDirty != 0 {
+		t.Errorf("Dirty => %d, want 0", gotDirty)
+	}
+}
+
+func TestFlushDoesNotStarve(t *testing.T) {
+	size := image.Point{4, 3}
+	got := faketerm.MustNew(size)
+	bt, err := New(got, size.X)
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+
+	// The bottom rows are set once.
+	for y := 1; y < size.Y; y++ {
+		for x := 0; x < size.X; x++ {
+			if err := bt.SetCell(image.Point{x, y}, 'b'); err != nil {
+				t.Fatalf("SetCell => unexpected error: %v", err)
+			}
+		}
+	}
+
+	// The top row changes on every frame and alone uses the whole budget.
+	// Each row takes one flush, so all the rows are forwarded within as
+	// many flushes as there are rows.
+	for i := 0; i < size.Y; i++ {
+		r := 'c'
+		if i%2 == 0 {
+			r = 'd'
+		}
+		for x := 0; x < size.X; x++ {
+			if err := bt.SetCell(image.Point{x, 0}, r); err != nil {
+				t.Fatalf("SetCell => unexpected error: %v", err)
+			}
+		}
+		if err := bt.Flush(); err != nil {
+			t.Fatalf("Flush => unexpected error: %v", err)
+		}
+	}
+
+	want := faketerm.MustNew(size)
+	for y := 0; y < size.Y; y++ {
+		for x := 0; x < size.X; x++ {
+			r := 'b'
+			if y == 0 {
+				r = 'd'
+			}
+			if err := want.SetCell(image.Point{x, y}, r); err != nil {
+				t.Fatalf("SetCell => unexpected error: %v", err)
+			}
+		}
+	}
+	if diff := faketerm.Diff(want, got); diff != "" {
+		t.Errorf("Flush => %v", diff)
+	}
+}
@@ -45,13 +45,14 @@ type attributes struct {
 }
 
 // newAttributes calculates attributes needed to place the segments for the
-// provided pixel area.
-func newAttributes(bcAr image.Rectangle) *attributes {
-	segSize := segdisp.SegmentSize(bcAr)
+// provided pixel area. The segment and gap sizes are scaled by the provided
+// percentages.
+func newAttributes(bcAr image.Rectangle, segScalePerc, gapScalePerc int) *attributes {
+	segSize := segdisp.ScaledSegmentSize(bcAr, segScalePerc)
 	return &attributes{
 		bcAr:    bcAr,
 		segSize: segSize,
-		sixteen: sixteen.NewAttributes(bcAr),
+		sixteen: sixteen.NewScaledAttributes(bcAr, segScalePerc, gapScalePerc),
 	}
 }
 
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/kylelemons/godebug/pretty"
+	"github.com/woodliu/termdash/private/segdisp"
 )
 
 func TestAttributes(t *testing.T) {
@@ -39,7 +40,7 @@ func TestAttributes(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
-			attr := newAttributes(tc.brailleAr)
+			attr := newAttributes(tc.brailleAr, segdisp.DefaultScalePercent, segdisp.DefaultScalePercent)
 			got, err := attr.segArea(tc.seg)
 			if (err != nil) != tc.wantErr {
 				t.Errorf("segArea => unexpected error: %v, wantErr: %v", err, tc.wantErr)
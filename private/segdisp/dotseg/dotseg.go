@@ -39,10 +39,12 @@ package dotseg
 
 import (
 	"fmt"
+	"image"
 	"strings"
 
 	"github.com/woodliu/termdash/cell"
 	"github.com/woodliu/termdash/private/canvas"
+	"github.com/woodliu/termdash/private/canvas/braille"
 	"github.com/woodliu/termdash/private/segdisp"
 	"github.com/woodliu/termdash/private/segdisp/segment"
 )
@@ -123,20 +125,44 @@ func CellOpts(cOpts ...cell.Option) Option {
 	})
 }
 
+// SegmentScale scales the size of the segments, i.e. the width of a vertical
+// or the height of a horizontal segment, by the provided percentage.
+// Must be a positive integer, defaults to segdisp.DefaultScalePercent which
+// results in the default size.
+func SegmentScale(perc int) Option {
+	return option(func(d *Display) {
+		d.segScalePerc = perc
+	})
+}
+
+// GapScale scales the size of the gaps between the segments by the provided
+// percentage. Must be a positive or zero integer, defaults to
+// segdisp.DefaultScalePercent which results in the default size. The gaps are
+// always at least one pixel wide so that the segments don't visually blend.
+func GapScale(perc int) Option {
+	return option(func(d *Display) {
+		d.gapScalePerc = perc
+	})
+}
+
 // Display represents the segment display.
 // This object is not thread-safe.
 type Display struct {
 	// segments maps segments to their current status.
 	segments map[Segment]bool
 
-	cellOpts []cell.Option
+	cellOpts     []cell.Option
+	segScalePerc int
+	gapScalePerc int
 }
 
 // New creates a new segment display.
 // Initially all the segments are off.
 func New(opts ...Option) *Display {
 	d := &Display{
-		segments: map[Segment]bool{},
+		segments:     map[Segment]bool{},
+		segScalePerc: segdisp.DefaultScalePercent,
+		gapScalePerc: segdisp.DefaultScalePercent,
 	}
 
 	for _, opt := range opts {
@@ -206,6 +232,37 @@ func (d *Display) SetCharacter(c rune) error {
 	return nil
 }
 
+// Columns returns the range of columns of cells that contain the dots when
+// the display is drawn onto a canvas of the provided size with the provided
+// SegmentScale. The range is zero based relative to the canvas, Min.X is
+// inclusive and Max.X exclusive. The height of the returned rectangle is the
+// height of the canvas.
+// Useful to draw the characters this display supports in narrower columns than
+// other segment displays.
+func Columns(size image.Point, segScalePerc int) (image.Rectangle, error) {
+	if segScalePerc < 1 {
+		return image.ZR, fmt.Errorf("invalid SegmentScale %d, must be a positive integer", segScalePerc)
+	}
+	cvs, err := canvas.New(image.Rect(0, 0, size.X, size.Y))
+	if err != nil {
+		return image.ZR, err
+	}
+	_, bcAr, err := segdisp.ToBraille(cvs)
+	if err != nil {
+		return image.ZR, err
+	}
+
+	// All the dots are horizontally aligned at the same position.
+	attr := newAttributes(bcAr, segScalePerc, segdisp.DefaultScalePercent)
+	dotAr, err := attr.segArea(D1)
+	if err != nil {
+		return image.ZR, err
+	}
+	minX := dotAr.Min.X / braille.ColMult
+	maxX := (dotAr.Max.X + braille.ColMult - 1) / braille.ColMult
+	return image.Rect(minX, 0, maxX, size.Y), nil
+}
+
 // Draw draws the current state of the segment display onto the canvas.
 // The canvas must be at least MinCols x MinRows cells, or an error will be
 // returned.
@@ -214,13 +271,19 @@ func (d *Display) Draw(cvs *canvas.Canvas, opts ...Option) error {
 	for _, o := range opts {
 		o.set(d)
 	}
+	if d.segScalePerc < 1 {
+		return fmt.Errorf("invalid SegmentScale %d, must be a positive integer", d.segScalePerc)
+	}
+	if d.gapScalePerc < 0 {
+		return fmt.Errorf("invalid GapScale %d, must be a positive or zero integer", d.gapScalePerc)
+	}
 
 	bc, bcAr, err := segdisp.ToBraille(cvs)
 	if err != nil {
 		return err
 	}
 
-	attr := newAttributes(bcAr)
+	attr := newAttributes(bcAr, d.segScalePerc, d.gapScalePerc)
 	for seg, isSet := range d.segments {
 		if !isSet {
 			continue
@@ -82,6 +82,18 @@ func TestDraw(t *testing.T) {
 			cellCanvas: image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows-1),
 			wantErr:    true,
 		},
+		{
+			desc:       "fails on invalid SegmentScale",
+			opts:       []Option{SegmentScale(0)},
+			cellCanvas: image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows),
+			wantErr:    true,
+		},
+		{
+			desc:       "fails on invalid GapScale",
+			drawOpts:   []Option{GapScale(-1)},
+			cellCanvas: image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows),
+			wantErr:    true,
+		},
 		{
 			desc:       "fails to set invalid segment (too small)",
 			cellCanvas: image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows),
@@ -600,3 +612,59 @@ func TestSupportedsChars(t *testing.T) {
 		t.Errorf("SupportedChars => unexpected diff (-want, +got):\n%s", diff)
 	}
 }
+
+func TestColumns(t *testing.T) {
+	tests := []struct {
+		desc         string
+		size         image.Point
+		segScalePerc int
+		want         image.Rectangle
+		wantErr      bool
+	}{
+		{
+			desc:         "fails on invalid segment scale",
+			size:         image.Point{segdisp.MinCols, segdisp.MinRows},
+			segScalePerc: 0,
+			wantErr:      true,
+		},
+		{
+			desc:         "fails when the canvas is too small",
+			size:         image.Point{segdisp.MinCols - 1, segdisp.MinRows},
+			segScalePerc: segdisp.DefaultScalePercent,
+			wantErr:      true,
+		},
+		{
+			desc:         "smallest display",
+			size:         image.Point{segdisp.MinCols, segdisp.MinRows},
+			segScalePerc: segdisp.DefaultScalePercent,
+			want:         image.Rect(2, 0, 4, segdisp.MinRows),
+		},
+		{
+			desc:         "larger display",
+			size:         image.Point{24, 20},
+			segScalePerc: segdisp.DefaultScalePercent,
+			want:         image.Rect(9, 0, 15, 20),
+		},
+		{
+			desc:         "larger segments take more columns",
+			size:         image.Point{24, 20},
+			segScalePerc: 200,
+			want:         image.Rect(7, 0, 17, 20),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := Columns(tc.size, tc.segScalePerc)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Columns => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got != tc.want {
+				t.Errorf("Columns => %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
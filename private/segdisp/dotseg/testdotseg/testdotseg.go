@@ -17,6 +17,7 @@ package testdotseg
 
 import (
 	"fmt"
+	"image"
 
 	"github.com/woodliu/termdash/private/canvas"
 	"github.com/woodliu/termdash/private/segdisp/dotseg"
@@ -35,3 +36,12 @@ func MustDraw(d *dotseg.Display, cvs *canvas.Canvas, opts ...dotseg.Option) {
 		panic(fmt.Errorf("dotseg.Display.Draw => unexpected error: %v", err))
 	}
 }
+
+// MustColumns returns the columns that contain the dots or panics.
+func MustColumns(size image.Point, segScalePerc int) image.Rectangle {
+	cols, err := dotseg.Columns(size, segScalePerc)
+	if err != nil {
+		panic(fmt.Errorf("dotseg.Columns => unexpected error: %v", err))
+	}
+	return cols
+}
@@ -73,13 +73,29 @@ func ToBraille(cvs *canvas.Canvas) (*braille.Canvas, image.Rectangle, error) {
 	return bc, area.WithRatio(bc.Area(), aspectRatio), nil
 }
 
+// DefaultScalePercent is the scale percentage that results in the default
+// segment size or gap size.
+const DefaultScalePercent = 100
+
 // SegmentSize given an area for the display segment determines the size of
 // individual segments, i.e. the width of a vertical or the height of a
 // horizontal segment.
 func SegmentSize(ar image.Rectangle) int {
+	return ScaledSegmentSize(ar, DefaultScalePercent)
+}
+
+// ScaledSegmentSize is like SegmentSize, but the determined size is scaled by
+// the provided percentage. A scale of DefaultScalePercent results in the same
+// size as returned by SegmentSize. Scaling never reduces the size below one
+// pixel.
+func ScaledSegmentSize(ar image.Rectangle, scalePerc int) int {
 	// widthPerc is the relative width of a segment to the width of the canvas.
 	const widthPerc = 9
-	s := int(math.Round(float64(ar.Dx()) * widthPerc / 100))
+	base := float64(ar.Dx()) * widthPerc / 100
+	s := int(math.Round(base * float64(scalePerc) / 100))
+	if s < 1 && math.Round(base) >= 1 {
+		s = 1
+	}
 	if s > 3 && s%2 == 0 {
 		// Segments with odd number of pixels in their width/height look
 		// better, since the spike at the top of their slopes has only one
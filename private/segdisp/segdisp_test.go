@@ -168,3 +168,52 @@ func TestSegmentSize(t *testing.T) {
 		})
 	}
 }
+
+func TestScaledSegmentSize(t *testing.T) {
+	tests := []struct {
+		desc      string
+		ar        image.Rectangle
+		scalePerc int
+		want      int
+	}{
+		{
+			desc:      "default scale",
+			ar:        image.Rect(0, 0, 55, 1),
+			scalePerc: DefaultScalePercent,
+			want:      5,
+		},
+		{
+			desc:      "double size, lands on even width, corrected to odd",
+			ar:        image.Rect(0, 0, 55, 1),
+			scalePerc: 200,
+			want:      11,
+		},
+		{
+			desc:      "half size",
+			ar:        image.Rect(0, 0, 66, 1),
+			scalePerc: 50,
+			want:      3,
+		},
+		{
+			desc:      "never scales below one pixel",
+			ar:        image.Rect(0, 0, 15, 1),
+			scalePerc: 10,
+			want:      1,
+		},
+		{
+			desc:      "zero area",
+			ar:        image.ZR,
+			scalePerc: 200,
+			want:      0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := ScaledSegmentSize(tc.ar, tc.scalePerc)
+			if got != tc.want {
+				t.Errorf("ScaledSegmentSize => %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
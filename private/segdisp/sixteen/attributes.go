@@ -100,14 +100,21 @@ type Attributes struct {
 // NewAttributes calculates attributes needed to place the segments for the
 // provided pixel area.
 func NewAttributes(bcAr image.Rectangle) *Attributes {
-	segSize := segdisp.SegmentSize(bcAr)
+	return NewScaledAttributes(bcAr, segdisp.DefaultScalePercent, segdisp.DefaultScalePercent)
+}
+
+// NewScaledAttributes is like NewAttributes, but scales the size of the
+// segments and the size of the gaps between them by the provided percentages.
+// Scale of segdisp.DefaultScalePercent results in the default sizes.
+func NewScaledAttributes(bcAr image.Rectangle, segScalePerc, gapScalePerc int) *Attributes {
+	segSize := segdisp.ScaledSegmentSize(bcAr, segScalePerc)
 
 	// diaPerc is the size of the diaGap in percentage of the segment's size.
 	const diaPerc = 40
 	// Ensure there is at least one pixel diagonally between segments so they
 	// don't visually blend.
 	_, dg := numbers.MinMaxInts([]int{
-		int(float64(segSize) * diaPerc / 100),
+		int(float64(segSize) * diaPerc / 100 * float64(gapScalePerc) / 100),
 		1,
 	})
 	diaGap := float64(dg)
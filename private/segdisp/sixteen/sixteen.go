@@ -289,20 +289,44 @@ func CellOpts(cOpts ...cell.Option) Option {
 	})
 }
 
+// SegmentScale scales the size of the segments, i.e. the width of a vertical
+// or the height of a horizontal segment, by the provided percentage.
+// Must be a positive integer, defaults to segdisp.DefaultScalePercent which
+// results in the default size.
+func SegmentScale(perc int) Option {
+	return option(func(d *Display) {
+		d.segScalePerc = perc
+	})
+}
+
+// GapScale scales the size of the gaps between the segments by the provided
+// percentage. Must be a positive or zero integer, defaults to
+// segdisp.DefaultScalePercent which results in the default size. The gaps are
+// always at least one pixel wide so that the segments don't visually blend.
+func GapScale(perc int) Option {
+	return option(func(d *Display) {
+		d.gapScalePerc = perc
+	})
+}
+
 // Display represents the segment display.
 // This object is not thread-safe.
 type Display struct {
 	// segments maps segments to their current status.
 	segments map[Segment]bool
 
-	cellOpts []cell.Option
+	cellOpts     []cell.Option
+	segScalePerc int
+	gapScalePerc int
 }
 
 // New creates a new segment display.
 // Initially all the segments are off.
 func New(opts ...Option) *Display {
 	d := &Display{
-		segments: map[Segment]bool{},
+		segments:     map[Segment]bool{},
+		segScalePerc: segdisp.DefaultScalePercent,
+		gapScalePerc: segdisp.DefaultScalePercent,
 	}
 
 	for _, opt := range opts {
@@ -381,13 +405,19 @@ func (d *Display) Draw(cvs *canvas.Canvas, opts ...Option) error {
 	for _, o := range opts {
 		o.set(d)
 	}
+	if d.segScalePerc < 1 {
+		return fmt.Errorf("invalid SegmentScale %d, must be a positive integer", d.segScalePerc)
+	}
+	if d.gapScalePerc < 0 {
+		return fmt.Errorf("invalid GapScale %d, must be a positive or zero integer", d.gapScalePerc)
+	}
 
 	bc, bcAr, err := segdisp.ToBraille(cvs)
 	if err != nil {
 		return err
 	}
 
-	attr := NewAttributes(bcAr)
+	attr := NewScaledAttributes(bcAr, d.segScalePerc, d.gapScalePerc)
 	var sOpts []segment.Option
 	if len(d.cellOpts) > 0 {
 		sOpts = append(sOpts, segment.CellOpts(d.cellOpts...))
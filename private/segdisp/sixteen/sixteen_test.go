@@ -54,6 +54,18 @@ func TestDraw(t *testing.T) {
 			cellCanvas: image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows-1),
 			wantErr:    true,
 		},
+		{
+			desc:       "fails on invalid SegmentScale",
+			opts:       []Option{SegmentScale(0)},
+			cellCanvas: image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows),
+			wantErr:    true,
+		},
+		{
+			desc:       "fails on invalid GapScale",
+			drawOpts:   []Option{GapScale(-1)},
+			cellCanvas: image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows),
+			wantErr:    true,
+		},
 		{
 			desc:       "fails to set invalid segment (too small)",
 			cellCanvas: image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows),
@@ -27,11 +27,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"image"
 	"sync"
 	"time"
 
 	"github.com/woodliu/termdash/container"
+	"github.com/woodliu/termdash/keyboard"
 	"github.com/woodliu/termdash/private/event"
+	"github.com/woodliu/termdash/private/framebudget"
 	"github.com/woodliu/termdash/terminal/terminalapi"
 )
 
@@ -55,6 +58,8 @@ func (o option) set(td *termdash) {
 // RedrawInterval sets how often termdash redraws the container and all the widgets.
 // Defaults to DefaultRedrawInterval. Use the controller to disable the
 // periodic redraw.
+// Widgets can request a shorter interval via
+// widgetapi.Options.RedrawInterval, the shortest of the intervals is used.
 func RedrawInterval(t time.Duration) Option {
 	return option(func(td *termdash) {
 		td.redrawInterval = t
@@ -62,8 +67,12 @@ func RedrawInterval(t time.Duration) Option {
 }
 
 // ErrorHandler is used to provide a function that will be called with all
-// errors that occur while the dashboard is running. If not provided, any
-// errors panic the application.
+// errors that occur while the dashboard is running. This includes errors
+// returned by the widgets from their Draw, Keyboard and Mouse methods, the
+// errors identify the widget and the ID of its container if one was set.
+// If provided, Run reports drawing errors to the handler and keeps running
+// instead of returning them. If not provided, errors that occur while
+// processing input events panic the application.
 // The provided function must be thread-safe.
 func ErrorHandler(f func(error)) Option {
 	return option(func(td *termdash) {
@@ -80,6 +89,40 @@ func KeyboardSubscriber(f func(*terminalapi.Keyboard)) Option {
 	})
 }
 
+// KeyBinding is an application-wide action bound to a key, see KeyBindings.
+type KeyBinding struct {
+	// Action is called each time the key is pressed.
+	Action func()
+	// Modifiers are the modifier keys that must be held while the key is
+	// pressed. The binding only matches events with exactly these modifiers,
+	// e.g. a binding without modifiers doesn't match Ctrl or Alt and the key.
+	Modifiers keyboard.Modifier
+	// Consume indicates that the keyboard event is consumed by the binding
+	// and isn't forwarded to the container, the widgets and the
+	// KeyboardSubscriber.
+	Consume bool
+}
+
+// KeyBindings registers application-wide actions for keys. The bindings are
+// dispatched by the event loop before the keyboard event is routed to the
+// container and the widgets. Unless the binding consumes the event, the event
+// is then forwarded as usual. The screen is redrawn after the action returns.
+//
+// The actions are called on the goroutine that processes input events, so
+// they must not block and must be thread-safe.
+// Can be provided multiple times, later bindings for the same key replace
+// earlier ones.
+func KeyBindings(bindings map[keyboard.Key]KeyBinding) Option {
+	return option(func(td *termdash) {
+		if td.keyBindings == nil {
+			td.keyBindings = map[keyboard.Key]KeyBinding{}
+		}
+		for k, b := range bindings {
+			td.keyBindings[k] = b
+		}
+	})
+}
+
 // MouseSubscriber registers a subscriber for Mouse events. Each mouse event
 // is forwarded to the container and the registered subscriber.
 // The provided function must be thread-safe.
@@ -89,6 +132,51 @@ func MouseSubscriber(f func(*terminalapi.Mouse)) Option {
 	})
 }
 
+// RedrawTrigger provides a channel that triggers an immediate redraw of the
+// terminal each time a value is received from it, in addition to the periodic
+// redraws configured by RedrawInterval. Allows applications that update
+// their widgets on events to redraw on demand and use a long RedrawInterval.
+// Closing the channel stops the triggered redraws, the periodic redraws
+// continue.
+// Only has effect with Run, use Controller.Redraw with the Controller.
+func RedrawTrigger(ch <-chan struct{}) Option {
+	return option(func(td *termdash) {
+		td.redrawTrigger = ch
+	})
+}
+
+// ResizeSubscriber registers a subscriber for terminal resize events. The
+// subscriber receives the new size of the terminal each time the terminal is
+// resized. The subscriber is called on a goroutine dedicated to it, so it
+// doesn't block the redraw loop, but the redraw that follows the resize
+// might happen before the subscriber returns.
+// The provided function must be thread-safe.
+func ResizeSubscriber(f func(image.Point)) Option {
+	return option(func(td *termdash) {
+		td.resizeSubscriber = f
+	})
+}
+
+// MaxCellsPerFrame limits the number of changed cells that are flushed to the
+// terminal on each redraw. Any remaining changed cells are deferred to the
+// following redraws. This spreads expensive full redraws of very large
+// terminals across multiple frames and keeps the dashboard responsive to
+// input, at the cost of the screen being temporarily partially updated.
+//
+// When using the Controller, the deferred cells are only flushed on
+// subsequent calls to Redraw.
+//
+// The container draws through the terminal that limits the cells only while
+// termdash runs, it is switched back to the provided terminal when Run returns
+// or when the Controller is closed. Deferred cells that weren't flushed by
+// then are dropped.
+// Zero or a negative value means no limit, which is the default.
+func MaxCellsPerFrame(n int) Option {
+	return option(func(td *termdash) {
+		td.maxCellsPerFrame = n
+	})
+}
+
 // withEDS indicates that termdash should run with the provided event
 // distribution system instead of creating one.
 // Useful for tests.
@@ -103,9 +191,12 @@ func withEDS(eds *event.DistributionSystem) Option {
 // Controller instead.
 // Blocks until the context expires.
 func Run(ctx context.Context, t terminalapi.Terminal, c *container.Container, opts ...Option) error {
-	td := newTermdash(t, c, opts...)
+	td, err := newTermdash(t, c, opts...)
+	if err != nil {
+		return err
+	}
 
-	err := td.start(ctx)
+	err = td.start(ctx)
 	// Only return the status (error or nil) after the termdash event
 	// processing goroutine actually exits.
 	td.stop()
@@ -125,24 +216,34 @@ type Controller struct {
 // option is ignored.
 // Close the controller when it isn't needed anymore.
 func NewController(t terminalapi.Terminal, c *container.Container, opts ...Option) (*Controller, error) {
+	td, err := newTermdash(t, c, opts...)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	ctrl := &Controller{
-		td:     newTermdash(t, c, opts...),
+		td:     td,
 		cancel: cancel,
 	}
 
 	// stops when Close() is called.
 	go ctrl.td.processEvents(ctx)
+	go ctrl.td.processRedraws(ctx)
 	if err := ctrl.td.periodicRedraw(); err != nil {
+		ctrl.Close()
 		return nil, err
 	}
 	return ctrl, nil
 }
 
+// errNotRunning is returned by the Controller after it was closed.
+var errNotRunning = errors.New("the termdash instance is no longer running, this controller is now invalid")
+
 // Redraw triggers redraw of the terminal.
 func (c *Controller) Redraw() error {
 	if c.td == nil {
-		return errors.New("the termdash instance is no longer running, this controller is now invalid")
+		return errNotRunning
 	}
 
 	c.td.mu.Lock()
@@ -150,6 +251,26 @@ func (c *Controller) Redraw() error {
 	return c.td.redraw()
 }
 
+// Suspend stops termdash from drawing, e.g. before running a subshell or an
+// external editor. If the terminal implements terminalapi.Suspender, it is
+// suspended too, which returns the screen to its original state. Redraws
+// requested while suspended are skipped. Does nothing if already suspended.
+func (c *Controller) Suspend() error {
+	if c.td == nil {
+		return errNotRunning
+	}
+	return c.td.suspend()
+}
+
+// Resume reverses Suspend, resuming the terminal and forcing a full redraw.
+// Does nothing if not suspended.
+func (c *Controller) Resume() error {
+	if c.td == nil {
+		return errNotRunning
+	}
+	return c.td.resume()
+}
+
 // Close closes the Controller and its termdash instance.
 func (c *Controller) Close() {
 	c.cancel()
@@ -162,6 +283,10 @@ func (c *Controller) Close() {
 type termdash struct {
 	// term is the terminal the dashboard runs on.
 	term terminalapi.Terminal
+	// userTerm is the terminal provided by the user. Differs from term if
+	// term wraps it, in which case the container is switched back to
+	// userTerm when termdash stops.
+	userTerm terminalapi.Terminal
 
 	// container maintains terminal splits and places widgets.
 	container *container.Container
@@ -174,38 +299,69 @@ type termdash struct {
 	closeCh chan struct{}
 	// exitCh gets closed when the event collecting goroutine actually exits.
 	exitCh chan struct{}
+	// redrawCh requests a redraw from the goroutine that draws the screen.
+	// Buffered so that requests made while a redraw is pending are merged.
+	redrawCh chan struct{}
+	// redrawnCh is notified after each redraw, since the widgets can change
+	// the redraw interval they request. Buffered like redrawCh.
+	redrawnCh chan struct{}
 
 	// clearNeeded indicates if the terminal needs to be cleared next time
 	// we're drawing it. Terminal needs to be cleared if its sized changed.
 	clearNeeded bool
 
+	// suspender suspends and resumes the terminal, nil if the terminal
+	// doesn't support it.
+	suspender terminalapi.Suspender
+	// suspended indicates that drawing is suspended.
+	suspended bool
+
 	// mu protects termdash.
 	mu sync.Mutex
 
 	// Options.
 	redrawInterval     time.Duration
+	redrawTrigger      <-chan struct{}
+	maxCellsPerFrame   int
 	errorHandler       func(error)
 	mouseSubscriber    func(*terminalapi.Mouse)
 	keyboardSubscriber func(*terminalapi.Keyboard)
+	keyBindings        map[keyboard.Key]KeyBinding
+	resizeSubscriber   func(image.Point)
 }
 
 // newTermdash creates a new termdash.
-func newTermdash(t terminalapi.Terminal, c *container.Container, opts ...Option) *termdash {
+func newTermdash(t terminalapi.Terminal, c *container.Container, opts ...Option) (*termdash, error) {
 	td := &termdash{
 		term:           t,
+		userTerm:       t,
 		container:      c,
 		eds:            event.NewDistributionSystem(),
 		closeCh:        make(chan struct{}),
 		exitCh:         make(chan struct{}),
+		redrawCh:       make(chan struct{}, 1),
+		redrawnCh:      make(chan struct{}, 1),
 		redrawInterval: DefaultRedrawInterval,
 	}
 
 	for _, opt := range opts {
 		opt.set(td)
 	}
+	// Checked before the terminal gets wrapped below.
+	if s, ok := t.(terminalapi.Suspender); ok {
+		td.suspender = s
+	}
+	if td.maxCellsPerFrame > 0 {
+		bt, err := framebudget.New(t, td.maxCellsPerFrame)
+		if err != nil {
+			return nil, err
+		}
+		td.term = bt
+		c.SetTerminal(bt)
+	}
 	td.subscribers()
 	c.Subscribe(td.eds)
-	return td
+	return td, nil
 }
 
 // subscribers subscribes event receivers that live in this package to EDS.
@@ -227,10 +383,12 @@ func (td *termdash) subscribers() {
 		&terminalapi.Keyboard{},
 		&terminalapi.Mouse{},
 	}, func(terminalapi.Event) {
-		td.evRedraw()
+		// Without an error handler, drawing errors surface from Run's next
+		// periodic redraw or from Controller.Redraw instead.
+		td.drawErr(td.evRedraw())
 	}, event.MaxRepetitive(0)) // No repetitive events that cause terminal redraw.
 
-	// Keyboard and Mouse subscribers specified via options.
+	// Keyboard, Mouse and Resize subscribers specified via options.
 	if td.keyboardSubscriber != nil {
 		td.eds.Subscribe([]terminalapi.Event{&terminalapi.Keyboard{}}, func(ev terminalapi.Event) {
 			td.keyboardSubscriber(ev.(*terminalapi.Keyboard))
@@ -241,6 +399,11 @@ func (td *termdash) subscribers() {
 			td.mouseSubscriber(ev.(*terminalapi.Mouse))
 		})
 	}
+	if td.resizeSubscriber != nil {
+		td.eds.Subscribe([]terminalapi.Event{&terminalapi.Resize{}}, func(ev terminalapi.Event) {
+			td.resizeSubscriber(ev.(*terminalapi.Resize).Size)
+		})
+	}
 }
 
 // handleError forwards the error to the error handler if one was
@@ -253,6 +416,27 @@ func (td *termdash) handleError(err error) {
 	}
 }
 
+// effectiveInterval returns the interval of the periodic redraw, i.e. the
+// shorter of the RedrawInterval option and the intervals requested by the
+// widgets.
+func (td *termdash) effectiveInterval() time.Duration {
+	if wi := td.container.RedrawInterval(); wi > 0 && wi < td.redrawInterval {
+		return wi
+	}
+	return td.redrawInterval
+}
+
+// drawErr forwards a drawing error to the error handler if one was provided
+// and returns nil so that the dashboard keeps running. Returns the error
+// otherwise.
+func (td *termdash) drawErr(err error) error {
+	if err == nil || td.errorHandler == nil {
+		return err
+	}
+	td.errorHandler(err)
+	return nil
+}
+
 // setClearNeeded flags that the terminal needs to be cleared next time we're
 // drawing it.
 func (td *termdash) setClearNeeded() {
@@ -262,8 +446,12 @@ func (td *termdash) setClearNeeded() {
 }
 
 // redraw redraws the container and its widgets.
+// Does nothing while suspended.
 // The caller must hold td.mu.
 func (td *termdash) redraw() error {
+	if td.suspended {
+		return nil
+	}
 	if td.clearNeeded {
 		if err := td.term.Clear(); err != nil {
 			return fmt.Errorf("term.Clear => error: %v", err)
@@ -271,6 +459,8 @@ func (td *termdash) redraw() error {
 		td.clearNeeded = false
 	}
 
+	// Widgets that want to display the cursor request it while drawing.
+	td.term.HideCursor()
 	if err := td.container.Draw(); err != nil {
 		return fmt.Errorf("container.Draw => error: %v", err)
 	}
@@ -278,9 +468,49 @@ func (td *termdash) redraw() error {
 	if err := td.term.Flush(); err != nil {
 		return fmt.Errorf("term.Flush => error: %v", err)
 	}
+
+	select {
+	case td.redrawnCh <- struct{}{}:
+	default: // The previous notification wasn't processed yet.
+	}
 	return nil
 }
 
+// suspend stops drawing and suspends the terminal.
+func (td *termdash) suspend() error {
+	td.mu.Lock()
+	defer td.mu.Unlock()
+
+	if td.suspended {
+		return nil
+	}
+	if td.suspender != nil {
+		if err := td.suspender.Suspend(); err != nil {
+			return fmt.Errorf("terminal.Suspend => error: %v", err)
+		}
+	}
+	td.suspended = true
+	return nil
+}
+
+// resume resumes the terminal and redraws it from scratch.
+func (td *termdash) resume() error {
+	td.mu.Lock()
+	defer td.mu.Unlock()
+
+	if !td.suspended {
+		return nil
+	}
+	if td.suspender != nil {
+		if err := td.suspender.Resume(); err != nil {
+			return fmt.Errorf("terminal.Resume => error: %v", err)
+		}
+	}
+	td.suspended = false
+	td.clearNeeded = true
+	return td.redraw()
+}
+
 // evRedraw redraws the container and its widgets.
 func (td *termdash) evRedraw() error {
 	td.mu.Lock()
@@ -308,7 +538,7 @@ func (td *termdash) processEvents(ctx context.Context) {
 
 	for {
 		ev := td.term.Event(ctx)
-		if ev != nil {
+		if ev != nil && !td.keyBinding(ev) {
 			td.eds.Event(ev)
 		}
 
@@ -320,16 +550,65 @@ func (td *termdash) processEvents(ctx context.Context) {
 	}
 }
 
+// keyBinding calls the action of the key binding that matches the event if
+// there is one. Returns true if the event was consumed by the binding and
+// shouldn't be forwarded to the event distribution system.
+func (td *termdash) keyBinding(ev terminalapi.Event) bool {
+	k, ok := ev.(*terminalapi.Keyboard)
+	if !ok {
+		return false
+	}
+	b, ok := td.keyBindings[k.Key]
+	if !ok || b.Modifiers != k.Modifiers {
+		return false
+	}
+	if b.Action != nil {
+		b.Action()
+	}
+	if !b.Consume {
+		return false
+	}
+	// The redraw subscriber won't see the consumed event.
+	td.requestRedraw()
+	return true
+}
+
+// requestRedraw asks the goroutine that draws the screen to redraw it without
+// waiting for the redraw.
+func (td *termdash) requestRedraw() {
+	select {
+	case td.redrawCh <- struct{}{}:
+	default: // A redraw is already pending.
+	}
+}
+
+// processRedraws redraws the screen each time a redraw is requested until the
+// context expires. Used by the Controller, Run redraws in its main loop.
+func (td *termdash) processRedraws(ctx context.Context) {
+	for {
+		select {
+		case <-td.redrawCh:
+			// Without an error handler, drawing errors surface from
+			// Controller.Redraw instead.
+			td.drawErr(td.periodicRedraw())
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // start starts the terminal dashboard. Blocks until the context expires or
 // until stop() is called.
 func (td *termdash) start(ctx context.Context) error {
 	// Redraw once to initialize the container sizes.
-	if err := td.periodicRedraw(); err != nil {
+	if err := td.drawErr(td.periodicRedraw()); err != nil {
 		close(td.exitCh)
 		return err
 	}
 
-	redrawTimer := time.NewTicker(td.redrawInterval)
+	interval := td.effectiveInterval()
+	redrawTimer := time.NewTicker(interval)
 	defer redrawTimer.Stop()
 
 	ctx, cancel := context.WithCancel(ctx)
@@ -341,7 +620,31 @@ func (td *termdash) start(ctx context.Context) error {
 	for {
 		select {
 		case <-redrawTimer.C:
-			if err := td.periodicRedraw(); err != nil {
+			if err := td.drawErr(td.periodicRedraw()); err != nil {
+				return err
+			}
+
+		case <-td.redrawnCh:
+			// Widgets can change the requested interval at runtime, apply
+			// the change immediately rather than when the timer fires.
+			if ei := td.effectiveInterval(); ei != interval {
+				interval = ei
+				redrawTimer.Reset(interval)
+			}
+
+		case <-td.redrawCh:
+			if err := td.drawErr(td.periodicRedraw()); err != nil {
+				return err
+			}
+
+		case _, ok := <-td.redrawTrigger:
+			if !ok {
+				// Receiving from a nil channel blocks forever, which disables
+				// this case.
+				td.redrawTrigger = nil
+				continue
+			}
+			if err := td.drawErr(td.periodicRedraw()); err != nil {
 				return err
 			}
 
@@ -359,4 +662,13 @@ func (td *termdash) start(ctx context.Context) error {
 func (td *termdash) stop() {
 	close(td.closeCh)
 	<-td.exitCh
+	td.restoreTerminal()
+}
+
+// restoreTerminal switches the container back to the terminal provided by the
+// user if termdash wrapped it.
+func (td *termdash) restoreTerminal() {
+	if td.term != td.userTerm {
+		td.container.SetTerminal(td.userTerm)
+	}
 }
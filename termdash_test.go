@@ -16,9 +16,12 @@ package termdash
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"image"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -26,6 +29,7 @@ import (
 	"github.com/woodliu/termdash/container"
 	"github.com/woodliu/termdash/keyboard"
 	"github.com/woodliu/termdash/mouse"
+	"github.com/woodliu/termdash/private/canvas"
 	"github.com/woodliu/termdash/private/canvas/testcanvas"
 	"github.com/woodliu/termdash/private/event"
 	"github.com/woodliu/termdash/private/event/eventqueue"
@@ -175,10 +179,48 @@ func (ms *mouseSubscriber) receive(m *terminalapi.Mouse) {
 	ms.received = *m
 }
 
+// resizeSubscriber just stores the last received size.
+type resizeSubscriber struct {
+	received image.Point
+	mu       sync.Mutex
+}
+
+func (rs *resizeSubscriber) get() image.Point {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return rs.received
+}
+
+func (rs *resizeSubscriber) receive(size image.Point) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.received = size
+}
+
+// keyBindingCounter counts the calls of a key binding action.
+type keyBindingCounter struct {
+	calls int
+	mu    sync.Mutex
+}
+
+func (kbc *keyBindingCounter) get() int {
+	kbc.mu.Lock()
+	defer kbc.mu.Unlock()
+	return kbc.calls
+}
+
+func (kbc *keyBindingCounter) action() {
+	kbc.mu.Lock()
+	defer kbc.mu.Unlock()
+	kbc.calls++
+}
+
 type eventHandlers struct {
-	handler  errorHandler
-	keySub   keySubscriber
-	mouseSub mouseSubscriber
+	handler   errorHandler
+	keySub    keySubscriber
+	binding   keyBindingCounter
+	mouseSub  mouseSubscriber
+	resizeSub resizeSubscriber
 }
 
 func TestRun(t *testing.T) {
@@ -231,6 +273,29 @@ func TestRun(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			desc: "reports drawing errors to the error handler",
+			size: image.Point{1, 1},
+			opts: func(eh *eventHandlers) []Option {
+				return []Option{
+					RedrawInterval(1),
+					ErrorHandler(eh.handler.handle),
+				}
+			},
+			after: func(eh *eventHandlers) error {
+				err := eh.handler.get()
+				if err == nil {
+					return errors.New("errorHandler got nil, want an error")
+				}
+				if want := "unable to draw widget *fakewidget.Mirror"; !strings.Contains(err.Error(), want) {
+					return fmt.Errorf("errorHandler got %v, want it to contain %q", err, want)
+				}
+				return nil
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+		},
 		{
 			desc: "forwards mouse events to container",
 			size: image.Point{60, 10},
@@ -361,6 +426,169 @@ func TestRun(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc: "calls the key binding and forwards the keyboard event",
+			size: image.Point{60, 10},
+			opts: func(eh *eventHandlers) []Option {
+				return []Option{
+					RedrawInterval(1),
+					KeyBindings(map[keyboard.Key]KeyBinding{
+						keyboard.KeyF1: {Action: eh.binding.action},
+					}),
+				}
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyF1},
+			},
+			wantProcessed: 2,
+			after: func(eh *eventHandlers) error {
+				if got, want := eh.binding.get(), 1; got != want {
+					return fmt.Errorf("key binding called %d times, want %d", got, want)
+				}
+				return nil
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(ft.Area()),
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{
+						WantKeyboard: widgetapi.KeyScopeFocused,
+					},
+					&fakewidget.Event{
+						Ev:   &terminalapi.Keyboard{Key: keyboard.KeyF1},
+						Meta: &widgetapi.EventMeta{Focused: true},
+					},
+				)
+				return ft
+			},
+		},
+		{
+			desc: "consumed key binding doesn't forward the keyboard event",
+			size: image.Point{60, 10},
+			opts: func(eh *eventHandlers) []Option {
+				return []Option{
+					RedrawInterval(1),
+					KeyboardSubscriber(eh.keySub.receive),
+					KeyBindings(map[keyboard.Key]KeyBinding{
+						keyboard.KeyF1: {Action: eh.binding.action, Consume: true},
+					}),
+				}
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyF1},
+				&terminalapi.Keyboard{Key: keyboard.KeyF2},
+			},
+			wantProcessed: 3,
+			after: func(eh *eventHandlers) error {
+				if got, want := eh.binding.get(), 1; got != want {
+					return fmt.Errorf("key binding called %d times, want %d", got, want)
+				}
+				want := terminalapi.Keyboard{Key: keyboard.KeyF2}
+				if diff := pretty.Compare(want, eh.keySub.get()); diff != "" {
+					return fmt.Errorf("keySubscriber got unexpected value, diff (-want, +got):\n%s", diff)
+				}
+				return nil
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(ft.Area()),
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{
+						WantKeyboard: widgetapi.KeyScopeFocused,
+					},
+					&fakewidget.Event{
+						Ev:   &terminalapi.Keyboard{Key: keyboard.KeyF2},
+						Meta: &widgetapi.EventMeta{Focused: true},
+					},
+				)
+				return ft
+			},
+		},
+		{
+			desc: "key binding doesn't match the key pressed with modifiers",
+			size: image.Point{60, 10},
+			opts: func(eh *eventHandlers) []Option {
+				return []Option{
+					RedrawInterval(1),
+					KeyBindings(map[keyboard.Key]KeyBinding{
+						keyboard.KeyF1: {Action: eh.binding.action, Consume: true},
+					}),
+				}
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyF1, Modifiers: keyboard.ModCtrl},
+			},
+			wantProcessed: 2,
+			after: func(eh *eventHandlers) error {
+				if got, want := eh.binding.get(), 0; got != want {
+					return fmt.Errorf("key binding called %d times, want %d", got, want)
+				}
+				return nil
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(ft.Area()),
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{
+						WantKeyboard: widgetapi.KeyScopeFocused,
+					},
+					&fakewidget.Event{
+						Ev:   &terminalapi.Keyboard{Key: keyboard.KeyF1, Modifiers: keyboard.ModCtrl},
+						Meta: &widgetapi.EventMeta{Focused: true},
+					},
+				)
+				return ft
+			},
+		},
+		{
+			desc: "key binding with modifiers matches only the same modifiers",
+			size: image.Point{60, 10},
+			opts: func(eh *eventHandlers) []Option {
+				return []Option{
+					RedrawInterval(1),
+					KeyBindings(map[keyboard.Key]KeyBinding{
+						keyboard.KeyF1: {Action: eh.binding.action, Modifiers: keyboard.ModCtrl, Consume: true},
+					}),
+				}
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyF1, Modifiers: keyboard.ModCtrl},
+				&terminalapi.Keyboard{Key: keyboard.KeyF1, Modifiers: keyboard.ModCtrl | keyboard.ModAlt},
+			},
+			wantProcessed: 2,
+			after: func(eh *eventHandlers) error {
+				if got, want := eh.binding.get(), 1; got != want {
+					return fmt.Errorf("key binding called %d times, want %d", got, want)
+				}
+				return nil
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(ft.Area()),
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{
+						WantKeyboard: widgetapi.KeyScopeFocused,
+					},
+					&fakewidget.Event{
+						Ev:   &terminalapi.Keyboard{Key: keyboard.KeyF1, Modifiers: keyboard.ModCtrl | keyboard.ModAlt},
+						Meta: &widgetapi.EventMeta{Focused: true},
+					},
+				)
+				return ft
+			},
+		},
 		{
 			desc: "forwards mouse events to the subscriber",
 			size: image.Point{60, 10},
@@ -399,6 +627,38 @@ func TestRun(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc: "forwards resize events to the subscriber",
+			size: image.Point{60, 10},
+			opts: func(eh *eventHandlers) []Option {
+				return []Option{
+					RedrawInterval(1),
+					ResizeSubscriber(eh.resizeSub.receive),
+				}
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Resize{Size: image.Point{60, 10}},
+			},
+			wantProcessed: 2,
+			after: func(eh *eventHandlers) error {
+				want := image.Point{60, 10}
+				if got := eh.resizeSub.get(); !got.Eq(want) {
+					return fmt.Errorf("resizeSubscriber got %v, want %v", got, want)
+				}
+				return nil
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(ft.Area()),
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{},
+				)
+				return ft
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -407,9 +667,10 @@ func TestRun(t *testing.T) {
 			t.Parallel()
 
 			handlers := &eventHandlers{
-				handler:  errorHandler{},
-				keySub:   keySubscriber{},
-				mouseSub: mouseSubscriber{},
+				handler:   errorHandler{},
+				keySub:    keySubscriber{},
+				mouseSub:  mouseSubscriber{},
+				resizeSub: resizeSubscriber{},
 			}
 
 			eq := eventqueue.New()
@@ -469,6 +730,280 @@ func TestRun(t *testing.T) {
 	}
 }
 
+func TestRedrawTrigger(t *testing.T) {
+	t.Parallel()
+
+	size := image.Point{60, 10}
+	got, err := faketerm.New(size, faketerm.WithEventQueue(eventqueue.New()))
+	if err != nil {
+		t.Fatalf("faketerm.New => unexpected error: %v", err)
+	}
+
+	mi := fakewidget.New(widgetapi.Options{})
+	cont, err := container.New(
+		got,
+		container.PlaceWidget(mi),
+	)
+	if err != nil {
+		t.Fatalf("container.New => unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	trigger := make(chan struct{})
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- Run(ctx, got, cont,
+			RedrawInterval(time.Hour),
+			RedrawTrigger(trigger),
+		)
+	}()
+
+	mi.Text("hello")
+	trigger <- struct{}{}
+
+	want := faketerm.MustNew(size)
+	mirror := fakewidget.New(widgetapi.Options{})
+	mirror.Text("hello")
+	fakewidget.MustDrawWithMirror(
+		mirror,
+		want,
+		testcanvas.MustNew(want.Area()),
+		&widgetapi.Meta{Focused: true},
+	)
+	if err := testevent.WaitFor(5*time.Second, func() error {
+		if diff := faketerm.Diff(want, got); diff != "" {
+			return fmt.Errorf("Run => %v", diff)
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("testevent.WaitFor => %v", err)
+	}
+
+	cancel()
+	if err := <-errCh; err != nil {
+		t.Errorf("Run => unexpected error: %v", err)
+	}
+}
+
+func TestConsumedKeyBindingRedraws(t *testing.T) {
+	t.Parallel()
+
+	size := image.Point{60, 10}
+	eq := eventqueue.New()
+	got, err := faketerm.New(size, faketerm.WithEventQueue(eq))
+	if err != nil {
+		t.Fatalf("faketerm.New => unexpected error: %v", err)
+	}
+
+	mi := fakewidget.New(widgetapi.Options{})
+	cont, err := container.New(
+		got,
+		container.PlaceWidget(mi),
+	)
+	if err != nil {
+		t.Fatalf("container.New => unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- Run(ctx, got, cont,
+			RedrawInterval(time.Hour),
+			KeyBindings(map[keyboard.Key]KeyBinding{
+				keyboard.KeyF1: {
+					Action:  func() { mi.Text("hello") },
+					Consume: true,
+				},
+			}),
+		)
+	}()
+	eq.Push(&terminalapi.Keyboard{Key: keyboard.KeyF1})
+
+	want := faketerm.MustNew(size)
+	mirror := fakewidget.New(widgetapi.Options{})
+	mirror.Text("hello")
+	fakewidget.MustDrawWithMirror(
+		mirror,
+		want,
+		testcanvas.MustNew(want.Area()),
+		&widgetapi.Meta{Focused: true},
+	)
+	if err := testevent.WaitFor(5*time.Second, func() error {
+		if diff := faketerm.Diff(want, got); diff != "" {
+			return fmt.Errorf("Run => %v", diff)
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("testevent.WaitFor => %v", err)
+	}
+
+	cancel()
+	if err := <-errCh; err != nil {
+		t.Errorf("Run => unexpected error: %v", err)
+	}
+}
+
+func TestRedrawIntervalLoweredPromptly(t *testing.T) {
+	t.Parallel()
+
+	size := image.Point{60, 10}
+	got, err := faketerm.New(size, faketerm.WithEventQueue(eventqueue.New()))
+	if err != nil {
+		t.Fatalf("faketerm.New => unexpected error: %v", err)
+	}
+
+	dc := &drawCounter{Mirror: fakewidget.New(widgetapi.Options{})}
+	cont, err := container.New(
+		got,
+		container.PlaceWidget(dc),
+	)
+	if err != nil {
+		t.Fatalf("container.New => unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	trigger := make(chan struct{})
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- Run(ctx, got, cont,
+			RedrawInterval(time.Hour),
+			RedrawTrigger(trigger),
+		)
+	}()
+
+	// Once the redraw loop runs with the long interval, the widget lowers
+	// the interval and termdash notices it on the next redraw.
+	trigger <- struct{}{}
+	dc.interval.Store(int64(10 * time.Millisecond))
+	trigger <- struct{}{}
+
+	if err := testevent.WaitFor(5*time.Second, func() error {
+		if got, want := dc.draws.Load(), int64(5); got < want {
+			return fmt.Errorf("the widget was drawn %d times, want at least %d", got, want)
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("testevent.WaitFor => %v", err)
+	}
+
+	cancel()
+	if err := <-errCh; err != nil {
+		t.Errorf("Run => unexpected error: %v", err)
+	}
+}
+
+func TestControllerRestoresTerminal(t *testing.T) {
+	t.Parallel()
+
+	size := image.Point{60, 10}
+	got, err := faketerm.New(size, faketerm.WithEventQueue(eventqueue.New()))
+	if err != nil {
+		t.Fatalf("faketerm.New => unexpected error: %v", err)
+	}
+
+	mi := fakewidget.New(widgetapi.Options{})
+	cont, err := container.New(
+		got,
+		container.PlaceWidget(mi),
+	)
+	if err != nil {
+		t.Fatalf("container.New => unexpected error: %v", err)
+	}
+
+	ctrl, err := NewController(got, cont, MaxCellsPerFrame(10))
+	if err != nil {
+		t.Fatalf("NewController => unexpected error: %v", err)
+	}
+	ctrl.Close()
+
+	// After the controller is closed, the container draws directly onto the
+	// provided terminal without the per frame limit.
+	mi.Text("hello")
+	if err := cont.Draw(); err != nil {
+		t.Fatalf("Draw => unexpected error: %v", err)
+	}
+
+	want := faketerm.MustNew(size)
+	mirror := fakewidget.New(widgetapi.Options{})
+	mirror.Text("hello")
+	fakewidget.MustDrawWithMirror(
+		mirror,
+		want,
+		testcanvas.MustNew(want.Area()),
+		&widgetapi.Meta{Focused: true},
+	)
+	if diff := faketerm.Diff(want, got); diff != "" {
+		t.Errorf("Draw => %v", diff)
+	}
+}
+
+// drawCounter is a widget that counts the calls to Draw and requests a redraw
+// interval that can be changed at runtime.
+type drawCounter struct {
+	*fakewidget.Mirror
+
+	draws    atomic.Int64
+	interval atomic.Int64
+}
+
+// Options implements widgetapi.Widget.Options.
+func (dc *drawCounter) Options() widgetapi.Options {
+	opts := dc.Mirror.Options()
+	opts.RedrawInterval = time.Duration(dc.interval.Load())
+	return opts
+}
+
+// Draw implements widgetapi.Widget.Draw.
+func (dc *drawCounter) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
+	dc.draws.Add(1)
+	return dc.Mirror.Draw(cvs, meta)
+}
+
+func TestRedrawTriggerClosed(t *testing.T) {
+	t.Parallel()
+
+	size := image.Point{60, 10}
+	got, err := faketerm.New(size, faketerm.WithEventQueue(eventqueue.New()))
+	if err != nil {
+		t.Fatalf("faketerm.New => unexpected error: %v", err)
+	}
+
+	dc := &drawCounter{Mirror: fakewidget.New(widgetapi.Options{})}
+	cont, err := container.New(
+		got,
+		container.PlaceWidget(dc),
+	)
+	if err != nil {
+		t.Fatalf("container.New => unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	trigger := make(chan struct{})
+	close(trigger)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- Run(ctx, got, cont,
+			RedrawInterval(time.Hour),
+			RedrawTrigger(trigger),
+		)
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	if err := <-errCh; err != nil {
+		t.Errorf("Run => unexpected error: %v", err)
+	}
+
+	// Only the initial redraw, the closed channel doesn't trigger any.
+	if got, max := dc.draws.Load(), int64(1); got > max {
+		t.Errorf("Run => the widget was drawn %d times, want at most %d", got, max)
+	}
+}
+
 func TestController(t *testing.T) {
 	t.Parallel()
 
@@ -580,6 +1115,88 @@ func TestController(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc: "flushes cells beyond the per frame limit on subsequent redraws",
+			size: image.Point{60, 10},
+			opts: []Option{
+				MaxCellsPerFrame(10),
+			},
+			apiEvents: func(mi *fakewidget.Mirror) {
+				mi.Text("hello")
+			},
+			controls: func(ctrl *Controller) error {
+				// The widget changes less than 60*10 cells.
+				for i := 0; i < 60; i++ {
+					if err := ctrl.Redraw(); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				mirror := fakewidget.New(widgetapi.Options{})
+				mirror.Text("hello")
+				fakewidget.MustDrawWithMirror(
+					mirror,
+					ft,
+					testcanvas.MustNew(ft.Area()),
+					&widgetapi.Meta{Focused: true},
+				)
+				return ft
+			},
+		},
+		{
+			desc: "skips redraws while suspended",
+			size: image.Point{60, 10},
+			apiEvents: func(mi *fakewidget.Mirror) {
+				mi.Text("hello")
+			},
+			controls: func(ctrl *Controller) error {
+				if err := ctrl.Suspend(); err != nil {
+					return err
+				}
+				return ctrl.Redraw()
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(ft.Area()),
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{},
+				)
+				return ft
+			},
+		},
+		{
+			desc: "redraws on resume",
+			size: image.Point{60, 10},
+			apiEvents: func(mi *fakewidget.Mirror) {
+				mi.Text("hello")
+			},
+			controls: func(ctrl *Controller) error {
+				if err := ctrl.Suspend(); err != nil {
+					return err
+				}
+				return ctrl.Resume()
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				mirror := fakewidget.New(widgetapi.Options{})
+				mirror.Text("hello")
+				fakewidget.MustDrawWithMirror(
+					mirror,
+					ft,
+					testcanvas.MustNew(ft.Area()),
+					&widgetapi.Meta{Focused: true},
+				)
+				return ft
+			},
+		},
 		{
 			desc: "fails when redraw fails",
 			size: image.Point{1, 1},
@@ -676,3 +1293,212 @@ func TestController(t *testing.T) {
 		})
 	}
 }
+
+// suspendTerm is a fake terminal that implements terminalapi.Suspender.
+type suspendTerm struct {
+	*faketerm.Terminal
+
+	// calls records the calls to Suspend and Resume.
+	calls []string
+	// err is returned from Suspend and Resume if not nil.
+	err error
+}
+
+// Suspend implements terminalapi.Suspender.Suspend.
+func (st *suspendTerm) Suspend() error {
+	st.calls = append(st.calls, "Suspend")
+	return st.err
+}
+
+// Resume implements terminalapi.Suspender.Resume.
+func (st *suspendTerm) Resume() error {
+	st.calls = append(st.calls, "Resume")
+	return st.err
+}
+
+func TestControllerSuspender(t *testing.T) {
+	tests := []struct {
+		desc      string
+		opts      []Option
+		termErr   error
+		controls  func(*Controller) error
+		wantCalls []string
+		wantErr   bool
+	}{
+		{
+			desc: "suspends and resumes the terminal",
+			controls: func(ctrl *Controller) error {
+				if err := ctrl.Suspend(); err != nil {
+					return err
+				}
+				return ctrl.Resume()
+			},
+			wantCalls: []string{"Suspend", "Resume"},
+		},
+		{
+			desc: "suspends the terminal wrapped by MaxCellsPerFrame",
+			opts: []Option{
+				MaxCellsPerFrame(10),
+			},
+			controls: func(ctrl *Controller) error {
+				if err := ctrl.Suspend(); err != nil {
+					return err
+				}
+				return ctrl.Resume()
+			},
+			wantCalls: []string{"Suspend", "Resume"},
+		},
+		{
+			desc: "repeated calls are ignored",
+			controls: func(ctrl *Controller) error {
+				for i := 0; i < 2; i++ {
+					if err := ctrl.Suspend(); err != nil {
+						return err
+					}
+				}
+				for i := 0; i < 2; i++ {
+					if err := ctrl.Resume(); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			wantCalls: []string{"Suspend", "Resume"},
+		},
+		{
+			desc: "resume without suspend does nothing",
+			controls: func(ctrl *Controller) error {
+				return ctrl.Resume()
+			},
+		},
+		{
+			desc:    "fails when the terminal fails to suspend",
+			termErr: errors.New("suspend failed"),
+			controls: func(ctrl *Controller) error {
+				return ctrl.Suspend()
+			},
+			wantCalls: []string{"Suspend"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ft, err := faketerm.New(image.Point{60, 10}, faketerm.WithEventQueue(eventqueue.New()))
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			st := &suspendTerm{Terminal: ft}
+			cont, err := container.New(
+				st,
+				container.PlaceWidget(fakewidget.New(widgetapi.Options{})),
+			)
+			if err != nil {
+				t.Fatalf("container.New => unexpected error: %v", err)
+			}
+
+			ctrl, err := NewController(st, cont, tc.opts...)
+			if err != nil {
+				t.Fatalf("NewController => unexpected error: %v", err)
+			}
+			defer ctrl.Close()
+
+			st.err = tc.termErr
+			err = tc.controls(ctrl)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("controls => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if diff := pretty.Compare(tc.wantCalls, st.calls); diff != "" {
+				t.Errorf("controls => unexpected calls to the terminal (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestControllerClosed(t *testing.T) {
+	ft, err := faketerm.New(image.Point{60, 10}, faketerm.WithEventQueue(eventqueue.New()))
+	if err != nil {
+		t.Fatalf("faketerm.New => unexpected error: %v", err)
+	}
+	cont, err := container.New(
+		ft,
+		container.PlaceWidget(fakewidget.New(widgetapi.Options{})),
+	)
+	if err != nil {
+		t.Fatalf("container.New => unexpected error: %v", err)
+	}
+
+	ctrl, err := NewController(ft, cont)
+	if err != nil {
+		t.Fatalf("NewController => unexpected error: %v", err)
+	}
+	ctrl.Close()
+
+	if err := ctrl.Suspend(); err == nil {
+		t.Errorf("Suspend => got nil error, want an error on a closed controller")
+	}
+	if err := ctrl.Resume(); err == nil {
+		t.Errorf("Resume => got nil error, want an error on a closed controller")
+	}
+}
+
+func TestEffectiveInterval(t *testing.T) {
+	tests := []struct {
+		desc       string
+		opts       []Option
+		widgetOpts widgetapi.Options
+		want       time.Duration
+	}{
+		{
+			desc: "defaults to DefaultRedrawInterval",
+			want: DefaultRedrawInterval,
+		},
+		{
+			desc: "uses the RedrawInterval option",
+			opts: []Option{
+				RedrawInterval(time.Second),
+			},
+			want: time.Second,
+		},
+		{
+			desc: "widget requests a shorter interval",
+			opts: []Option{
+				RedrawInterval(time.Second),
+			},
+			widgetOpts: widgetapi.Options{RedrawInterval: 100 * time.Millisecond},
+			want:       100 * time.Millisecond,
+		},
+		{
+			desc: "widget requests a longer interval",
+			opts: []Option{
+				RedrawInterval(time.Second),
+			},
+			widgetOpts: widgetapi.Options{RedrawInterval: time.Minute},
+			want:       time.Second,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ft, err := faketerm.New(image.Point{60, 10}, faketerm.WithEventQueue(eventqueue.New()))
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			cont, err := container.New(
+				ft,
+				container.PlaceWidget(fakewidget.New(tc.widgetOpts)),
+			)
+			if err != nil {
+				t.Fatalf("container.New => unexpected error: %v", err)
+			}
+
+			td, err := newTermdash(ft, cont, tc.opts...)
+			if err != nil {
+				t.Fatalf("newTermdash => unexpected error: %v", err)
+			}
+			if got := td.effectiveInterval(); got != tc.want {
+				t.Errorf("effectiveInterval => %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package headless implements a terminal that doesn't need a TTY.
+//
+// The terminal is driven by injected events and records the content of the
+// screen on each redraw, which allows writing end-to-end tests of dashboards
+// that run the full termdash loop, e.g. using termdash.Run.
+package headless
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"strings"
+	"sync"
+
+	"github.com/woodliu/termdash/cell"
+	"github.com/woodliu/termdash/private/event/eventqueue"
+	"github.com/woodliu/termdash/private/faketerm"
+	"github.com/woodliu/termdash/terminal/terminalapi"
+)
+
+// Option is used to provide options.
+type Option interface {
+	// set sets the provided option.
+	set(*Terminal)
+}
+
+// option implements Option.
+type option func(*Terminal)
+
+// set implements Option.set.
+func (o option) set(t *Terminal) {
+	o(t)
+}
+
+// DefaultMaxFrames is the default value for the MaxFrames option.
+const DefaultMaxFrames = 100
+
+// MaxFrames limits the number of frames the terminal retains, so that the
+// memory used by a long running dashboard stays bounded. Once the limit is
+// reached, each Flush discards the oldest frame. Discarded frames are no longer
+// returned by Frames and aren't checked by WaitFor.
+// The limit cannot be negative, zero means that all the frames are retained.
+// Defaults to DefaultMaxFrames.
+func MaxFrames(n int) Option {
+	return option(func(t *Terminal) {
+		t.maxFrames = n
+	})
+}
+
+// Terminal is a terminal without a TTY. Input events are provided by calling
+// Inject and the content of the screen is recorded as a frame each time it is
+// flushed.
+//
+// Implements terminalapi.Terminal. This object is thread-safe.
+type Terminal struct {
+	// term holds the content of the screen.
+	term *faketerm.Terminal
+
+	// events is a queue of the injected events.
+	events *eventqueue.Unbound
+
+	// frames are the recorded contents of the screen, one per Flush.
+	// Only the last maxFrames frames are retained.
+	frames []string
+	// discarded is the number of frames discarded from the beginning of
+	// frames.
+	discarded int
+	// maxFrames is the maximum number of retained frames, zero if unlimited.
+	maxFrames int
+	// flushed is closed and replaced on each Flush.
+	flushed chan struct{}
+
+	// mu protects frames and flushed.
+	mu sync.Mutex
+}
+
+// New returns a new headless terminal of the provided size.
+// Call Close() when the terminal isn't required anymore.
+func New(size image.Point, opts ...Option) (*Terminal, error) {
+	t := &Terminal{
+		flushed:   make(chan struct{}),
+		maxFrames: DefaultMaxFrames,
+	}
+	for _, opt := range opts {
+		opt.set(t)
+	}
+	if t.maxFrames < 0 {
+		return nil, fmt.Errorf("invalid MaxFrames %d, must be zero or positive", t.maxFrames)
+	}
+
+	events := eventqueue.New()
+	term, err := faketerm.New(size, faketerm.WithEventQueue(events))
+	if err != nil {
+		events.Close()
+		return nil, err
+	}
+	t.term = term
+	t.events = events
+	return t, nil
+}
+
+// Inject queues an input event that is delivered the same way as events read
+// from a real terminal, e.g. a *terminalapi.Keyboard or a *terminalapi.Mouse.
+// A *terminalapi.Resize event also resizes the terminal when it is delivered,
+// which clears the screen.
+func (t *Terminal) Inject(ev terminalapi.Event) {
+	t.events.Push(ev)
+}
+
+// Snapshot returns the content of the screen as of the last Flush as text,
+// one line per row of cells separated by newlines. Cell options are ignored.
+// Returns an empty string if the screen wasn't flushed yet.
+func (t *Terminal) Snapshot() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.frames) == 0 {
+		return ""
+	}
+	return t.frames[len(t.frames)-1]
+}
+
+// Frames returns the retained frames in the order they were flushed, in the
+// same format as Snapshot. See the MaxFrames option.
+func (t *Terminal) Frames() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return append([]string(nil), t.frames...)
+}
+
+// WaitFor blocks until a flushed frame satisfies the condition and returns the
+// frame. The last frame flushed before the call is checked too. Frames
+// discarded due to the MaxFrames option before they were checked are skipped.
+// Useful to wait until the dashboard processed injected events and redrew the
+// screen. Returns the context error if the context expires first.
+func (t *Terminal) WaitFor(ctx context.Context, cond func(frame string) bool) (string, error) {
+	// seen is the number of frames flushed before the next checked one,
+	// including the discarded ones.
+	t.mu.Lock()
+	seen := t.discarded + len(t.frames) - 1
+	if seen < 0 {
+		seen = 0
+	}
+	t.mu.Unlock()
+
+	for {
+		t.mu.Lock()
+		if seen < t.discarded {
+			seen = t.discarded
+		}
+		for ; seen < t.discarded+len(t.frames); seen++ {
+			if f := t.frames[seen-t.discarded]; cond(f) {
+				t.mu.Unlock()
+				return f, nil
+			}
+		}
+		flushed := t.flushed
+		t.mu.Unlock()
+
+		select {
+		case <-flushed:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+}
+
+// Size implements terminalapi.Terminal.Size.
+func (t *Terminal) Size() image.Point {
+	return t.term.Size()
+}
+
+// Clear implements terminalapi.Terminal.Clear.
+func (t *Terminal) Clear(opts ...cell.Option) error {
+	return t.term.Clear(opts...)
+}
+
+// Flush implements terminalapi.Terminal.Flush.
+// Records the content of the screen as a new frame.
+func (t *Terminal) Flush() error {
+	frame := strings.TrimSuffix(t.term.String(), "\n")
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.frames = append(t.frames, frame)
+	if t.maxFrames > 0 && len(t.frames) > t.maxFrames {
+		// Release the discarded frame, the backing array is reallocated by
+		// a later append.
+		t.frames[0] = ""
+		t.frames = t.frames[1:]
+		t.discarded++
+	}
+	close(t.flushed)
+	t.flushed = make(chan struct{})
+	return nil
+}
+
+// SetCursor implements terminalapi.Terminal.SetCursor.
+func (t *Terminal) SetCursor(p image.Point) {
+	t.term.SetCursor(p)
+}
+
+// HideCursor implements terminalapi.Terminal.HideCursor.
+func (t *Terminal) HideCursor() {
+	t.term.HideCursor()
+}
+
+// SetCell implements terminalapi.Terminal.SetCell.
+func (t *Terminal) SetCell(p image.Point, r rune, opts ...cell.Option) error {
+	return t.term.SetCell(p, r, opts...)
+}
+
+// Event implements terminalapi.Terminal.Event.
+// Returns the injected events in the order they were injected.
+func (t *Terminal) Event(ctx context.Context) terminalapi.Event {
+	return t.term.Event(ctx)
+}
+
+// Close implements terminalapi.Terminal.Close.
+func (t *Terminal) Close() {
+	t.events.Close()
+}
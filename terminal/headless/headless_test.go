@@ -0,0 +1,276 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package headless
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/woodliu/termdash"
+	"github.com/woodliu/termdash/container"
+	"github.com/woodliu/termdash/keyboard"
+	"github.com/woodliu/termdash/terminal/terminalapi"
+	"github.com/woodliu/termdash/widgets/text"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		desc    string
+		size    image.Point
+		opts    []Option
+		wantErr bool
+	}{
+		{
+			desc:    "fails on negative size",
+			size:    image.Point{-1, 1},
+			wantErr: true,
+		},
+		{
+			desc:    "fails on negative MaxFrames",
+			size:    image.Point{3, 2},
+			opts:    []Option{MaxFrames(-1)},
+			wantErr: true,
+		},
+		{
+			desc: "creates the terminal",
+			size: image.Point{3, 2},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			term, err := New(tc.size, tc.opts...)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("New => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer term.Close()
+
+			if got := term.Size(); got != tc.size {
+				t.Errorf("Size => %v, want %v", got, tc.size)
+			}
+		})
+	}
+}
+
+func TestFlushRecordsFrames(t *testing.T) {
+	term, err := New(image.Point{3, 2})
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+	defer term.Close()
+
+	if got := term.Snapshot(); got != "" {
+		t.Errorf("Snapshot before Flush => %q, want empty", got)
+	}
+
+	if err := term.SetCell(image.Point{0, 0}, 'a'); err != nil {
+		t.Fatalf("SetCell => unexpected error: %v", err)
+	}
+	if err := term.Flush(); err != nil {
+		t.Fatalf("Flush => unexpected error: %v", err)
+	}
+	if err := term.SetCell(image.Point{2, 1}, 'b'); err != nil {
+		t.Fatalf("SetCell => unexpected error: %v", err)
+	}
+	if err := term.Flush(); err != nil {
+		t.Fatalf("Flush => unexpected error: %v", err)
+	}
+
+	wantFrames := []string{
+		"a  \n   ",
+		"a  \n  b",
+	}
+	if diff := pretty.Compare(wantFrames, term.Frames()); diff != "" {
+		t.Errorf("Frames => unexpected diff (-want, +got):\n%s", diff)
+	}
+	if got, want := term.Snapshot(), wantFrames[1]; got != want {
+		t.Errorf("Snapshot => %q, want %q", got, want)
+	}
+}
+
+func TestMaxFrames(t *testing.T) {
+	tests := []struct {
+		desc       string
+		opts       []Option
+		flushes    int
+		wantFrames []string
+	}{
+		{
+			desc:       "retains all frames under the limit",
+			opts:       []Option{MaxFrames(3)},
+			flushes:    2,
+			wantFrames: []string{"0", "1"},
+		},
+		{
+			desc:       "discards the oldest frames over the limit",
+			opts:       []Option{MaxFrames(2)},
+			flushes:    4,
+			wantFrames: []string{"2", "3"},
+		},
+		{
+			desc:       "retains all frames when unlimited",
+			opts:       []Option{MaxFrames(0)},
+			flushes:    4,
+			wantFrames: []string{"0", "1", "2", "3"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			term, err := New(image.Point{1, 1}, tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			defer term.Close()
+
+			for i := 0; i < tc.flushes; i++ {
+				if err := term.SetCell(image.Point{0, 0}, rune('0'+i)); err != nil {
+					t.Fatalf("SetCell => unexpected error: %v", err)
+				}
+				if err := term.Flush(); err != nil {
+					t.Fatalf("Flush => unexpected error: %v", err)
+				}
+			}
+
+			if diff := pretty.Compare(tc.wantFrames, term.Frames()); diff != "" {
+				t.Errorf("Frames => unexpected diff (-want, +got):\n%s", diff)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			want := tc.wantFrames[len(tc.wantFrames)-1]
+			got, err := term.WaitFor(ctx, func(f string) bool { return f == want })
+			if err != nil {
+				t.Fatalf("WaitFor => unexpected error: %v", err)
+			}
+			if got != want {
+				t.Errorf("WaitFor => %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestInject(t *testing.T) {
+	term, err := New(image.Point{3, 2})
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+	defer term.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events := []terminalapi.Event{
+		&terminalapi.Keyboard{Key: 'a'},
+		&terminalapi.Resize{Size: image.Point{4, 1}},
+	}
+	for _, ev := range events {
+		term.Inject(ev)
+	}
+
+	var got []terminalapi.Event
+	for range events {
+		got = append(got, term.Event(ctx))
+	}
+	if diff := pretty.Compare(events, got); diff != "" {
+		t.Errorf("Event => unexpected diff (-want, +got):\n%s", diff)
+	}
+	if got, want := term.Size(), (image.Point{4, 1}); got != want {
+		t.Errorf("Size after Resize => %v, want %v", got, want)
+	}
+}
+
+func TestWaitForTimesOut(t *testing.T) {
+	term, err := New(image.Point{3, 2})
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+	defer term.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := term.WaitFor(ctx, func(string) bool { return true }); err == nil {
+		t.Errorf("WaitFor => got nil error, want the context error")
+	}
+}
+
+// TestRun drives a full termdash loop using the headless terminal.
+func TestRun(t *testing.T) {
+	term, err := New(image.Point{20, 3})
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+	defer term.Close()
+
+	txt, err := text.New()
+	if err != nil {
+		t.Fatalf("text.New => unexpected error: %v", err)
+	}
+	if err := txt.Write("ready"); err != nil {
+		t.Fatalf("Write => unexpected error: %v", err)
+	}
+	c, err := container.New(term, container.PlaceWidget(txt))
+	if err != nil {
+		t.Fatalf("container.New => unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	runCtx, stop := context.WithCancel(ctx)
+	done := make(chan error)
+	go func() {
+		done <- termdash.Run(runCtx, term, c,
+			termdash.RedrawInterval(time.Hour),
+			termdash.KeyboardSubscriber(func(k *terminalapi.Keyboard) {
+				if err := txt.Write(fmt.Sprintf("got %v", k.Key), text.WriteReplace()); err != nil {
+					t.Errorf("Write => unexpected error: %v", err)
+				}
+			}),
+		)
+	}()
+
+	if _, err := term.WaitFor(ctx, func(f string) bool {
+		return strings.HasPrefix(f, "ready")
+	}); err != nil {
+		t.Fatalf("WaitFor(ready) => unexpected error: %v", err)
+	}
+
+	term.Inject(&terminalapi.Keyboard{Key: keyboard.KeyEnter})
+	frame, err := term.WaitFor(ctx, func(f string) bool {
+		return strings.HasPrefix(f, "got KeyEnter")
+	})
+	if err != nil {
+		t.Fatalf("WaitFor(got KeyEnter) => unexpected error: %v, last frame:\n%s", err, term.Snapshot())
+	}
+	want := "got KeyEnter        \n                    \n                    "
+	if frame != want {
+		t.Errorf("WaitFor => %q, want %q", frame, want)
+	}
+
+	stop()
+	if err := <-done; err != nil {
+		t.Errorf("Run => unexpected error: %v", err)
+	}
+}
@@ -25,43 +25,20 @@ func cellColor(c cell.Color) tcell.Color {
 	if c == cell.ColorDefault {
 		return tcell.ColorDefault
 	}
+	if r, g, b, ok := c.RGB(); ok {
+		return tcell.NewRGBColor(int32(r), int32(g), int32(b))
+	}
 	// Subtract one, because cell.ColorBlack has value one instead of zero.
 	// Zero is used for cell.ColorDefault instead.
 	return tcell.Color(c-1) + tcell.ColorValid
 }
 
-// colorToMode adjusts the color to the color mode.
-func colorToMode(c cell.Color, colorMode terminalapi.ColorMode) cell.Color {
-	if c == cell.ColorDefault {
-		return c
-	}
-	switch colorMode {
-	case terminalapi.ColorModeNormal:
-		c %= 16 + 1 // Add one for cell.ColorDefault.
-	case terminalapi.ColorMode256:
-		c %= 256 + 1 // Add one for cell.ColorDefault.
-	case terminalapi.ColorMode216:
-		if c <= 216 { // Add one for cell.ColorDefault.
-			return c + 16
-		}
-		c = c%216 + 16
-	case terminalapi.ColorModeGrayscale:
-		if c <= 24 { // Add one for cell.ColorDefault.
-			return c + 232
-		}
-		c = c%24 + 232
-	default:
-		c = cell.ColorDefault
-	}
-	return c
-}
-
 // cellOptsToStyle converts termdash cell color to the tcell format.
 func cellOptsToStyle(opts *cell.Options, colorMode terminalapi.ColorMode) tcell.Style {
 	st := tcell.StyleDefault
 
-	fg := cellColor(colorToMode(opts.FgColor, colorMode))
-	bg := cellColor(colorToMode(opts.BgColor, colorMode))
+	fg := cellColor(colorMode.DisplayedColor(opts.FgColor))
+	bg := cellColor(colorMode.DisplayedColor(opts.BgColor))
 
 	st = st.Foreground(fg).
 		Background(bg).
@@ -74,3 +51,37 @@ func cellOptsToStyle(opts *cell.Options, colorMode terminalapi.ColorMode) tcell.
 		Dim(opts.Dim)
 	return st
 }
+
+// cursorStyle converts termdash cursor style to the tcell format.
+func cursorStyle(cs terminalapi.CursorStyle) tcell.CursorStyle {
+	switch cs {
+	case terminalapi.CursorStyleBlinkingBlock:
+		return tcell.CursorStyleBlinkingBlock
+	case terminalapi.CursorStyleSteadyBlock:
+		return tcell.CursorStyleSteadyBlock
+	case terminalapi.CursorStyleBlinkingUnderline:
+		return tcell.CursorStyleBlinkingUnderline
+	case terminalapi.CursorStyleSteadyUnderline:
+		return tcell.CursorStyleSteadyUnderline
+	case terminalapi.CursorStyleBlinkingBar:
+		return tcell.CursorStyleBlinkingBar
+	case terminalapi.CursorStyleSteadyBar:
+		return tcell.CursorStyleSteadyBar
+	default:
+		return tcell.CursorStyleDefault
+	}
+}
+
+// mouseFlags converts termdash mouse mode to the tcell flags passed to
+// EnableMouse. Returns false if the mouse shouldn't be enabled at all.
+func mouseFlags(mm terminalapi.MouseMode) ([]tcell.MouseFlags, bool) {
+	switch mm {
+	case terminalapi.MouseModeDisabled:
+		return nil, false
+	case terminalapi.MouseModeClickOnly:
+		return []tcell.MouseFlags{tcell.MouseButtonEvents}, true
+	default:
+		// Without any flags tcell reports all mouse events.
+		return nil, true
+	}
+}
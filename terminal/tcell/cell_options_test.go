@@ -185,6 +185,39 @@ func TestCellOptsToStyle(t *testing.T) {
 				Foreground(tcell.Color16).
 				Background(tcell.Color231),
 		},
+		{
+			desc:      "ColorMode256: RGB colors are approximated",
+			colorMode: terminalapi.ColorMode256,
+			opts: cell.Options{
+				FgColor: cell.ColorRGB(0, 0, 0),
+				BgColor: cell.ColorRGB(255, 255, 255),
+			},
+			want: tcell.StyleDefault.
+				Foreground(tcell.Color16).
+				Background(tcell.Color231),
+		},
+		{
+			desc:      "ColorModeFullRGB: RGB colors",
+			colorMode: terminalapi.ColorModeFullRGB,
+			opts: cell.Options{
+				FgColor: cell.ColorRGB(18, 52, 86),
+				BgColor: cell.ColorRGB(255, 255, 255),
+			},
+			want: tcell.StyleDefault.
+				Foreground(tcell.NewRGBColor(18, 52, 86)).
+				Background(tcell.NewRGBColor(255, 255, 255)),
+		},
+		{
+			desc:      "ColorModeFullRGB: numbered colors",
+			colorMode: terminalapi.ColorModeFullRGB,
+			opts: cell.Options{
+				FgColor: cell.ColorNumber(33),
+				BgColor: cell.ColorDefault,
+			},
+			want: tcell.StyleDefault.
+				Foreground(tcell.Color33).
+				Background(tcell.ColorDefault),
+		},
 		{
 			desc:      "ColorModeNormal: first and last color",
 			colorMode: terminalapi.ColorModeNormal,
@@ -208,15 +241,26 @@ func TestCellOptsToStyle(t *testing.T) {
 				Background(tcell.ColorOlive),
 		},
 		{
-			desc:      "ColorModeNormal: colors above the range rotate back",
+			desc:      "ColorModeNormal: colors above the range are converted to the nearest color",
 			colorMode: terminalapi.ColorModeNormal,
 			opts: cell.Options{
-				FgColor: cell.ColorNumber(17),
-				BgColor: cell.ColorNumber(18),
+				FgColor: cell.ColorNumber(196),
+				BgColor: cell.ColorNumber(250),
 			},
 			want: tcell.StyleDefault.
-				Foreground(tcell.ColorBlack).
-				Background(tcell.ColorMaroon),
+				Foreground(tcell.ColorRed).
+				Background(tcell.ColorSilver),
+		},
+		{
+			desc:      "ColorModeNormal: RGB colors are converted to the nearest color",
+			colorMode: terminalapi.ColorModeNormal,
+			opts: cell.Options{
+				FgColor: cell.ColorRGB(0, 0, 100),
+				BgColor: cell.ColorRGB(250, 250, 240),
+			},
+			want: tcell.StyleDefault.
+				Foreground(tcell.ColorNavy).
+				Background(tcell.ColorWhite),
 		},
 		{
 			desc:      "ColorMode216: first and last color",
@@ -330,6 +374,22 @@ func TestCellOptsToStyle(t *testing.T) {
 			opts:      cell.Options{Dim: true},
 			want:      tcell.StyleDefault.Dim(true),
 		},
+		{
+			desc:      "combines attributes",
+			colorMode: terminalapi.ColorModeNormal,
+			opts: *cell.NewOptions(
+				cell.FgColor(cell.ColorRed),
+				cell.Bold(),
+				cell.Blink(),
+				cell.Strikethrough(),
+			),
+			want: tcell.StyleDefault.
+				Foreground(tcell.ColorRed).
+				Background(tcell.ColorDefault).
+				Bold(true).
+				Blink(true).
+				StrikeThrough(true),
+		},
 	}
 
 	for _, tc := range tests {
@@ -343,3 +403,51 @@ func TestCellOptsToStyle(t *testing.T) {
 		})
 	}
 }
+
+func TestCursorStyle(t *testing.T) {
+	tests := []struct {
+		cs   terminalapi.CursorStyle
+		want tcell.CursorStyle
+	}{
+		{terminalapi.CursorStyleDefault, tcell.CursorStyleDefault},
+		{terminalapi.CursorStyleBlinkingBlock, tcell.CursorStyleBlinkingBlock},
+		{terminalapi.CursorStyleSteadyBlock, tcell.CursorStyleSteadyBlock},
+		{terminalapi.CursorStyleBlinkingUnderline, tcell.CursorStyleBlinkingUnderline},
+		{terminalapi.CursorStyleSteadyUnderline, tcell.CursorStyleSteadyUnderline},
+		{terminalapi.CursorStyleBlinkingBar, tcell.CursorStyleBlinkingBar},
+		{terminalapi.CursorStyleSteadyBar, tcell.CursorStyleSteadyBar},
+		{terminalapi.CursorStyle(-1), tcell.CursorStyleDefault},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.cs.String(), func(t *testing.T) {
+			if got := cursorStyle(tc.cs); got != tc.want {
+				t.Errorf("cursorStyle(%v) => %v, want %v", tc.cs, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMouseFlags(t *testing.T) {
+	tests := []struct {
+		mm         terminalapi.MouseMode
+		wantFlags  []tcell.MouseFlags
+		wantEnable bool
+	}{
+		{terminalapi.MouseModeFull, nil, true},
+		{terminalapi.MouseModeClickOnly, []tcell.MouseFlags{tcell.MouseButtonEvents}, true},
+		{terminalapi.MouseModeDisabled, nil, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.mm.String(), func(t *testing.T) {
+			gotFlags, gotEnable := mouseFlags(tc.mm)
+			if gotEnable != tc.wantEnable {
+				t.Errorf("mouseFlags(%v) => enable %v, want %v", tc.mm, gotEnable, tc.wantEnable)
+			}
+			if !reflect.DeepEqual(gotFlags, tc.wantFlags) {
+				t.Errorf("mouseFlags(%v) => flags %v, want %v", tc.mm, gotFlags, tc.wantFlags)
+			}
+		})
+	}
+}
@@ -86,14 +86,53 @@ var tcellToTd = map[tcell.Key]keyboard.Key{
 	tcell.KeyCtrlSpace:      keyboard.KeyCtrlSpace,
 }
 
+// tcellCtrlToTd maps tcell key values pressed with the Ctrl modifier to the
+// termdash format.
+var tcellCtrlToTd = map[tcell.Key]keyboard.Key{
+	tcell.KeyLeft:       keyboard.KeyCtrlArrowLeft,
+	tcell.KeyRight:      keyboard.KeyCtrlArrowRight,
+	tcell.KeyBackspace:  keyboard.KeyCtrlBackspace,
+	tcell.KeyBackspace2: keyboard.KeyCtrlBackspace,
+}
+
+// tcellModToTd maps tcell modifier masks to the termdash format.
+var tcellModToTd = map[tcell.ModMask]keyboard.Modifier{
+	tcell.ModShift: keyboard.ModShift,
+	tcell.ModCtrl:  keyboard.ModCtrl,
+	tcell.ModAlt:   keyboard.ModAlt,
+	tcell.ModMeta:  keyboard.ModMeta,
+}
+
+// convModifiers converts tcell modifiers to the termdash format.
+func convModifiers(mask tcell.ModMask) keyboard.Modifier {
+	var mods keyboard.Modifier
+	for tm, m := range tcellModToTd {
+		if mask&tm != 0 {
+			mods |= m
+		}
+	}
+	return mods
+}
+
 // convKey converts a tcell keyboard event to the termdash format.
 func convKey(event *tcell.EventKey) terminalapi.Event {
 	tcellKey := event.Key()
+	mods := convModifiers(event.Modifiers())
+
+	if event.Modifiers()&tcell.ModCtrl != 0 {
+		if k, ok := tcellCtrlToTd[tcellKey]; ok {
+			return &terminalapi.Keyboard{
+				Key:       k,
+				Modifiers: mods,
+			}
+		}
+	}
 
 	if tcellKey == tcell.KeyRune {
 		ch := event.Rune()
 		return &terminalapi.Keyboard{
-			Key: keyboard.Key(ch),
+			Key:       keyboard.Key(ch),
+			Modifiers: mods,
 		}
 	}
 
@@ -103,7 +142,8 @@ func convKey(event *tcell.EventKey) terminalapi.Event {
 	}
 
 	return &terminalapi.Keyboard{
-		Key: k,
+		Key:       k,
+		Modifiers: mods,
 	}
 }
 
@@ -171,6 +171,7 @@ func TestKeyboardKeys(t *testing.T) {
 	tests := []struct {
 		key     tcell.Key
 		ch      rune
+		mod     tcell.ModMask
 		want    keyboard.Key
 		wantErr bool
 	}{
@@ -248,11 +249,16 @@ func TestKeyboardKeys(t *testing.T) {
 		{key: tcell.KeyCtrlRightSq, want: keyboard.KeyCtrl5},
 		{key: tcell.KeyCtrlUnderscore, want: keyboard.KeyCtrlUnderscore},
 		{key: tcell.KeyBackspace2, want: keyboard.KeyBackspace2},
+		{key: tcell.KeyLeft, mod: tcell.ModCtrl, want: keyboard.KeyCtrlArrowLeft},
+		{key: tcell.KeyRight, mod: tcell.ModCtrl, want: keyboard.KeyCtrlArrowRight},
+		{key: tcell.KeyBackspace, mod: tcell.ModCtrl, want: keyboard.KeyCtrlBackspace},
+		{key: tcell.KeyBackspace2, mod: tcell.ModCtrl, want: keyboard.KeyCtrlBackspace},
+		{key: tcell.KeyUp, mod: tcell.ModCtrl, want: keyboard.KeyArrowUp},
 	}
 
 	for _, tc := range tests {
-		t.Run(fmt.Sprintf("key:%v and ch:%v want:%v", tc.key, tc.ch, tc.want), func(t *testing.T) {
-			evs := toTermdashEvents(tcell.NewEventKey(tc.key, tc.ch, tcell.ModNone))
+		t.Run(fmt.Sprintf("key:%v and ch:%v and mod:%v want:%v", tc.key, tc.ch, tc.mod, tc.want), func(t *testing.T) {
+			evs := toTermdashEvents(tcell.NewEventKey(tc.key, tc.ch, tc.mod))
 
 			gotCount := len(evs)
 			wantCount := 1
@@ -280,3 +286,55 @@ func TestKeyboardKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestKeyboardModifiers(t *testing.T) {
+	tests := []struct {
+		desc string
+		key  tcell.Key
+		ch   rune
+		mod  tcell.ModMask
+		want terminalapi.Keyboard
+	}{
+		{
+			desc: "rune without modifiers",
+			key:  tcell.KeyRune,
+			ch:   'a',
+			want: terminalapi.Keyboard{Key: 'a'},
+		},
+		{
+			desc: "rune with Alt",
+			key:  tcell.KeyRune,
+			ch:   'a',
+			mod:  tcell.ModAlt,
+			want: terminalapi.Keyboard{Key: 'a', Modifiers: keyboard.ModAlt},
+		},
+		{
+			desc: "key with Shift",
+			key:  tcell.KeyRight,
+			mod:  tcell.ModShift,
+			want: terminalapi.Keyboard{Key: keyboard.KeyArrowRight, Modifiers: keyboard.ModShift},
+		},
+		{
+			desc: "key with multiple modifiers",
+			key:  tcell.KeyHome,
+			mod:  tcell.ModCtrl | tcell.ModShift | tcell.ModMeta,
+			want: terminalapi.Keyboard{Key: keyboard.KeyHome, Modifiers: keyboard.ModShift | keyboard.ModCtrl | keyboard.ModMeta},
+		},
+		{
+			desc: "Ctrl key combination keeps the modifier",
+			key:  tcell.KeyLeft,
+			mod:  tcell.ModCtrl,
+			want: terminalapi.Keyboard{Key: keyboard.KeyCtrlArrowLeft, Modifiers: keyboard.ModCtrl},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			evs := toTermdashEvents(tcell.NewEventKey(tc.key, tc.ch, tc.mod))
+			want := []terminalapi.Event{&tc.want}
+			if diff := pretty.Compare(want, evs); diff != "" {
+				t.Errorf("toTermdashEvents => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tcell
+
+// snapshot.go contains code that reads back the content of the screen.
+
+import (
+	"fmt"
+	"strings"
+
+	tcell "github.com/gdamore/tcell/v2"
+)
+
+// Snapshot returns the current content of the screen as text, one line per
+// row of cells separated by newlines. Every line has the width of the screen,
+// full-width runes occupy two cells and are written once.
+// The content is the one set by the last calls to SetCell and Clear, which
+// is what is visible on the screen after Flush.
+func (t *Terminal) Snapshot() string {
+	return t.snapshot(false)
+}
+
+// SnapshotANSI is like Snapshot, but includes the colors and attributes of the
+// cells as ANSI escape sequences (SGR). Each line ends by resetting the
+// attributes, so the lines can be printed independently.
+func (t *Terminal) SnapshotANSI() string {
+	return t.snapshot(true)
+}
+
+// snapshot reads back the content of the screen, optionally with ANSI escape
+// sequences.
+func (t *Terminal) snapshot(ansi bool) string {
+	w, h := t.screen.Size()
+	var b strings.Builder
+	for y := 0; y < h; y++ {
+		if y > 0 {
+			b.WriteByte('\n')
+		}
+
+		var last tcell.Style
+		styled := false
+		for x := 0; x < w; {
+			r, comb, st, width := t.screen.GetContent(x, y)
+			if ansi && (!styled || st != last) {
+				b.WriteString(sgr(st))
+				last = st
+				styled = true
+			}
+			b.WriteRune(r)
+			for _, c := range comb {
+				b.WriteRune(c)
+			}
+			if width < 1 {
+				width = 1
+			}
+			x += width
+		}
+		if ansi {
+			b.WriteString(sgrReset)
+		}
+	}
+	return b.String()
+}
+
+// sgrReset is the ANSI escape sequence that resets all the attributes.
+const sgrReset = "\x1b[0m"
+
+// sgrAttrs maps tcell attributes to their SGR parameters.
+var sgrAttrs = []struct {
+	attr  tcell.AttrMask
+	param string
+}{
+	{tcell.AttrBold, "1"},
+	{tcell.AttrDim, "2"},
+	{tcell.AttrItalic, "3"},
+	{tcell.AttrUnderline, "4"},
+	{tcell.AttrBlink, "5"},
+	{tcell.AttrReverse, "7"},
+	{tcell.AttrStrikeThrough, "9"},
+}
+
+// sgr returns the ANSI escape sequence that resets the attributes and then
+// sets the ones of the style.
+func sgr(st tcell.Style) string {
+	fg, bg, attrs := st.Decompose()
+	params := []string{"0"}
+	for _, a := range sgrAttrs {
+		if attrs&a.attr != 0 {
+			params = append(params, a.param)
+		}
+	}
+	if p := sgrColor(fg, "38"); p != "" {
+		params = append(params, p)
+	}
+	if p := sgrColor(bg, "48"); p != "" {
+		params = append(params, p)
+	}
+	return fmt.Sprintf("\x1b[%sm", strings.Join(params, ";"))
+}
+
+// sgrColor returns the SGR parameters that set the color, prefixed with 38
+// for the foreground or 48 for the background. Returns an empty string for the
+// default color.
+func sgrColor(c tcell.Color, prefix string) string {
+	switch {
+	case !c.Valid():
+		return ""
+	case c.IsRGB():
+		r, g, b := c.RGB()
+		return fmt.Sprintf("%s;2;%d;%d;%d", prefix, r, g, b)
+	default:
+		return fmt.Sprintf("%s;5;%d", prefix, c-tcell.ColorValid)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tcell
+
+import (
+	"image"
+	"testing"
+
+	tcell "github.com/gdamore/tcell/v2"
+	"github.com/woodliu/termdash/cell"
+	"github.com/woodliu/termdash/terminal/terminalapi"
+)
+
+// cellCall is a call to Terminal.SetCell.
+type cellCall struct {
+	p    image.Point
+	r    rune
+	opts []cell.Option
+}
+
+func TestSnapshot(t *testing.T) {
+	tests := []struct {
+		desc     string
+		size     image.Point
+		cells    []cellCall
+		want     string
+		wantANSI string
+	}{
+		{
+			desc:     "empty screen",
+			size:     image.Point{3, 2},
+			want:     "   \n   ",
+			wantANSI: "\x1b[0m   \x1b[0m\n\x1b[0m   \x1b[0m",
+		},
+		{
+			desc: "text with full-width runes",
+			size: image.Point{4, 2},
+			cells: []cellCall{
+				{p: image.Point{0, 0}, r: 'a'},
+				{p: image.Point{1, 0}, r: '世'},
+				{p: image.Point{3, 1}, r: 'z'},
+			},
+			want:     "a世 \n   z",
+			wantANSI: "\x1b[0ma世 \x1b[0m\n\x1b[0m   z\x1b[0m",
+		},
+		{
+			desc: "colors and attributes",
+			size: image.Point{3, 1},
+			cells: []cellCall{
+				{p: image.Point{0, 0}, r: 'a', opts: []cell.Option{cell.FgColor(cell.ColorNumber(196)), cell.Bold()}},
+				{p: image.Point{1, 0}, r: 'b', opts: []cell.Option{cell.FgColor(cell.ColorNumber(196)), cell.Bold()}},
+				{p: image.Point{2, 0}, r: 'c', opts: []cell.Option{cell.BgColor(cell.ColorNumber(21)), cell.Underline()}},
+			},
+			want:     "abc",
+			wantANSI: "\x1b[0;1;38;5;196mab\x1b[0;4;48;5;21mc\x1b[0m",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			screen := tcell.NewSimulationScreen("UTF-8")
+			if err := screen.Init(); err != nil {
+				t.Fatalf("Init => unexpected error: %v", err)
+			}
+			defer screen.Fini()
+			screen.SetSize(tc.size.X, tc.size.Y)
+
+			term := &Terminal{
+				screen:    screen,
+				colorMode: terminalapi.ColorMode256,
+			}
+			for _, c := range tc.cells {
+				if err := term.SetCell(c.p, c.r, c.opts...); err != nil {
+					t.Fatalf("SetCell => unexpected error: %v", err)
+				}
+			}
+
+			if got := term.Snapshot(); got != tc.want {
+				t.Errorf("Snapshot => %q, want %q", got, tc.want)
+			}
+			if got := term.SnapshotANSI(); got != tc.wantANSI {
+				t.Errorf("SnapshotANSI => %q, want %q", got, tc.wantANSI)
+			}
+		})
+	}
+}
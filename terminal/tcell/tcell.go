@@ -51,6 +51,31 @@ func ColorMode(cm terminalapi.ColorMode) Option {
 	})
 }
 
+// DefaultCursorStyle is the default value for the CursorStyle option.
+const DefaultCursorStyle = terminalapi.CursorStyleDefault
+
+// CursorStyle sets the shape of the cursor displayed when a widget requests
+// the cursor or when SetCursor is called.
+// Defaults to DefaultCursorStyle.
+func CursorStyle(cs terminalapi.CursorStyle) Option {
+	return option(func(t *Terminal) {
+		t.cursorStyle = cs
+	})
+}
+
+// DefaultMouseMode is the default value for the MouseMode option.
+const DefaultMouseMode = terminalapi.MouseModeFull
+
+// MouseMode sets which mouse events the terminal reports. Use
+// terminalapi.MouseModeDisabled to let the terminal emulator handle the
+// mouse, e.g. for native text selection.
+// Defaults to DefaultMouseMode.
+func MouseMode(mm terminalapi.MouseMode) Option {
+	return option(func(t *Terminal) {
+		t.mouseMode = mm
+	})
+}
+
 // ClearStyle sets the style to use for tcell when clearing the screen.
 // Defaults to ColorDefault for foreground and background.
 func ClearStyle(fg, bg cell.Color) Option {
@@ -62,6 +87,29 @@ func ClearStyle(fg, bg cell.Color) Option {
 	})
 }
 
+// EventQueueSize limits the number of input events queued until termdash
+// processes them, e.g. to bound the memory used under heavy mouse motion.
+// When the queue is full, new mouse events are dropped. Other events, like
+// keyboard events and resizes, are never dropped and are queued even if that
+// exceeds the size, so the queue never blocks reading the input.
+// The size cannot be negative, zero means that the queue is unbound.
+// Defaults to zero.
+func EventQueueSize(n int) Option {
+	return option(func(t *Terminal) {
+		t.eventQueueSize = n
+	})
+}
+
+// CoalesceResize when provided, only the latest of the queued resize events
+// is delivered. A new resize event replaces any resize events that are still
+// queued, so termdash doesn't redraw the screen for each intermediate size
+// while the terminal is being resized.
+func CoalesceResize() Option {
+	return option(func(t *Terminal) {
+		t.coalesceResize = true
+	})
+}
+
 // Terminal provides input and output to a real terminal. Wraps the
 // gdamore/tcell terminal implementation. This object is not thread-safe.
 // Implements terminalapi.Terminal.
@@ -76,8 +124,13 @@ type Terminal struct {
 	screen tcell.Screen
 
 	// Options.
-	colorMode  terminalapi.ColorMode
-	clearStyle *cell.Options
+	colorMode   terminalapi.ColorMode
+	cursorStyle terminalapi.CursorStyle
+	mouseMode   terminalapi.MouseMode
+	clearStyle  *cell.Options
+	// eventQueueSize is the maximum number of queued events, zero if unbound.
+	eventQueueSize int
+	coalesceResize bool
 }
 
 // tcellNewScreen can be overridden from tests.
@@ -91,9 +144,11 @@ func newTerminal(opts ...Option) (*Terminal, error) {
 	}
 
 	t := &Terminal{
-		events:    eventqueue.New(),
-		done:      make(chan struct{}),
-		colorMode: DefaultColorMode,
+		events:      eventqueue.New(),
+		done:        make(chan struct{}),
+		colorMode:   DefaultColorMode,
+		cursorStyle: DefaultCursorStyle,
+		mouseMode:   DefaultMouseMode,
 		clearStyle: &cell.Options{
 			FgColor: cell.ColorDefault,
 			BgColor: cell.ColorDefault,
@@ -103,6 +158,9 @@ func newTerminal(opts ...Option) (*Terminal, error) {
 	for _, opt := range opts {
 		opt.set(t)
 	}
+	if min := 0; t.eventQueueSize < min {
+		return nil, fmt.Errorf("invalid EventQueueSize %d, must be %d <= size", t.eventQueueSize, min)
+	}
 
 	return t, nil
 }
@@ -122,8 +180,11 @@ func New(opts ...Option) (*Terminal, error) {
 	}
 
 	clearStyle := cellOptsToStyle(t.clearStyle, t.colorMode)
-	t.screen.EnableMouse()
+	if flags, ok := mouseFlags(t.mouseMode); ok {
+		t.screen.EnableMouse(flags...)
+	}
 	t.screen.SetStyle(clearStyle)
+	t.screen.SetCursorStyle(cursorStyle(t.cursorStyle))
 
 	go t.pollEvents() // Stops when Close() is called.
 	return t, nil
@@ -153,6 +214,7 @@ func (t *Terminal) Flush() error {
 }
 
 // SetCursor implements terminalapi.Terminal.SetCursor.
+// Shows the cursor if it was hidden.
 func (t *Terminal) SetCursor(p image.Point) {
 	t.screen.ShowCursor(p.X, p.Y)
 }
@@ -170,6 +232,21 @@ func (t *Terminal) SetCell(p image.Point, r rune, opts ...cell.Option) error {
 	return nil
 }
 
+// Suspend implements terminalapi.Suspender.Suspend.
+func (t *Terminal) Suspend() error {
+	return t.screen.Suspend()
+}
+
+// Resume implements terminalapi.Suspender.Resume.
+// The whole screen is redrawn since its content was lost while suspended.
+func (t *Terminal) Resume() error {
+	if err := t.screen.Resume(); err != nil {
+		return err
+	}
+	t.screen.Sync()
+	return nil
+}
+
 // pollEvents polls and enqueues the input events.
 func (t *Terminal) pollEvents() {
 	for {
@@ -179,13 +256,46 @@ func (t *Terminal) pollEvents() {
 		default:
 		}
 
-		events := toTermdashEvents(t.screen.PollEvent())
+		ev := t.screen.PollEvent()
+		if !t.forwardEvent(ev) {
+			continue
+		}
+		events := toTermdashEvents(ev)
 		for _, ev := range events {
-			t.events.Push(ev)
+			t.enqueue(ev)
 		}
 	}
 }
 
+// enqueue pushes the event onto the queue of input events unless the queue is
+// full and the event can be dropped. Removes the queued resize events first if
+// the resize events are coalesced.
+func (t *Terminal) enqueue(ev terminalapi.Event) {
+	switch ev.(type) {
+	case *terminalapi.Mouse:
+		if t.eventQueueSize > 0 && t.events.Len() >= t.eventQueueSize {
+			return
+		}
+	case *terminalapi.Resize:
+		if t.coalesceResize {
+			t.events.Remove(func(e terminalapi.Event) bool {
+				_, ok := e.(*terminalapi.Resize)
+				return ok
+			})
+		}
+	}
+	t.events.Push(ev)
+}
+
+// forwardEvent determines if the tcell event should be delivered to termdash.
+// Mouse events are dropped when the mouse is disabled.
+func (t *Terminal) forwardEvent(ev tcell.Event) bool {
+	if _, ok := ev.(*tcell.EventMouse); ok {
+		return t.mouseMode != terminalapi.MouseModeDisabled
+	}
+	return true
+}
+
 // Event implements terminalapi.Terminal.Event.
 func (t *Terminal) Event(ctx context.Context) terminalapi.Event {
 	ev := t.events.Pull(ctx)
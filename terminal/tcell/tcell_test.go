@@ -15,11 +15,13 @@
 package tcell
 
 import (
+	"image"
 	"testing"
 
 	tcell "github.com/gdamore/tcell/v2"
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/woodliu/termdash/cell"
+	"github.com/woodliu/termdash/keyboard"
 	"github.com/woodliu/termdash/terminal/terminalapi"
 )
 
@@ -68,6 +70,41 @@ func TestNewTerminalColorMode(t *testing.T) {
 	}
 }
 
+func TestNewTerminalCursorStyle(t *testing.T) {
+	tests := []struct {
+		desc string
+		opts []Option
+		want terminalapi.CursorStyle
+	}{
+		{
+			desc: "default options",
+			want: terminalapi.CursorStyleDefault,
+		},
+		{
+			desc: "sets cursor style",
+			opts: []Option{
+				CursorStyle(terminalapi.CursorStyleBlinkingBar),
+			},
+			want: terminalapi.CursorStyleBlinkingBar,
+		},
+	}
+
+	tcellNewScreen = func() (tcell.Screen, error) { return nil, nil }
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := newTerminal(tc.opts...)
+			if err != nil {
+				t.Errorf("newTerminal => unexpected error:\n%v", err)
+				return
+			}
+
+			if got.cursorStyle != tc.want {
+				t.Errorf("newTerminal => cursorStyle %v, want %v", got.cursorStyle, tc.want)
+			}
+		})
+	}
+}
+
 func TestNewTerminalClearStyle(t *testing.T) {
 	tests := []struct {
 		desc string
@@ -119,3 +156,170 @@ func TestNewTerminalClearStyle(t *testing.T) {
 		})
 	}
 }
+
+func TestNewTerminalMouseMode(t *testing.T) {
+	tests := []struct {
+		desc string
+		opts []Option
+		want terminalapi.MouseMode
+	}{
+		{
+			desc: "default options",
+			want: terminalapi.MouseModeFull,
+		},
+		{
+			desc: "sets mouse mode",
+			opts: []Option{
+				MouseMode(terminalapi.MouseModeDisabled),
+			},
+			want: terminalapi.MouseModeDisabled,
+		},
+	}
+
+	tcellNewScreen = func() (tcell.Screen, error) { return nil, nil }
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := newTerminal(tc.opts...)
+			if err != nil {
+				t.Errorf("newTerminal => unexpected error:\n%v", err)
+				return
+			}
+
+			if got.mouseMode != tc.want {
+				t.Errorf("newTerminal => mouseMode %v, want %v", got.mouseMode, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewTerminalEventQueueSize(t *testing.T) {
+	tcellNewScreen = func() (tcell.Screen, error) { return nil, nil }
+	if _, err := newTerminal(EventQueueSize(-1)); err == nil {
+		t.Errorf("newTerminal(EventQueueSize(-1)) => got nil err, want one")
+	}
+
+	got, err := newTerminal(EventQueueSize(10))
+	if err != nil {
+		t.Fatalf("newTerminal => unexpected error: %v", err)
+	}
+	if want := 10; got.eventQueueSize != want {
+		t.Errorf("newTerminal => eventQueueSize %d, want %d", got.eventQueueSize, want)
+	}
+}
+
+func TestEnqueue(t *testing.T) {
+	mouseEv := &terminalapi.Mouse{Position: image.Point{1, 1}}
+	keyEv := &terminalapi.Keyboard{Key: keyboard.KeyEnter}
+
+	tests := []struct {
+		desc   string
+		opts   []Option
+		events []terminalapi.Event
+		want   []terminalapi.Event
+	}{
+		{
+			desc:   "unbound queue by default",
+			events: []terminalapi.Event{mouseEv, mouseEv, mouseEv},
+			want:   []terminalapi.Event{mouseEv, mouseEv, mouseEv},
+		},
+		{
+			desc:   "drops mouse events when full",
+			opts:   []Option{EventQueueSize(2)},
+			events: []terminalapi.Event{mouseEv, keyEv, mouseEv, keyEv},
+			want:   []terminalapi.Event{mouseEv, keyEv, keyEv},
+		},
+		{
+			desc: "delivers all the resize events by default",
+			events: []terminalapi.Event{
+				&terminalapi.Resize{Size: image.Point{1, 1}},
+				keyEv,
+				&terminalapi.Resize{Size: image.Point{2, 2}},
+			},
+			want: []terminalapi.Event{
+				&terminalapi.Resize{Size: image.Point{1, 1}},
+				keyEv,
+				&terminalapi.Resize{Size: image.Point{2, 2}},
+			},
+		},
+		{
+			desc: "coalesces resize events",
+			opts: []Option{CoalesceResize()},
+			events: []terminalapi.Event{
+				&terminalapi.Resize{Size: image.Point{1, 1}},
+				keyEv,
+				&terminalapi.Resize{Size: image.Point{2, 2}},
+				&terminalapi.Resize{Size: image.Point{3, 3}},
+			},
+			want: []terminalapi.Event{
+				keyEv,
+				&terminalapi.Resize{Size: image.Point{3, 3}},
+			},
+		},
+	}
+
+	tcellNewScreen = func() (tcell.Screen, error) { return nil, nil }
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			term, err := newTerminal(tc.opts...)
+			if err != nil {
+				t.Fatalf("newTerminal => unexpected error: %v", err)
+			}
+			defer term.events.Close()
+
+			for _, ev := range tc.events {
+				term.enqueue(ev)
+			}
+
+			var got []terminalapi.Event
+			for ev := term.events.Pop(); ev != nil; ev = term.events.Pop() {
+				got = append(got, ev)
+			}
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("enqueue => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestForwardEvent(t *testing.T) {
+	tests := []struct {
+		desc  string
+		mode  terminalapi.MouseMode
+		event tcell.Event
+		want  bool
+	}{
+		{
+			desc:  "forwards mouse events in full mode",
+			mode:  terminalapi.MouseModeFull,
+			event: tcell.NewEventMouse(0, 0, tcell.Button1, tcell.ModNone),
+			want:  true,
+		},
+		{
+			desc:  "forwards mouse events in click only mode",
+			mode:  terminalapi.MouseModeClickOnly,
+			event: tcell.NewEventMouse(0, 0, tcell.Button1, tcell.ModNone),
+			want:  true,
+		},
+		{
+			desc:  "drops mouse events when disabled",
+			mode:  terminalapi.MouseModeDisabled,
+			event: tcell.NewEventMouse(0, 0, tcell.Button1, tcell.ModNone),
+			want:  false,
+		},
+		{
+			desc:  "forwards keyboard events when the mouse is disabled",
+			mode:  terminalapi.MouseModeDisabled,
+			event: tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone),
+			want:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			term := &Terminal{mouseMode: tc.mode}
+			if got := term.forwardEvent(tc.event); got != tc.want {
+				t.Errorf("forwardEvent => %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -25,6 +25,10 @@ import (
 
 // cellColor converts termdash cell color to the termbox format.
 func cellColor(c cell.Color) tbx.Attribute {
+	// Termbox doesn't support true colors, approximate them.
+	if r, g, b, ok := c.RGB(); ok {
+		c = cell.ColorRGB24(r, g, b)
+	}
 	// Special cases for backward compatibility after we have aligned the
 	// definition of the first 16 colors with Xterm and tcell.
 	// This ensures that users that run with termbox-go don't experience any
@@ -91,9 +91,15 @@ func convKey(tbxEv tbx.Event) terminalapi.Event {
 		return terminalapi.NewErrorf("the key event contain both a key(%v) and a character(%v)", tbxEv.Key, tbxEv.Ch)
 	}
 
+	var mods keyboard.Modifier
+	if tbxEv.Mod&tbx.ModAlt != 0 {
+		mods |= keyboard.ModAlt
+	}
+
 	if tbxEv.Ch != 0 {
 		return &terminalapi.Keyboard{
-			Key: keyboard.Key(tbxEv.Ch),
+			Key:       keyboard.Key(tbxEv.Ch),
+			Modifiers: mods,
 		}
 	}
 
@@ -102,7 +108,8 @@ func convKey(tbxEv tbx.Event) terminalapi.Event {
 		return terminalapi.NewErrorf("unknown keyboard key '%v' in a keyboard event", k)
 	}
 	return &terminalapi.Keyboard{
-		Key: k,
+		Key:       k,
+		Modifiers: mods,
 	}
 }
 
@@ -322,3 +322,37 @@ func TestKeyboardKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestKeyboardModifiers(t *testing.T) {
+	tests := []struct {
+		desc string
+		ev   tbx.Event
+		want terminalapi.Keyboard
+	}{
+		{
+			desc: "rune without modifiers",
+			ev:   tbx.Event{Type: tbx.EventKey, Ch: 'a'},
+			want: terminalapi.Keyboard{Key: 'a'},
+		},
+		{
+			desc: "rune with Alt",
+			ev:   tbx.Event{Type: tbx.EventKey, Ch: 'a', Mod: tbx.ModAlt},
+			want: terminalapi.Keyboard{Key: 'a', Modifiers: keyboard.ModAlt},
+		},
+		{
+			desc: "key with Alt",
+			ev:   tbx.Event{Type: tbx.EventKey, Key: tbx.KeyArrowUp, Mod: tbx.ModAlt},
+			want: terminalapi.Keyboard{Key: keyboard.KeyArrowUp, Modifiers: keyboard.ModAlt},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			evs := toTermdashEvents(tc.ev)
+			want := []terminalapi.Event{&tc.want}
+			if diff := pretty.Compare(want, evs); diff != "" {
+				t.Errorf("toTermdashEvents => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
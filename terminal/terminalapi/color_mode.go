@@ -16,6 +16,8 @@ package terminalapi
 
 // color_mode.go defines the terminal color modes.
 
+import "github.com/woodliu/termdash/cell"
+
 // ColorMode represents a color mode of a terminal.
 type ColorMode int
 
@@ -33,6 +35,7 @@ var colorModeNames = map[ColorMode]string{
 	ColorMode256:       "ColorMode256",
 	ColorMode216:       "ColorMode216",
 	ColorModeGrayscale: "ColorModeGrayscale",
+	ColorModeFullRGB:   "ColorModeFullRGB",
 }
 
 // Supported color modes.
@@ -57,4 +60,68 @@ const (
 	// i.e the 24 different shades of grey. However in this mode the colors are
 	// zero based, so the caller doesn't need to provide an offset.
 	ColorModeGrayscale
+
+	// ColorModeFullRGB supports all the colors of ColorMode256 and
+	// additionally true colors (24 bit) created by cell.ColorRGB.
+	ColorModeFullRGB
 )
+
+// DisplayedColor returns the terminal color number that is displayed for the
+// provided color in this color mode. Allows widgets to determine how a color
+// looks like in the active color mode.
+//
+// In ColorModeNormal colors outside of the 16 Xterm colors are displayed as
+// the nearest Xterm color. In ColorMode216 and ColorModeGrayscale the colors
+// are zero based and rotate back when above the range. Colors created by
+// cell.ColorRGB are only displayed precisely in ColorModeFullRGB and are
+// approximated in the other modes. Unknown color modes display the default
+// color.
+func (cm ColorMode) DisplayedColor(c cell.Color) cell.Color {
+	if c == cell.ColorDefault {
+		return c
+	}
+	if cm == ColorModeNormal {
+		return cell.NearestColor16(c)
+	}
+	if r, g, b, ok := c.RGB(); ok {
+		if cm == ColorModeFullRGB {
+			return c
+		}
+		c = cell.ColorRGB24(r, g, b)
+	}
+	switch cm {
+	case ColorMode256, ColorModeFullRGB:
+		c %= 256 + 1 // Add one for cell.ColorDefault.
+	case ColorMode216:
+		if c <= 216 { // Add one for cell.ColorDefault.
+			return c + 16
+		}
+		c = c%216 + 16
+	case ColorModeGrayscale:
+		if c <= 24 { // Add one for cell.ColorDefault.
+			return c + 232
+		}
+		c = c%24 + 232
+	default:
+		c = cell.ColorDefault
+	}
+	return c
+}
+
+// Gradient returns the provided number of colors evenly spaced along a
+// linear gradient between the colors from and to, suitable for this color
+// mode. In ColorModeFullRGB the colors in between are true colors as
+// returned by cell.Gradient. In the other color modes each color is
+// the nearest of the 256 terminal colors, which gives smoother gradients than
+// the approximation of true colors done by DisplayedColor.
+// Returns nil if steps is zero or negative.
+func (cm ColorMode) Gradient(from, to cell.Color, steps int) []cell.Color {
+	colors := cell.Gradient(from, to, steps)
+	if cm == ColorModeFullRGB {
+		return colors
+	}
+	for i, c := range colors {
+		colors[i] = cell.NearestColor256(c)
+	}
+	return colors
+}
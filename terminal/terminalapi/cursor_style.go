@@ -0,0 +1,65 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terminalapi
+
+// cursor_style.go defines the shapes of the terminal cursor.
+
+// CursorStyle represents the shape of the terminal cursor and whether it
+// blinks. Not all terminals support changing the cursor style.
+type CursorStyle int
+
+// String implements fmt.Stringer()
+func (cs CursorStyle) String() string {
+	if n, ok := cursorStyleNames[cs]; ok {
+		return n
+	}
+	return "CursorStyleUnknown"
+}
+
+// cursorStyleNames maps CursorStyle values to human readable names.
+var cursorStyleNames = map[CursorStyle]string{
+	CursorStyleDefault:           "CursorStyleDefault",
+	CursorStyleBlinkingBlock:     "CursorStyleBlinkingBlock",
+	CursorStyleSteadyBlock:       "CursorStyleSteadyBlock",
+	CursorStyleBlinkingUnderline: "CursorStyleBlinkingUnderline",
+	CursorStyleSteadyUnderline:   "CursorStyleSteadyUnderline",
+	CursorStyleBlinkingBar:       "CursorStyleBlinkingBar",
+	CursorStyleSteadyBar:         "CursorStyleSteadyBar",
+}
+
+// Supported cursor styles.
+const (
+	// CursorStyleDefault is the cursor style configured in the terminal.
+	CursorStyleDefault CursorStyle = iota
+
+	// CursorStyleBlinkingBlock is a blinking block covering the whole cell.
+	CursorStyleBlinkingBlock
+
+	// CursorStyleSteadyBlock is a block covering the whole cell.
+	CursorStyleSteadyBlock
+
+	// CursorStyleBlinkingUnderline is a blinking line under the cell.
+	CursorStyleBlinkingUnderline
+
+	// CursorStyleSteadyUnderline is a line under the cell.
+	CursorStyleSteadyUnderline
+
+	// CursorStyleBlinkingBar is a blinking vertical bar on the left side of
+	// the cell.
+	CursorStyleBlinkingBar
+
+	// CursorStyleSteadyBar is a vertical bar on the left side of the cell.
+	CursorStyleSteadyBar
+)
@@ -35,12 +35,24 @@ type Event interface {
 type Keyboard struct {
 	// Key is the pressed key.
 	Key keyboard.Key
+
+	// Modifiers are the modifier keys held while the key was pressed.
+	// The tcell terminal reports Shift, Ctrl, Alt and Meta. The termbox
+	// terminal only reports Alt and only when its input mode enables it.
+	// Terminals that don't report modifiers leave this set to
+	// keyboard.ModNone. Note that the control characters like
+	// keyboard.KeyCtrlA are reported as distinct keys regardless of this
+	// field.
+	Modifiers keyboard.Modifier
 }
 
 func (*Keyboard) isEvent() {}
 
 // String implements fmt.Stringer.
 func (k Keyboard) String() string {
+	if k.Modifiers != keyboard.ModNone {
+		return fmt.Sprintf("Keyboard{Key: %v, Modifiers: %v}", k.Key, k.Modifiers)
+	}
 	return fmt.Sprintf("Keyboard{Key: %v}", k.Key)
 }
 
@@ -0,0 +1,52 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terminalapi
+
+// mouse_mode.go defines which mouse events the terminal reports.
+
+// MouseMode determines whether the terminal reports mouse events and which
+// ones. Not all terminals support every mode.
+type MouseMode int
+
+// String implements fmt.Stringer()
+func (mm MouseMode) String() string {
+	if n, ok := mouseModeNames[mm]; ok {
+		return n
+	}
+	return "MouseModeUnknown"
+}
+
+// mouseModeNames maps MouseMode values to human readable names.
+var mouseModeNames = map[MouseMode]string{
+	MouseModeFull:      "MouseModeFull",
+	MouseModeClickOnly: "MouseModeClickOnly",
+	MouseModeDisabled:  "MouseModeDisabled",
+}
+
+// Supported mouse modes.
+const (
+	// MouseModeFull reports all mouse events including clicks, drags and
+	// motion.
+	MouseModeFull MouseMode = iota
+
+	// MouseModeClickOnly reports button presses, releases and the mouse
+	// wheel, but not motion.
+	MouseModeClickOnly
+
+	// MouseModeDisabled doesn't report any mouse events. This leaves mouse
+	// handling to the terminal emulator, e.g. native text selection for copy
+	// and paste.
+	MouseModeDisabled
+)
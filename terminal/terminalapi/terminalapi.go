@@ -54,3 +54,13 @@ type Terminal interface {
 	// the terminal isn't required anymore to return the screen to a sane state.
 	Close()
 }
+
+// Suspender is implemented by terminals that can temporarily hand the screen
+// back, e.g. to a subshell or an external editor.
+type Suspender interface {
+	// Suspend stops processing input and output and restores the terminal to
+	// the state it was in before the terminal was initialized.
+	Suspend() error
+	// Resume reverses Suspend and re-synchronizes the screen.
+	Resume() error
+}
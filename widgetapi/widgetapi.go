@@ -17,6 +17,7 @@ package widgetapi
 
 import (
 	"image"
+	"time"
 
 	"github.com/woodliu/termdash/private/canvas"
 	"github.com/woodliu/termdash/terminal/terminalapi"
@@ -73,6 +74,7 @@ var mouseScopeNames = map[MouseScope]string{
 	MouseScopeWidget:    "MouseScopeWidget",
 	MouseScopeContainer: "MouseScopeContainer",
 	MouseScopeGlobal:    "MouseScopeGlobal",
+	MouseScopeWheel:     "MouseScopeWheel",
 }
 
 const (
@@ -101,6 +103,17 @@ const (
 	// reset to image.Point{-1, -1} and must not be used by the widgets.
 	// The widgets are allowed to process the button event.
 	MouseScopeGlobal
+
+	// MouseScopeWheel is used when the widget only wants the mouse wheel
+	// events (mouse.ButtonWheelUp and mouse.ButtonWheelDown) that fall onto
+	// its canvas, e.g. to scroll its content. Other buttons, i.e. clicks,
+	// aren't forwarded to the widget.
+	// Like with all the other scopes, the events are forwarded regardless of
+	// whether the widget's container is focused. The wheel events are a
+	// subset of the events forwarded with MouseScopeWidget, which should be
+	// used by widgets that want to receive both the clicks and the wheel.
+	// The position of these widgets is always relative to widget's canvas.
+	MouseScopeWheel
 )
 
 // Options contains registration options for a widget.
@@ -110,6 +123,12 @@ type Options struct {
 	// the specified ratio of width:height (Ratio.X:Ratio.Y).
 	// The zero value i.e. image.Point{0, 0} indicates that the widget accepts
 	// canvas of any ratio.
+	//
+	// The container gives the widget the largest canvas with this ratio that
+	// fits its area, after applying the MaximumSize. The canvas is placed
+	// within the area according to the container's AlignHorizontal and
+	// AlignVertical options, which center it by default, and the leftover
+	// space remains empty.
 	Ratio image.Point
 
 	// MinimumSize allows a widget to specify the smallest allowed canvas size.
@@ -144,6 +163,16 @@ type Options struct {
 	// if it falls onto its canvas. See the documentation next to individual
 	// MouseScope values for details.
 	WantMouse MouseScope
+
+	// RedrawInterval allows a widget to request that the dashboard is redrawn
+	// at least this often, e.g. a clock that updates every second. When
+	// multiple widgets request an interval, or when it conflicts with the
+	// termdash.RedrawInterval option, the shortest interval wins. The zero
+	// value indicates that the widget has no requirement. The interval is
+	// re-evaluated after each redraw, so a change takes effect right after the
+	// redraw that follows it.
+	// Only affects the periodic redraw performed by termdash.Run.
+	RedrawInterval time.Duration
 }
 
 // Meta provide additional metadata to widgets.
@@ -200,3 +229,15 @@ type Widget interface {
 	// Draw.
 	Options() Options
 }
+
+// Resettable is an optional interface implemented by widgets that accumulate
+// state from the data they are given, e.g. the text of a Text widget or the
+// data points of a SparkLine. Calling Reset returns the widget to the state
+// it was in right after it was created, keeping the options it was created
+// with.
+//
+// Implementations must be thread safe. Useful together with
+// Container.Broadcast, e.g. to clear all widgets in the layout at once.
+type Resettable interface {
+	Reset()
+}
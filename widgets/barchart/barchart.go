@@ -22,6 +22,7 @@ import (
 	"image"
 	"math"
 	"sync"
+	"time"
 
 	"github.com/woodliu/termdash/align"
 	"github.com/woodliu/termdash/cell"
@@ -29,6 +30,7 @@ import (
 	"github.com/woodliu/termdash/private/area"
 	"github.com/woodliu/termdash/private/canvas"
 	"github.com/woodliu/termdash/private/draw"
+	"github.com/woodliu/termdash/private/runewidth"
 	"github.com/woodliu/termdash/terminal/terminalapi"
 	"github.com/woodliu/termdash/widgetapi"
 )
@@ -36,18 +38,31 @@ import (
 // BarChart displays multiple bars showing relative ratios of values.
 //
 // Each bar can have a text label under it explaining the meaning of the value
-// and can display the value itself inside the bar.
+// and can display the value itself inside the bar. When the Horizontal option
+// is set, the bars grow from left to right, the labels are on their left and
+// the values at their right end.
 //
 // Implements widgetapi.Widget. This object is thread-safe.
 type BarChart struct {
 	// values are the values provided on a call to Values(). These are the
 	// individual bars that will be drawn.
 	values []int
+	// segments are the values of the segments of each bar provided on a call
+	// to ValuesStacked(), nil if the bars aren't stacked. The values are the
+	// sums of the segments.
+	segments [][]int
 	// max is the maximum value of a bar. A bar having this value takes all the
 	// vertical space.
 	max int
 
-	// lastWidth is the width of the canvas as of the last time when Draw was called.
+	// from are the values the bars are animating from, scaled to the current
+	// max. Only populated when the AnimateDuration option is set.
+	from []float64
+	// animStart is the time when the current animation started.
+	animStart time.Time
+
+	// lastWidth is the width of the canvas as of the last time when Draw was
+	// called. This is the height of the canvas when the bars are horizontal.
 	lastWidth int
 
 	// mu protects the BarChart.
@@ -71,13 +86,17 @@ func New(opts ...Option) (*BarChart, error) {
 	}, nil
 }
 
+// timeSince is a function that calculates duration since some time.
+// Replaced from tests.
+var timeSince = time.Since
+
 // Draw draws the BarChart widget onto the canvas.
 // Implements widgetapi.Widget.Draw.
 func (bc *BarChart) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	bc.mu.Lock()
 	defer bc.mu.Unlock()
 
-	bc.lastWidth = cvs.Area().Dx()
+	bc.lastWidth = bc.across(cvs)
 	needAr, err := area.FromSize(bc.minSize())
 	if err != nil {
 		return err
@@ -86,30 +105,20 @@ func (bc *BarChart) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 		return draw.ResizeNeeded(cvs)
 	}
 
-	for i, v := range bc.values {
-		r, err := bc.barRect(cvs, i, v)
-		if err != nil {
+	for i, v := range bc.current() {
+		if err := bc.drawBar(cvs, i, v); err != nil {
 			return err
 		}
 
-		if r.Dy() > 0 { // Value might be so small so that the rectangle is zero.
-			if err := draw.Rectangle(cvs, r,
-				draw.RectCellOpts(cell.BgColor(bc.barColor(i))),
-				draw.RectChar(bc.opts.barChar),
-			); err != nil {
-				return err
-			}
-		}
-
 		if bc.opts.showValues {
-			if err := bc.drawText(cvs, i, fmt.Sprint(bc.values[i]), bc.valColor(i), insideBar); err != nil {
+			if err := bc.drawText(cvs, i, v, fmt.Sprint(bc.values[i]), bc.valColor(i), insideBar); err != nil {
 				return err
 			}
 		}
 
 		l, c := bc.label(i)
 		if l != "" {
-			if err := bc.drawText(cvs, i, l, c, underBar); err != nil {
+			if err := bc.drawText(cvs, i, v, l, c, underBar); err != nil {
 				return err
 			}
 		}
@@ -117,6 +126,60 @@ func (bc *BarChart) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	return nil
 }
 
+// drawBar draws the i-th bar which currently displays the value v. Stacked
+// bars are drawn as segments, each sized proportionally to its share of the
+// bar's value.
+func (bc *BarChart) drawBar(cvs *canvas.Canvas, i int, v float64) error {
+	r, err := bc.barRect(cvs, i, v)
+	if err != nil {
+		return err
+	}
+
+	var segs []int
+	if i < len(bc.segments) {
+		segs = bc.segments[i]
+	}
+	if len(segs) == 0 || bc.values[i] == 0 {
+		return bc.drawRect(cvs, r, bc.barColor(i))
+	}
+
+	// Segments are sized by their cumulative sums, so rounding doesn't leave
+	// gaps between them.
+	prev, err := bc.barRect(cvs, i, 0)
+	if err != nil {
+		return err
+	}
+	var sum int
+	for j, s := range segs {
+		sum += s
+		cur, err := bc.barRect(cvs, i, v*float64(sum)/float64(bc.values[i]))
+		if err != nil {
+			return err
+		}
+
+		seg := image.Rect(cur.Min.X, cur.Min.Y, cur.Max.X, prev.Min.Y)
+		if bc.opts.horizontal {
+			seg = image.Rect(prev.Max.X, cur.Min.Y, cur.Max.X, cur.Max.Y)
+		}
+		if err := bc.drawRect(cvs, seg, bc.segColor(i, j)); err != nil {
+			return err
+		}
+		prev = cur
+	}
+	return nil
+}
+
+// drawRect draws the rectangle of a bar or of its segment.
+func (bc *BarChart) drawRect(cvs *canvas.Canvas, r image.Rectangle, color cell.Color) error {
+	if r.Empty() { // Value might be so small so that the rectangle is zero.
+		return nil
+	}
+	return draw.Rectangle(cvs, r,
+		draw.RectCellOpts(cell.BgColor(color)),
+		draw.RectChar(bc.opts.barChar),
+	)
+}
+
 // textLoc represents the location of the drawn text.
 type textLoc int
 
@@ -125,12 +188,17 @@ const (
 	underBar
 )
 
-// drawText draws the provided text inside or under the i-th bar.
-func (bc *BarChart) drawText(cvs *canvas.Canvas, i int, text string, color cell.Color, loc textLoc) error {
+// drawText draws the provided text inside or under the i-th bar which
+// currently displays the value v.
+func (bc *BarChart) drawText(cvs *canvas.Canvas, i int, v float64, text string, color cell.Color, loc textLoc) error {
+	if bc.opts.horizontal {
+		return bc.drawHorizontalText(cvs, i, v, text, color, loc)
+	}
+
 	// Rectangle representing area in which the text will be aligned.
 	var barCol image.Rectangle
 
-	r, err := bc.barRect(cvs, i, bc.max)
+	r, err := bc.barRect(cvs, i, float64(bc.max))
 	if err != nil {
 		return err
 	}
@@ -157,7 +225,87 @@ func (bc *BarChart) drawText(cvs *canvas.Canvas, i int, text string, color cell.
 	)
 }
 
-// barWidth determines the width of a single bar based on options and the canvas.
+// drawHorizontalText draws the provided text at the right end of or on the
+// left of the i-th horizontal bar which currently displays the value v.
+func (bc *BarChart) drawHorizontalText(cvs *canvas.Canvas, i int, v float64, text string, color cell.Color, loc textLoc) error {
+	// Rectangle representing the entire row where the bar is.
+	barRow, err := bc.barRect(cvs, i, float64(bc.max))
+	if err != nil {
+		return err
+	}
+
+	var (
+		textAr image.Rectangle
+		start  image.Point
+	)
+	switch loc {
+	case insideBar:
+		// Align the text to the end of the bar, unless the bar is too short
+		// to contain it.
+		bar, err := bc.barRect(cvs, i, v)
+		if err != nil {
+			return err
+		}
+		textAr = barRow
+		start, err = alignfor.Text(textAr, text, align.HorizontalLeft, align.VerticalMiddle)
+		if err != nil {
+			return err
+		}
+		if x := bar.Max.X - runewidth.StringWidth(text); x > start.X {
+			start.X = x
+		}
+
+	case underBar:
+		// Align the text within the space left for the labels.
+		textAr = image.Rect(cvs.Area().Min.X, barRow.Min.Y, barRow.Min.X-labelGap, barRow.Max.Y)
+		start, err = alignfor.Text(textAr, text, align.HorizontalLeft, align.VerticalMiddle)
+		if err != nil {
+			return err
+		}
+	}
+
+	return draw.Text(cvs, text, start,
+		draw.TextCellOpts(cell.FgColor(color)),
+		draw.TextMaxX(textAr.Max.X),
+		draw.TextOverrunMode(draw.OverrunModeThreeDot),
+	)
+}
+
+// labelGap is the number of cells between the labels and the horizontal bars.
+const labelGap = 1
+
+// labelWidth returns the width of the space on the left of horizontal bars
+// reserved for the labels, including the gap between the labels and the
+// bars. Returns zero for vertical bars or when there are no labels.
+func (bc *BarChart) labelWidth() int {
+	if !bc.opts.horizontal {
+		return 0
+	}
+
+	var width int
+	for _, l := range bc.opts.labels {
+		if w := runewidth.StringWidth(l); w > width {
+			width = w
+		}
+	}
+	if width == 0 {
+		return 0
+	}
+	return width + labelGap
+}
+
+// across returns the size of the canvas in the direction in which the bars
+// are placed next to each other, i.e. the width for vertical bars and the
+// height for horizontal bars.
+func (bc *BarChart) across(cvs *canvas.Canvas) int {
+	if bc.opts.horizontal {
+		return cvs.Area().Dy()
+	}
+	return cvs.Area().Dx()
+}
+
+// barWidth determines the width of a single bar based on options and the
+// canvas. This is the height of the bar when the bars are horizontal.
 func (bc *BarChart) barWidth(cvs *canvas.Canvas) int {
 	if len(bc.values) == 0 {
 		return 0 // No width when we have no values.
@@ -170,14 +318,17 @@ func (bc *BarChart) barWidth(cvs *canvas.Canvas) int {
 
 	gaps := len(bc.values) - 1
 	gapW := gaps * bc.opts.barGap
-	rem := cvs.Area().Dx() - gapW
+	rem := bc.across(cvs) - gapW
 	return rem / len(bc.values)
 }
 
-// barHeight determines the height of the i-th bar based on the value it is displaying.
-func (bc *BarChart) barHeight(cvs *canvas.Canvas, i, value int) int {
+// barHeight determines the height of the i-th bar based on the value it is
+// displaying. This is the length of the bar when the bars are horizontal.
+func (bc *BarChart) barHeight(cvs *canvas.Canvas, i int, value float64) int {
 	available := cvs.Area().Dy()
-	if len(bc.opts.labels) > 0 {
+	if bc.opts.horizontal {
+		available = cvs.Area().Dx() - bc.labelWidth()
+	} else if len(bc.opts.labels) > 0 {
 		// One line for the bar labels.
 		available--
 	}
@@ -188,7 +339,7 @@ func (bc *BarChart) barHeight(cvs *canvas.Canvas, i, value int) int {
 
 // barRect returns a rectangle that represents the i-th bar on the canvas that
 // displays the specified value.
-func (bc *BarChart) barRect(cvs *canvas.Canvas, i, value int) (image.Rectangle, error) {
+func (bc *BarChart) barRect(cvs *canvas.Canvas, i int, value float64) (image.Rectangle, error) {
 	bw := bc.barWidth(cvs)
 	minX := bw * i
 	if i > 0 {
@@ -197,6 +348,11 @@ func (bc *BarChart) barRect(cvs *canvas.Canvas, i, value int) (image.Rectangle,
 	maxX := minX + bw
 
 	bh := bc.barHeight(cvs, i, value)
+	if bc.opts.horizontal {
+		// The bars grow from the left, the coordinates are transposed.
+		x := bc.labelWidth()
+		return image.Rect(x, minX, x+bh, maxX), nil
+	}
 	maxY := cvs.Area().Max.Y
 	if len(bc.opts.labels) > 0 {
 		// One line for the bar labels.
@@ -206,6 +362,33 @@ func (bc *BarChart) barRect(cvs *canvas.Canvas, i, value int) (image.Rectangle,
 	return image.Rect(minX, minY, maxX, maxY), nil
 }
 
+// current returns the values the bars should currently display. These are
+// equal to the values provided on the last call to Values() unless an
+// animation is in progress, in which case they are interpolated between the
+// previous and the new values based on the elapsed time.
+func (bc *BarChart) current() []float64 {
+	res := make([]float64, len(bc.values))
+	progress := 1.0
+	if d := bc.opts.animateDuration; d > 0 && bc.from != nil {
+		progress = float64(timeSince(bc.animStart)) / float64(d)
+	}
+
+	for i, v := range bc.values {
+		to := float64(v)
+		if progress >= 1 {
+			res[i] = to
+			continue
+		}
+
+		var from float64
+		if i < len(bc.from) {
+			from = bc.from[i]
+		}
+		res[i] = from + (to-from)*progress
+	}
+	return res
+}
+
 // barColor safely determines the color for the i-th bar.
 // Colors are optional and don't have to be specified for all the bars.
 func (bc *BarChart) barColor(i int) cell.Color {
@@ -215,6 +398,15 @@ func (bc *BarChart) barColor(i int) cell.Color {
 	return DefaultBarColor
 }
 
+// segColor safely determines the color for the j-th segment of the i-th bar.
+// Segments without a color use the color of the bar.
+func (bc *BarChart) segColor(i, j int) cell.Color {
+	if len(bc.opts.segColors) > j {
+		return bc.opts.segColors[j]
+	}
+	return bc.barColor(i)
+}
+
 // valColor safely determines the color for the i-th value.
 // Colors are optional and don't have to be specified for all the values.
 func (bc *BarChart) valColor(i int) cell.Color {
@@ -270,13 +462,78 @@ func (bc *BarChart) Values(values []int, max int, opts ...Option) error {
 	if err := validateValues(v, max); err != nil {
 		return err
 	}
+	bc.setValues(v, nil, max, opts)
+	return nil
+}
 
+// ValuesStacked is like Values, but each bar is stacked from segments. The
+// values of the segments of a bar are added up and the bar displays the sum,
+// with each segment taking its share of the bar. The segments are drawn from
+// the bottom of the bar up, or from left to right when the bars are
+// horizontal, in colors set by the SegmentColors option.
+// The values must not be negative and the sum of the segments of each bar
+// must be less or equal the maximum value. ShowValues displays the sums.
+// Provided options override values set when New() was called.
+func (bc *BarChart) ValuesStacked(values [][]int, max int, opts ...Option) error {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+
+	// Copy to avoid external modifications. See #174.
+	segs := make([][]int, len(values))
+	sums := make([]int, len(values))
+	for i, bar := range values {
+		segs[i] = make([]int, len(bar))
+		copy(segs[i], bar)
+		for j, s := range bar {
+			if s < 0 {
+				return fmt.Errorf("invalid values[%d][%d]: %d, each value must be positive or zero", i, j, s)
+			}
+			sums[i] += s
+		}
+	}
+	if err := validateValues(sums, max); err != nil {
+		return err
+	}
+	bc.setValues(sums, segs, max, opts)
+	return nil
+}
+
+// setValues sets the values, segments and the maximum after they were
+// validated and applies the options.
+func (bc *BarChart) setValues(v []int, segs [][]int, max int, opts []Option) {
 	for _, opt := range opts {
 		opt.set(bc.opts)
 	}
+
+	if bc.opts.animateDuration > 0 {
+		// Start the animation from wherever the bars currently are, scaled
+		// to the new maximum.
+		from := bc.current()
+		for i := range from {
+			from[i] = from[i] * float64(max) / float64(bc.max)
+		}
+		bc.from = from
+		bc.animStart = time.Now()
+	} else {
+		bc.from = nil
+	}
 	bc.values = v
+	bc.segments = segs
 	bc.max = max
-	return nil
+}
+
+// Reset removes all the values from the BarChart, effectively returning to an
+// empty chart. Options provided to New() or Values() remain in effect.
+// Implements widgetapi.Resettable.
+func (bc *BarChart) Reset() {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+
+	bc.values = nil
+	bc.segments = nil
+	bc.max = 0
+	bc.from = nil
+	bc.animStart = time.Time{}
 }
 
 // Keyboard input isn't supported on the BarChart widget.
@@ -300,15 +557,34 @@ func (bc *BarChart) Options() widgetapi.Options {
 	// never update bc.lastWidth and the result of ValueCapacity().
 	// Draw will stil refuse to draw if the canvas is too small, but the user
 	// will have an option to send less values.
-	min.X = bc.minBarWidth()
+	if bc.opts.horizontal {
+		min.Y = bc.minBarWidth()
+	} else {
+		min.X = bc.minBarWidth()
+	}
 
+	var redraw time.Duration
+	if bc.animating() {
+		redraw = animationRedrawInterval
+	}
 	return widgetapi.Options{
-		MinimumSize:  min,
-		WantKeyboard: widgetapi.KeyScopeNone,
-		WantMouse:    widgetapi.MouseScopeNone,
+		MinimumSize:    min,
+		WantKeyboard:   widgetapi.KeyScopeNone,
+		WantMouse:      widgetapi.MouseScopeNone,
+		RedrawInterval: redraw,
 	}
 }
 
+// animationRedrawInterval is the redraw interval the BarChart requests while
+// an animation is in progress, so that the bars grow smoothly.
+const animationRedrawInterval = 40 * time.Millisecond
+
+// animating asserts whether an animation is in progress.
+func (bc *BarChart) animating() bool {
+	d := bc.opts.animateDuration
+	return d > 0 && bc.from != nil && timeSince(bc.animStart) < d
+}
+
 // minBarWidth determines the minimum possible width of a bar based on the
 // options.
 func (bc *BarChart) minBarWidth() int {
@@ -328,6 +604,12 @@ func (bc *BarChart) minSize() image.Point {
 		return image.Point{1, 1}
 	}
 
+	if bc.opts.horizontal {
+		minWidth := bc.labelWidth() + 1 // At least one character for the bar.
+		minHeight := bars*bc.minBarWidth() + (bars-1)*bc.opts.barGap
+		return image.Point{minWidth, minHeight}
+	}
+
 	minHeight := 1 // At least one character vertically to display the bar.
 	if len(bc.opts.labels) > 0 {
 		minHeight++ // One line for the labels.
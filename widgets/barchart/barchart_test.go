@@ -17,6 +17,7 @@ package barchart
 import (
 	"image"
 	"testing"
+	"time"
 
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/woodliu/termdash/cell"
@@ -69,6 +70,20 @@ func TestBarChart(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			desc: "fails on negative animate duration",
+			opts: []Option{
+				AnimateDuration(-1 * time.Second),
+			},
+			update: func(bc *BarChart) error {
+				return nil
+			},
+			canvas: image.Rect(0, 0, 3, 10),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
 		{
 			desc: "draws empty for no values",
 			opts: []Option{
@@ -83,6 +98,24 @@ func TestBarChart(t *testing.T) {
 			},
 			wantCapacity: 2,
 		},
+		{
+			desc: "draws empty after reset",
+			opts: []Option{
+				Char('o'),
+			},
+			update: func(bc *BarChart) error {
+				if err := bc.Values([]int{0, 2, 5, 10}, 10); err != nil {
+					return err
+				}
+				bc.Reset()
+				return nil
+			},
+			canvas: image.Rect(0, 0, 3, 10),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantCapacity: 2,
+		},
 		{
 			desc: "fails for zero max",
 			opts: []Option{
@@ -139,6 +172,135 @@ func TestBarChart(t *testing.T) {
 			},
 			wantUpdateErr: true,
 		},
+		{
+			desc: "fails when negative stacked value",
+			opts: []Option{
+				Char('o'),
+			},
+			update: func(bc *BarChart) error {
+				return bc.ValuesStacked([][]int{{1, -1}}, 10)
+			},
+			canvas: image.Rect(0, 0, 3, 10),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantUpdateErr: true,
+		},
+		{
+			desc: "fails for sum of stacked values larger than max",
+			opts: []Option{
+				Char('o'),
+			},
+			update: func(bc *BarChart) error {
+				return bc.ValuesStacked([][]int{{1, 2}, {5, 6}}, 10)
+			},
+			canvas: image.Rect(0, 0, 3, 10),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantUpdateErr: true,
+		},
+		{
+			desc: "displays stacked bars",
+			opts: []Option{
+				Char('o'),
+				ShowValues(),
+				SegmentColors([]cell.Color{cell.ColorBlue, cell.ColorGreen}),
+			},
+			update: func(bc *BarChart) error {
+				return bc.ValuesStacked([][]int{{2, 3}, {5, 0, 5}}, 10)
+			},
+			canvas: image.Rect(0, 0, 3, 10),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 8, 1, 10),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorBlue)),
+				)
+				testdraw.MustRectangle(c, image.Rect(0, 5, 1, 8),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testdraw.MustRectangle(c, image.Rect(2, 5, 3, 10),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorBlue)),
+				)
+				// The third segment doesn't have a color, uses the bar color.
+				testdraw.MustRectangle(c, image.Rect(2, 0, 3, 5),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+
+				// Values are the sums of the segments.
+				testdraw.MustText(c, "5", image.Point{0, 9}, draw.TextCellOpts(
+					cell.FgColor(DefaultValueColor),
+					cell.BgColor(cell.ColorBlue),
+				))
+				testdraw.MustText(c, "…", image.Point{2, 9}, draw.TextCellOpts(
+					cell.FgColor(DefaultValueColor),
+					cell.BgColor(cell.ColorBlue),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 2,
+		},
+		{
+			desc: "displays horizontal stacked bars",
+			opts: []Option{
+				Char('o'),
+				Horizontal(),
+				SegmentColors([]cell.Color{cell.ColorBlue, cell.ColorGreen}),
+			},
+			update: func(bc *BarChart) error {
+				return bc.ValuesStacked([][]int{{4, 6}, {}}, 10)
+			},
+			canvas: image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 4, 1),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorBlue)),
+				)
+				testdraw.MustRectangle(c, image.Rect(4, 0, 10, 1),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 2,
+		},
+		{
+			desc: "values replace stacked values",
+			opts: []Option{
+				Char('o'),
+				SegmentColors([]cell.Color{cell.ColorBlue}),
+			},
+			update: func(bc *BarChart) error {
+				if err := bc.ValuesStacked([][]int{{5, 5}}, 10); err != nil {
+					return err
+				}
+				return bc.Values([]int{10}, 10)
+			},
+			canvas: image.Rect(0, 0, 1, 10),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 1, 10),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 1,
+		},
 		{
 			desc: "draws resize needed character when canvas is smaller than requested",
 			opts: []Option{
@@ -580,6 +742,97 @@ func TestBarChart(t *testing.T) {
 			},
 			wantCapacity: 3,
 		},
+		{
+			desc: "displays horizontal bars",
+			opts: []Option{
+				Char('o'),
+				Horizontal(),
+			},
+			update: func(bc *BarChart) error {
+				return bc.Values([]int{0, 5, 10}, 10)
+			},
+			canvas: image.Rect(0, 0, 10, 5),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 2, 5, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testdraw.MustRectangle(c, image.Rect(0, 4, 10, 5),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 3,
+		},
+		{
+			desc: "displays horizontal bars with labels and values",
+			opts: []Option{
+				Char('o'),
+				Horizontal(),
+				ShowValues(),
+				Labels([]string{
+					"a",
+					"long",
+				}),
+			},
+			update: func(bc *BarChart) error {
+				return bc.Values([]int{2, 10}, 10)
+			},
+			canvas: image.Rect(0, 0, 9, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(5, 2, 9, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+
+				// Values.
+				testdraw.MustText(c, "2", image.Point{5, 0}, draw.TextCellOpts(
+					cell.FgColor(DefaultValueColor),
+				))
+				testdraw.MustText(c, "10", image.Point{7, 2}, draw.TextCellOpts(
+					cell.FgColor(DefaultValueColor),
+					cell.BgColor(DefaultBarColor),
+				))
+
+				// Labels.
+				testdraw.MustText(c, "a", image.Point{0, 0}, draw.TextCellOpts(
+					cell.FgColor(DefaultLabelColor),
+				))
+				testdraw.MustText(c, "long", image.Point{0, 2}, draw.TextCellOpts(
+					cell.FgColor(DefaultLabelColor),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 2,
+		},
+		{
+			desc: "draws resize needed character when horizontal bars don't fit the height",
+			opts: []Option{
+				Horizontal(),
+			},
+			update: func(bc *BarChart) error {
+				return bc.Values([]int{1, 2}, 10)
+			},
+			canvas: image.Rect(0, 0, 3, 2),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustResizeNeeded(c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 1,
+		},
 		{
 			desc: "regression for #174, protects against external data mutation",
 			opts: []Option{
@@ -689,6 +942,120 @@ func TestBarChart(t *testing.T) {
 	}
 }
 
+func TestAnimateDuration(t *testing.T) {
+	tests := []struct {
+		desc string
+		// first are the values provided before the animation starts.
+		first []int
+		// second are the values the bars animate to.
+		second []int
+		max    int
+		// elapsed is the time elapsed since the second call to Values.
+		elapsed time.Duration
+		// wantHeights are the expected heights of the drawn bars.
+		wantHeights []int
+		// wantRedrawInterval is the expected RedrawInterval in the options.
+		wantRedrawInterval time.Duration
+	}{
+		{
+			desc:               "animation just started",
+			first:              []int{0, 10},
+			second:             []int{10, 0},
+			max:                10,
+			wantHeights:        []int{0, 10},
+			wantRedrawInterval: animationRedrawInterval,
+		},
+		{
+			desc:               "animation half way through",
+			first:              []int{0, 10},
+			second:             []int{10, 0},
+			max:                10,
+			elapsed:            500 * time.Millisecond,
+			wantHeights:        []int{5, 5},
+			wantRedrawInterval: animationRedrawInterval,
+		},
+		{
+			desc:        "animation finished",
+			first:       []int{0, 10},
+			second:      []int{10, 0},
+			max:         10,
+			elapsed:     2 * time.Second,
+			wantHeights: []int{10, 0},
+		},
+		{
+			desc:               "new bars grow from zero",
+			first:              []int{10},
+			second:             []int{10, 10},
+			max:                10,
+			elapsed:            500 * time.Millisecond,
+			wantHeights:        []int{10, 5},
+			wantRedrawInterval: animationRedrawInterval,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			// The animation to the first values completes before the second
+			// values are provided.
+			timeSince = func(time.Time) time.Duration {
+				return time.Hour
+			}
+			defer func() {
+				timeSince = time.Since
+			}()
+
+			bc, err := New(
+				Char('o'),
+				AnimateDuration(time.Second),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if err := bc.Values(tc.first, tc.max); err != nil {
+				t.Fatalf("Values => unexpected error: %v", err)
+			}
+			if err := bc.Values(tc.second, tc.max); err != nil {
+				t.Fatalf("Values => unexpected error: %v", err)
+			}
+
+			timeSince = func(time.Time) time.Duration {
+				return tc.elapsed
+			}
+			c := testcanvas.MustNew(image.Rect(0, 0, 3, 10))
+			if err := bc.Draw(c, &widgetapi.Meta{}); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+			got, err := faketerm.New(c.Size())
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			if err := c.Apply(got); err != nil {
+				t.Fatalf("Apply => unexpected error: %v", err)
+			}
+
+			want := faketerm.MustNew(c.Size())
+			wc := testcanvas.MustNew(want.Area())
+			for i, h := range tc.wantHeights {
+				if h == 0 {
+					continue
+				}
+				testdraw.MustRectangle(wc, image.Rect(i*2, 10-h, i*2+1, 10),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+			}
+			testcanvas.MustApply(wc, want)
+			if diff := faketerm.Diff(want, got); diff != "" {
+				t.Errorf("Draw => %v", diff)
+			}
+
+			if got := bc.Options().RedrawInterval; got != tc.wantRedrawInterval {
+				t.Errorf("Options => RedrawInterval %v, want %v", got, tc.wantRedrawInterval)
+			}
+		})
+	}
+}
+
 func TestOptions(t *testing.T) {
 	tests := []struct {
 		desc   string
@@ -775,6 +1142,28 @@ func TestOptions(t *testing.T) {
 				WantMouse:    widgetapi.MouseScopeNone,
 			},
 		},
+		{
+			desc: "minimum size for horizontal bars accounts for labels",
+			create: func() (*BarChart, error) {
+				bc, err := New(
+					Horizontal(),
+					BarWidth(2),
+					Labels([]string{"foo", "a"}),
+				)
+				if err != nil {
+					return nil, err
+				}
+				if err := bc.Values([]int{1, 2}, 3); err != nil {
+					return nil, err
+				}
+				return bc, nil
+			},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{5, 2},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeNone,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -18,6 +18,7 @@ package barchart
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/woodliu/termdash/cell"
 	"github.com/woodliu/termdash/private/draw"
@@ -46,7 +47,11 @@ type options struct {
 	barColors   []cell.Color
 	labelColors []cell.Color
 	valueColors []cell.Color
+	segColors   []cell.Color
 	labels      []string
+	horizontal  bool
+
+	animateDuration time.Duration
 }
 
 // validate validates the provided options.
@@ -57,6 +62,9 @@ func (o *options) validate() error {
 	if got, min := o.barGap, 0; got < min {
 		return fmt.Errorf("invalid BarGap %d, must be %d <= BarGap", got, min)
 	}
+	if got, min := o.animateDuration, time.Duration(0); got < min {
+		return fmt.Errorf("invalid AnimateDuration %v, must be %v <= AnimateDuration", got, min)
+	}
 	return nil
 }
 
@@ -79,9 +87,9 @@ func Char(ch rune) Option {
 	})
 }
 
-// BarWidth sets the width of the bars. If not set, or set to zero, the bars
-// use all the space available to the widget. Must be a positive or zero
-// integer.
+// BarWidth sets the width of the bars, or their height when the bars are
+// horizontal. If not set, or set to zero, the bars use all the space available
+// to the widget. Must be a positive or zero integer.
 func BarWidth(width int) Option {
 	return option(func(opts *options) {
 		opts.barWidth = width
@@ -91,7 +99,8 @@ func BarWidth(width int) Option {
 // DefaultBarGap is the default value for the BarGap option.
 const DefaultBarGap = 1
 
-// BarGap sets the width of the space between the bars.
+// BarGap sets the width of the space between the bars, or its height when
+// the bars are horizontal.
 // Must be a positive or zero integer.
 // Defaults to DefaultBarGap.
 func BarGap(width int) Option {
@@ -121,6 +130,17 @@ func BarColors(colors []cell.Color) Option {
 	})
 }
 
+// SegmentColors sets the colors of the segments of stacked bars, see
+// BarChart.ValuesStacked. The first supplied color applies to the first
+// segment of each bar, i.e. the one at the bottom of the bar or at its left
+// end when the bars are horizontal. Any segments that don't have a color
+// specified use the color of their bar.
+func SegmentColors(colors []cell.Color) Option {
+	return option(func(opts *options) {
+		opts.segColors = colors
+	})
+}
+
 // DefaultLabelColor is the default color of a bar label, unless specified
 // otherwise via the LabelColors option.
 const DefaultLabelColor = cell.ColorGreen
@@ -162,3 +182,28 @@ func ValueColors(colors []cell.Color) Option {
 		opts.valueColors = colors
 	})
 }
+
+// AnimateDuration makes the bars gradually move towards new values provided on
+// a call to Values() instead of jumping to them. The height of each bar is
+// interpolated between its previous and its new value over the specified
+// duration. While the bars are moving, the widget asks termdash to redraw it
+// frequently, regardless of the redraw interval used by termdash. Values
+// provided on the first call to Values() animate from zero.
+// Must be a positive or zero duration, zero disables the animation.
+// Defaults to zero.
+func AnimateDuration(d time.Duration) Option {
+	return option(func(opts *options) {
+		opts.animateDuration = d
+	})
+}
+
+// Horizontal makes the bars extend from left to right instead of from the
+// bottom up. The labels are displayed on the left of the bars in a column as
+// wide as the longest label and the values at the right end of each bar.
+// The BarWidth and BarGap options then set the height of the bars and of the
+// space between them and ValueCapacity is determined by the canvas height.
+func Horizontal() Option {
+	return option(func(opts *options) {
+		opts.horizontal = true
+	})
+}
@@ -31,7 +31,9 @@ import (
 	"github.com/woodliu/termdash/private/attrrange"
 	"github.com/woodliu/termdash/private/button"
 	"github.com/woodliu/termdash/private/canvas"
-	"github.com/woodliu/termdash/private/draw"
+	"github.com/woodliu/termdash/private/canvas/buffer"
+	"github.com/woodliu/termdash/private/runewidth"
+	"github.com/woodliu/termdash/private/wrap"
 	"github.com/woodliu/termdash/terminal/terminalapi"
 	"github.com/woodliu/termdash/widgetapi"
 )
@@ -87,9 +89,24 @@ type Button struct {
 	// provide us with release events for keys.
 	keyTriggerTime *time.Time
 
+	// pressTime is the time when the button was last pressed down using the
+	// mouse. Used to detect long presses.
+	pressTime time.Time
+
+	// repeater calls the callback repeatedly while the button is held down
+	// using the mouse. Nil unless the RepeatInterval option is set and the
+	// button is held down.
+	repeater *repeater
+
 	// callback gets called on each button press.
 	callback CallbackFn
 
+	// menuOpen indicates whether the menu is open.
+	// Only used when the Menu option is set.
+	menuOpen bool
+	// menuHighlighted is the index of the highlighted menu item.
+	menuHighlighted int
+
 	// mu protects the widget.
 	mu sync.Mutex
 
@@ -170,6 +187,14 @@ var (
 
 	// timeSince is a function that calculates duration since some time.
 	timeSince = time.Since
+
+	// newRepeatTicker returns the channel that ticks every interval while the
+	// button repeats its callback and a function that stops the ticker.
+	// Replaced from tests to control the ticks.
+	newRepeatTicker = func(interval time.Duration) (<-chan time.Time, func()) {
+		t := time.NewTicker(interval)
+		return t.C, t.Stop
+	}
 )
 
 // Draw draws the Button widget onto the canvas.
@@ -178,25 +203,33 @@ func (b *Button) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	if b.repeater != nil {
+		b.repeater.drawn()
+	}
 	if b.keyTriggerTime != nil {
 		since := timeSince(*b.keyTriggerTime)
 		if since > b.opts.keyUpDelay {
 			b.state = button.Up
+			b.keyTriggerTime = nil
 		}
 	}
 
 	cvsAr := cvs.Area()
+	if b.opts.menu != nil {
+		// The rows under the button are reserved for the menu.
+		cvsAr.Max.Y = min(cvsAr.Max.Y, cvsAr.Min.Y+b.buttonSize().Y)
+	}
 	b.mouseFSM.UpdateArea(cvsAr)
 
-	sw := b.shadowWidth()
-	shadowAr := image.Rect(sw, sw, cvsAr.Dx(), cvsAr.Dy())
+	so := b.shadowOffset()
+	shadowAr := image.Rect(so.X, so.Y, cvsAr.Dx(), cvsAr.Dy())
 	if !b.opts.disableShadow {
 		if err := cvs.SetAreaCells(shadowAr, shadowRune, cell.BgColor(b.opts.shadowColor)); err != nil {
 			return err
 		}
 	}
 
-	buttonAr := image.Rect(0, 0, cvsAr.Dx()-sw, cvsAr.Dy()-sw)
+	buttonAr := image.Rect(0, 0, cvsAr.Dx()-so.X, cvsAr.Dy()-so.Y)
 	if b.state == button.Down && !b.opts.disableShadow {
 		buttonAr = shadowAr
 	}
@@ -214,50 +247,136 @@ func (b *Button) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	if err := cvs.SetAreaCells(buttonAr, buttonRune, cell.BgColor(fillColor)); err != nil {
 		return err
 	}
-	return b.drawText(cvs, meta, buttonAr)
+	if err := b.drawText(cvs, meta, buttonAr, fillColor); err != nil {
+		return err
+	}
+	return b.drawMenu(cvs, cvsAr.Max.Y)
 }
 
 // drawText draws the text inside the button.
-func (b *Button) drawText(cvs *canvas.Canvas, meta *widgetapi.Meta, buttonAr image.Rectangle) error {
+// If the button is taller than one cell, text that doesn't fit onto a single
+// line is wrapped at word boundaries and each line is centered. The text is
+// trimmed with an ellipsis only if it exceeds all the available lines.
+func (b *Button) drawText(cvs *canvas.Canvas, meta *widgetapi.Meta, buttonAr image.Rectangle, fillColor cell.Color) error {
 	pad := b.opts.textHorizontalPadding
-	textAr := image.Rect(buttonAr.Min.X+pad, buttonAr.Min.Y, buttonAr.Dx()-pad, buttonAr.Max.Y)
-	start, err := alignfor.Text(textAr, b.text.String(), align.HorizontalCenter, align.VerticalMiddle)
+	textAr := image.Rect(buttonAr.Min.X+pad, buttonAr.Min.Y, buttonAr.Max.X-pad, buttonAr.Max.Y)
+	maxX := buttonAr.Max.X
+	if sr := b.opts.leadingRune; sr != nil {
+		runeAr := image.Rect(textAr.Min.X, textAr.Min.Y, textAr.Min.X+runewidth.RuneWidth(sr.r), textAr.Max.Y)
+		if err := b.drawSideRune(cvs, sr, runeAr, buttonAr); err != nil {
+			return err
+		}
+		textAr.Min.X += sr.width()
+	}
+	if sr := b.opts.trailingRune; sr != nil {
+		runeAr := image.Rect(textAr.Max.X-runewidth.RuneWidth(sr.r), textAr.Min.Y, textAr.Max.X, textAr.Max.Y)
+		if err := b.drawSideRune(cvs, sr, runeAr, buttonAr); err != nil {
+			return err
+		}
+		textAr.Max.X -= sr.width()
+		maxX = textAr.Max.X
+	}
+
+	cells, err := b.textCells(meta, fillColor)
 	if err != nil {
 		return err
 	}
 
-	maxCells := buttonAr.Max.X - start.X
-	trimmed, err := draw.TrimText(b.text.String(), maxCells, draw.OverrunModeThreeDot)
+	if textAr.Dy() <= 1 || textAr.Dx() < 1 || runewidth.StringWidth(b.text.String()) <= textAr.Dx() {
+		return drawLine(cvs, cells, textAr, maxX)
+	}
+
+	lines, err := wrap.Cells(cells, textAr.Dx(), wrap.AtWords)
 	if err != nil {
 		return err
 	}
+	if len(lines) > textAr.Dy() {
+		last := textAr.Dy() - 1
+		lines[last] = withEllipsis(lines[last], textAr.Dx())
+		lines = lines[:textAr.Dy()]
+	}
 
-	optRange, err := b.tOptsTracker.ForPosition(0) // Text options for the current byte.
+	startY := textAr.Min.Y + (textAr.Dy()-len(lines))/2
+	for i, line := range lines {
+		lineAr := image.Rect(textAr.Min.X, startY+i, textAr.Max.X, startY+i+1)
+		if err := drawLine(cvs, line, lineAr, textAr.Max.X); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drawSideRune draws a leading or trailing rune vertically centered in its
+// area. The rune isn't drawn if its area doesn't fit inside the button.
+func (b *Button) drawSideRune(cvs *canvas.Canvas, sr *sideRune, runeAr, buttonAr image.Rectangle) error {
+	if runeAr.Empty() || !runeAr.In(buttonAr) {
+		return nil
+	}
+	start, err := alignfor.Text(runeAr, string(sr.r), align.HorizontalLeft, align.VerticalMiddle)
 	if err != nil {
 		return err
 	}
+	cellOpts := append([]cell.Option{cell.FgColor(b.opts.textColor)}, sr.cellOpts...)
+	_, err = cvs.SetCell(start, sr.r, cellOpts...)
+	return err
+}
+
+// textCells returns the cells of the button's text with the cell options that
+// apply in the current state of the button drawn over the fill color.
+func (b *Button) textCells(meta *widgetapi.Meta, fillColor cell.Color) ([]*buffer.Cell, error) {
+	optRange, err := b.tOptsTracker.ForPosition(0) // Text options for the current byte.
+	if err != nil {
+		return nil, err
+	}
 
-	cur := start
-	for i, r := range trimmed {
+	var cells []*buffer.Cell
+	for i, r := range b.text.String() {
 		if i >= optRange.High { // Get the next write options.
 			or, err := b.tOptsTracker.ForPosition(i)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			optRange = or
 		}
 
 		tOpts := b.givenTOpts[optRange.AttrIdx]
-		var cellOpts []cell.Option
+		cellOpts := []cell.Option{cell.BgColor(fillColor)}
 		switch {
 		case b.state == button.Down && len(tOpts.pressedCellOpts) > 0:
-			cellOpts = tOpts.pressedCellOpts
+			cellOpts = append(cellOpts, tOpts.pressedCellOpts...)
 		case meta.Focused && len(tOpts.focusedCellOpts) > 0:
-			cellOpts = tOpts.focusedCellOpts
+			cellOpts = append(cellOpts, tOpts.focusedCellOpts...)
 		default:
-			cellOpts = tOpts.cellOpts
+			cellOpts = append(cellOpts, tOpts.cellOpts...)
 		}
-		cells, err := cvs.SetCell(cur, r, cellOpts...)
+		cells = append(cells, buffer.NewCell(r, cellOpts...))
+	}
+	return cells, nil
+}
+
+// drawLine draws one line of text centered in the area. The text is trimmed
+// with an ellipsis if it crosses the maxX coordinate.
+func drawLine(cvs *canvas.Canvas, line []*buffer.Cell, ar image.Rectangle, maxX int) error {
+	var text strings.Builder
+	for _, c := range line {
+		text.WriteRune(c.Rune)
+	}
+	start, err := alignfor.Text(ar, text.String(), align.HorizontalCenter, align.VerticalMiddle)
+	if err != nil {
+		return err
+	}
+
+	maxCells := maxX - start.X
+	if maxCells < 1 {
+		return fmt.Errorf("maxCells(%d) cannot be less than one", maxCells)
+	}
+	if runewidth.StringWidth(text.String()) > maxCells {
+		line = withEllipsis(line, maxCells)
+	}
+
+	cur := start
+	for _, c := range line {
+		cells, err := cvs.SetCell(cur, c.Rune, c.Opts)
 		if err != nil {
 			return err
 		}
@@ -266,6 +385,33 @@ func (b *Button) drawText(cvs *canvas.Canvas, meta *widgetapi.Meta, buttonAr ima
 	return nil
 }
 
+// withEllipsis returns the line trimmed so that it fits into maxCells
+// including a trailing ellipsis, which takes the cell options of the last
+// remaining cell.
+func withEllipsis(line []*buffer.Cell, maxCells int) []*buffer.Cell {
+	var (
+		res []*buffer.Cell
+		cur int
+	)
+	for _, c := range line {
+		rw := runewidth.RuneWidth(c.Rune)
+		if cur+rw >= maxCells {
+			break
+		}
+		res = append(res, c)
+		cur += rw
+	}
+
+	opts := cell.NewOptions()
+	switch {
+	case len(res) > 0:
+		opts = res[len(res)-1].Opts
+	case len(line) > 0:
+		opts = line[0].Opts
+	}
+	return append(res, buffer.NewCell('…', opts))
+}
+
 // activated asserts whether the keyboard event activated the button.
 func (b *Button) keyActivated(k *terminalapi.Keyboard, meta *widgetapi.EventMeta) bool {
 	b.mu.Lock()
@@ -275,16 +421,24 @@ func (b *Button) keyActivated(k *terminalapi.Keyboard, meta *widgetapi.EventMeta
 		b.state = button.Down
 		now := time.Now().UTC()
 		b.keyTriggerTime = &now
+		b.toggleMenu()
 		return true
 	}
 	return false
 }
 
 // Keyboard processes keyboard events, acts as a button press on the configured
-// Key.
+// Key. Navigates the menu while it is open, see the Menu option.
 //
 // Implements widgetapi.Widget.Keyboard.
 func (b *Button) Keyboard(k *terminalapi.Keyboard, meta *widgetapi.EventMeta) error {
+	if consumed, fn := b.menuKeyboard(k, meta); consumed {
+		if fn != nil {
+			// Mutex must be released when calling the callback.
+			return fn()
+		}
+		return nil
+	}
 	if b.keyActivated(k, meta) {
 		if b.callback != nil {
 			// Mutex must be released when calling the callback.
@@ -296,60 +450,233 @@ func (b *Button) Keyboard(k *terminalapi.Keyboard, meta *widgetapi.EventMeta) er
 	return nil
 }
 
+// repeatStaleIntervals is the number of repeat intervals without a redraw of
+// the button after which the repeater stops on its own.
+const repeatStaleIntervals = 10
+
+// minRepeatStale is the shortest time without a redraw of the button after
+// which the repeater stops on its own.
+const minRepeatStale = time.Second
+
+// repeater calls a callback in a separate goroutine on every tick of a ticker
+// until stopped.
+//
+// The button requests a redraw on every tick while repeating, so the repeater
+// also stops once the button isn't drawn for a while. This happens when
+// termdash exits or the button is removed from the container while it is held
+// down, in which case the release never arrives.
+type repeater struct {
+	// stopCh is closed to stop the repetition.
+	stopCh chan struct{}
+	// doneCh is closed once the goroutine exits.
+	doneCh chan struct{}
+	// err is the error returned by the callback if any.
+	// Only safe to read once doneCh is closed.
+	err error
+
+	// lastDraw is the last time the button was drawn.
+	lastDraw time.Time
+	// mu protects lastDraw.
+	mu sync.Mutex
+}
+
+// newRepeater starts calling the callback every interval.
+func newRepeater(interval time.Duration, cFn CallbackFn) *repeater {
+	r := &repeater{
+		stopCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+		lastDraw: time.Now(),
+	}
+	go r.run(interval, cFn)
+	return r
+}
+
+// drawn informs the repeater that the button was drawn.
+func (r *repeater) drawn() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastDraw = time.Now()
+}
+
+// stale asserts whether the button wasn't drawn for long enough to assume
+// that it isn't displayed anymore.
+func (r *repeater) stale(interval time.Duration) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	after := repeatStaleIntervals * interval
+	if after < minRepeatStale {
+		after = minRepeatStale
+	}
+	return timeSince(r.lastDraw) > after
+}
+
+// run calls the callback until stopped, until the callback returns an error or
+// until the button isn't drawn anymore.
+func (r *repeater) run(interval time.Duration, cFn CallbackFn) {
+	defer close(r.doneCh)
+
+	tickCh, stopTicker := newRepeatTicker(interval)
+	defer stopTicker()
+	for {
+		select {
+		case <-r.stopCh:
+			return
+		case <-tickCh:
+			if r.stale(interval) {
+				return
+			}
+			if err := cFn(); err != nil {
+				r.err = err
+				return
+			}
+		}
+	}
+}
+
+// stop stops the repetition and waits until the callback returns if it is
+// executing. Returns the error returned by the callback if any.
+func (r *repeater) stop() error {
+	close(r.stopCh)
+	<-r.doneCh
+	return r.err
+}
+
 // mouseActivated asserts whether the mouse event activated the button.
-func (b *Button) mouseActivated(m *terminalapi.Mouse) bool {
+// Also returns the callback that should be called, which is the long press
+// callback if the button was held down long enough and a repeater that should
+// be stopped, if the event released the button while it was repeating.
+func (b *Button) mouseActivated(m *terminalapi.Mouse) (bool, CallbackFn, *repeater) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	prevState := b.state
 	clicked, state := b.mouseFSM.Event(m)
 	b.state = state
 	b.keyTriggerTime = nil
+	if prevState != button.Down && state == button.Down {
+		b.pressTime = time.Now()
+		if b.opts.repeatInterval > 0 && b.callback != nil {
+			b.repeater = newRepeater(b.opts.repeatInterval, b.callback)
+		}
+	}
+
+	var stopR *repeater
+	if state != button.Down && b.repeater != nil {
+		stopR = b.repeater
+		b.repeater = nil
+	}
+
+	if clicked {
+		b.toggleMenu()
+	}
 
-	return clicked
+	if clicked && b.opts.longPressFn != nil && timeSince(b.pressTime) >= b.opts.longPressDuration {
+		return true, b.opts.longPressFn, stopR
+	}
+	return clicked, b.callback, stopR
 }
 
 // Mouse processes mouse events, acts as a button press if both the press and
-// the release happen inside the button.
+// the release happen inside the button. Selects the clicked item while the
+// menu is open, see the Menu option.
 //
 // Implements widgetapi.Widget.Mouse.
 func (b *Button) Mouse(m *terminalapi.Mouse, meta *widgetapi.EventMeta) error {
-	if b.mouseActivated(m) {
-		if b.callback != nil {
+	if consumed, fn := b.menuMouse(m); consumed {
+		if fn != nil {
+			// Mutex must be released when calling the callback.
+			return fn()
+		}
+		return nil
+	}
+
+	clicked, cFn, stopR := b.mouseActivated(m)
+	if stopR != nil {
+		// Mutex must be released when stopping, since the callback might be
+		// executing.
+		if err := stopR.stop(); err != nil {
+			return err
+		}
+	}
+	if clicked {
+		if cFn != nil {
 			// Mutex must be released when calling the callback.
 			// Users might call container methods from the callback like the
 			// Container.Update, see #205.
-			return b.callback()
+			return cFn()
 		}
 	}
 	return nil
 }
 
-// shadowWidth returns the width of the shadow under the button or zero if the
-// button shouldn't have any shadow.
-func (b *Button) shadowWidth() int {
+// shadowOffset returns the offset of the shadow under the button or a zero
+// point if the button shouldn't have any shadow.
+func (b *Button) shadowOffset() image.Point {
 	if b.opts.disableShadow {
-		return 0
+		return image.Point{}
+	}
+	return b.opts.shadowOffset
+}
+
+// buttonSize returns the size of the button including its shadow, excluding
+// the rows reserved for the menu.
+func (b *Button) buttonSize() image.Point {
+	so := b.shadowOffset()
+	return image.Point{
+		X: b.opts.width + so.X + 2*b.opts.textHorizontalPadding + b.opts.leadingRune.width() + b.opts.trailingRune.width(),
+		Y: b.opts.height + so.Y,
 	}
-	return 1
 }
 
 // Options implements widgetapi.Widget.Options.
 func (b *Button) Options() widgetapi.Options {
-	// No need to lock, as the height and width get fixed when New is called.
+	// The height and width get fixed when New is called, the lock protects
+	// the state of the keyboard press.
+	b.mu.Lock()
+	defer b.mu.Unlock()
 
-	width := b.opts.width + b.shadowWidth() + 2*b.opts.textHorizontalPadding
-	height := b.opts.height + b.shadowWidth()
+	size := b.buttonSize()
+	size.Y += b.menuRows()
 
 	var keyScope widgetapi.KeyScope
-	if len(b.opts.focusedKeys) > 0 || len(b.opts.globalKeys) > 0 {
+	switch {
+	case len(b.opts.focusedKeys) > 0 || len(b.opts.globalKeys) > 0:
 		keyScope = widgetapi.KeyScopeGlobal
-	} else {
+	case b.opts.menu != nil:
+		// Navigating the menu requires keyboard events.
+		keyScope = widgetapi.KeyScopeFocused
+	default:
 		keyScope = widgetapi.KeyScopeNone
 	}
+	redraw := b.keyUpRedrawInterval()
+	if b.repeater != nil {
+		// Draw the effects of each repeated callback.
+		redraw = b.opts.repeatInterval
+	}
 	return widgetapi.Options{
-		MinimumSize:  image.Point{width, height},
-		MaximumSize:  image.Point{width, height},
-		WantKeyboard: keyScope,
-		WantMouse:    widgetapi.MouseScopeGlobal,
+		MinimumSize:    size,
+		MaximumSize:    size,
+		WantKeyboard:   keyScope,
+		WantMouse:      widgetapi.MouseScopeGlobal,
+		RedrawInterval: redraw,
+	}
+}
+
+// keyUpRedrawSteps is the number of redraws the button requests during the
+// KeyUpDelay, so that the release of a key press is drawn close to the
+// configured delay.
+const keyUpRedrawSteps = 4
+
+// keyUpRedrawInterval returns the redraw interval the button needs while it
+// is drawn pressed after a keyboard activation. Returns zero when the button
+// doesn't need any redraws.
+func (b *Button) keyUpRedrawInterval() time.Duration {
+	if b.keyTriggerTime == nil {
+		return 0
+	}
+	if iv := b.opts.keyUpDelay / keyUpRedrawSteps; iv > 0 {
+		return iv
 	}
+	return time.Millisecond
 }
@@ -130,6 +130,28 @@ func TestButton(t *testing.T) {
 			meta:       &widgetapi.Meta{Focused: false},
 			wantNewErr: true,
 		},
+		{
+			desc:     "New fails with an unprintable LeadingRune",
+			callback: &callbackTracker{},
+			opts: []Option{
+				LeadingRune('\t'),
+			},
+			canvas:     image.Rect(0, 0, 1, 1),
+			text:       "hello",
+			meta:       &widgetapi.Meta{Focused: false},
+			wantNewErr: true,
+		},
+		{
+			desc:     "New fails with an unprintable TrailingRune",
+			callback: &callbackTracker{},
+			opts: []Option{
+				TrailingRune('\n'),
+			},
+			canvas:     image.Rect(0, 0, 1, 1),
+			text:       "hello",
+			meta:       &widgetapi.Meta{Focused: false},
+			wantNewErr: true,
+		},
 		{
 			desc:     "New fails with negative textHorizontalPadding",
 			callback: &callbackTracker{},
@@ -141,6 +163,28 @@ func TestButton(t *testing.T) {
 			meta:       &widgetapi.Meta{Focused: false},
 			wantNewErr: true,
 		},
+		{
+			desc:     "New fails with zero long press duration",
+			callback: &callbackTracker{},
+			opts: []Option{
+				OnLongPress(0, func() error { return nil }),
+			},
+			canvas:     image.Rect(0, 0, 1, 1),
+			text:       "hello",
+			meta:       &widgetapi.Meta{Focused: false},
+			wantNewErr: true,
+		},
+		{
+			desc:     "New fails with negative ShadowOffset",
+			callback: &callbackTracker{},
+			opts: []Option{
+				ShadowOffset(1, -1),
+			},
+			canvas:     image.Rect(0, 0, 1, 1),
+			text:       "hello",
+			meta:       &widgetapi.Meta{Focused: false},
+			wantNewErr: true,
+		},
 		{
 			desc:     "New fails when duplicate Key and GlobalKey are specified",
 			callback: &callbackTracker{},
@@ -1063,6 +1107,159 @@ func TestButton(t *testing.T) {
 			},
 			wantCallback: &callbackTracker{},
 		},
+		{
+			desc:     "wraps text that doesn't fit onto the other lines of a tall button",
+			callback: &callbackTracker{},
+			opts: []Option{
+				Width(5),
+				DisableShadow(),
+			},
+			text:   "hello world",
+			canvas: image.Rect(0, 0, 7, 3),
+			meta:   &widgetapi.Meta{Focused: false},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				// Button.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(0, 0, 7, 3), 'x', cell.BgColor(cell.ColorNumber(117)))
+
+				// Text.
+				testdraw.MustText(cvs, "hello", image.Point{1, 0},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorBlack),
+						cell.BgColor(cell.ColorNumber(117))),
+				)
+				testdraw.MustText(cvs, "world", image.Point{1, 1},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorBlack),
+						cell.BgColor(cell.ColorNumber(117))),
+				)
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCallback: &callbackTracker{},
+		},
+		{
+			desc:     "wrapped text is trimmed with an ellipsis when it exceeds all the lines",
+			callback: &callbackTracker{},
+			opts: []Option{
+				Width(5),
+				Height(2),
+				DisableShadow(),
+			},
+			text:   "one two three four",
+			canvas: image.Rect(0, 0, 7, 2),
+			meta:   &widgetapi.Meta{Focused: false},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				// Button.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(0, 0, 7, 2), 'x', cell.BgColor(cell.ColorNumber(117)))
+
+				// Text.
+				testdraw.MustText(cvs, "one", image.Point{2, 0},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorBlack),
+						cell.BgColor(cell.ColorNumber(117))),
+				)
+				testdraw.MustText(cvs, "two…", image.Point{1, 1},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorBlack),
+						cell.BgColor(cell.ColorNumber(117))),
+				)
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCallback: &callbackTracker{},
+		},
+		{
+			desc:     "wraps text chunks and keeps their cell options",
+			callback: &callbackTracker{},
+			opts: []Option{
+				Width(5),
+				FillColor(cell.ColorBlue),
+				DisableShadow(),
+			},
+			textChunks: []*TextChunk{
+				NewChunk(
+					"hello ",
+					TextCellOpts(cell.FgColor(cell.ColorBlack)),
+				),
+				NewChunk(
+					"world",
+					TextCellOpts(cell.FgColor(cell.ColorMagenta)),
+				),
+			},
+			canvas: image.Rect(0, 0, 7, 3),
+			meta:   &widgetapi.Meta{Focused: false},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				// Button.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(0, 0, 7, 3), 'x', cell.BgColor(cell.ColorBlue))
+
+				// Text.
+				testdraw.MustText(cvs, "hello", image.Point{1, 0},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorBlack),
+						cell.BgColor(cell.ColorBlue)),
+				)
+				testdraw.MustText(cvs, "world", image.Point{1, 1},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorMagenta),
+						cell.BgColor(cell.ColorBlue)),
+				)
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCallback: &callbackTracker{},
+		},
+		{
+			desc:     "draws leading and trailing runes in reserved columns",
+			callback: &callbackTracker{},
+			opts: []Option{
+				LeadingRune('✓', cell.FgColor(cell.ColorGreen)),
+				TrailingRune('▶'),
+				DisableShadow(),
+			},
+			text:   "hi",
+			canvas: image.Rect(0, 0, 8, 3),
+			meta:   &widgetapi.Meta{Focused: false},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				// Button.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(0, 0, 8, 3), 'x', cell.BgColor(cell.ColorNumber(117)))
+
+				// Side runes.
+				testcanvas.MustSetCell(cvs, image.Point{1, 1}, '✓',
+					cell.FgColor(cell.ColorGreen),
+					cell.BgColor(cell.ColorNumber(117)),
+				)
+				testcanvas.MustSetCell(cvs, image.Point{6, 1}, '▶',
+					cell.FgColor(cell.ColorBlack),
+					cell.BgColor(cell.ColorNumber(117)),
+				)
+
+				// Text.
+				testdraw.MustText(cvs, "hi", image.Point{3, 1},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorBlack),
+						cell.BgColor(cell.ColorNumber(117))),
+				)
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCallback: &callbackTracker{},
+		},
 		{
 			desc:     "sets custom text color",
 			callback: &callbackTracker{},
@@ -1156,6 +1353,37 @@ func TestButton(t *testing.T) {
 			},
 			wantCallback: &callbackTracker{},
 		},
+		{
+			desc:     "sets custom shadow offset",
+			callback: &callbackTracker{},
+			text:     "hello",
+			opts: []Option{
+				ShadowOffset(2, 1),
+			},
+			canvas: image.Rect(0, 0, 9, 4),
+			meta:   &widgetapi.Meta{Focused: false},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				// Shadow.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(2, 1, 9, 4), 's', cell.BgColor(cell.ColorNumber(240)))
+
+				// Button.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(0, 0, 7, 3), 'x', cell.BgColor(cell.ColorNumber(117)))
+
+				// Text.
+				testdraw.MustText(cvs, "hello", image.Point{1, 1},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorBlack),
+						cell.BgColor(cell.ColorNumber(117))),
+				)
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCallback: &callbackTracker{},
+		},
 		{
 			desc:     "draws button with text chunks and custom fill color in up state",
 			callback: &callbackTracker{},
@@ -1609,6 +1837,342 @@ func TestButton(t *testing.T) {
 	}
 }
 
+func TestLongPress(t *testing.T) {
+	tests := []struct {
+		desc      string
+		events    []terminalapi.Event
+		held      time.Duration
+		wantShort int
+		wantLong  int
+	}{
+		{
+			desc: "short press calls the regular callback",
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonRelease},
+			},
+			held:      time.Second - time.Millisecond,
+			wantShort: 1,
+		},
+		{
+			desc: "long press calls the long press callback",
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonRelease},
+			},
+			held:     time.Second,
+			wantLong: 1,
+		},
+		{
+			desc: "long press released outside of the button calls nothing",
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{20, 20}, Button: mouse.ButtonRelease},
+			},
+			held: time.Second,
+		},
+		{
+			desc: "keyboard presses always call the regular callback",
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+			},
+			held:      time.Second,
+			wantShort: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			timeSince = func(time.Time) time.Duration {
+				return tc.held
+			}
+			defer func() {
+				timeSince = time.Since
+			}()
+
+			short := &callbackTracker{}
+			long := &callbackTracker{}
+			b, err := New("hello", short.callback,
+				Key(keyboard.KeyEnter),
+				OnLongPress(time.Second, long.callback),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			// Draw once so the button knows its area.
+			cvs, err := canvas.New(image.Rect(0, 0, 8, 4))
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+			if err := b.Draw(cvs, &widgetapi.Meta{}); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			for _, ev := range tc.events {
+				switch e := ev.(type) {
+				case *terminalapi.Mouse:
+					if err := b.Mouse(e, &widgetapi.EventMeta{}); err != nil {
+						t.Fatalf("Mouse => unexpected error: %v", err)
+					}
+				case *terminalapi.Keyboard:
+					if err := b.Keyboard(e, &widgetapi.EventMeta{Focused: true}); err != nil {
+						t.Fatalf("Keyboard => unexpected error: %v", err)
+					}
+				default:
+					t.Fatalf("unsupported event type: %T", ev)
+				}
+			}
+
+			if short.count != tc.wantShort {
+				t.Errorf("regular callback called %d times, want %d", short.count, tc.wantShort)
+			}
+			if long.count != tc.wantLong {
+				t.Errorf("long press callback called %d times, want %d", long.count, tc.wantLong)
+			}
+		})
+	}
+}
+
+func TestRepeatInterval(t *testing.T) {
+	t.Run("fails on negative interval", func(t *testing.T) {
+		if _, err := New("hello", nil, RepeatInterval(-1)); err == nil {
+			t.Errorf("New => got nil err, wanted one")
+		}
+	})
+
+	tests := []struct {
+		desc    string
+		wantErr bool
+	}{
+		{
+			desc: "calls the callback repeatedly until released",
+		},
+		{
+			desc:    "stops repeating and forwards callback errors on release",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			var calls int
+			var mu sync.Mutex
+			cFn := func() error {
+				mu.Lock()
+				calls++
+				mu.Unlock()
+				if tc.wantErr {
+					return errors.New("callback error")
+				}
+				return nil
+			}
+			b, err := New("hello", cFn, RepeatInterval(time.Millisecond))
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			// Draw once so the button knows its area.
+			cvs, err := canvas.New(image.Rect(0, 0, 8, 4))
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+			if err := b.Draw(cvs, &widgetapi.Meta{}); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			press := &terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonLeft}
+			if err := b.Mouse(press, &widgetapi.EventMeta{}); err != nil {
+				t.Fatalf("Mouse => unexpected error: %v", err)
+			}
+
+			wantCalls := 2
+			if tc.wantErr {
+				wantCalls = 1
+			}
+			deadline := time.Now().Add(5 * time.Second)
+			for {
+				mu.Lock()
+				got := calls
+				mu.Unlock()
+				if got >= wantCalls {
+					break
+				}
+				if time.Now().After(deadline) {
+					t.Fatalf("callback called %d times while held, want at least %d", got, wantCalls)
+				}
+				time.Sleep(time.Millisecond)
+			}
+
+			release := &terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonRelease}
+			err = b.Mouse(release, &widgetapi.EventMeta{})
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Mouse => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			mu.Lock()
+			afterRelease := calls
+			mu.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			mu.Lock()
+			defer mu.Unlock()
+			if calls != afterRelease {
+				t.Errorf("callback called %d times after release, want no more calls", calls-afterRelease)
+			}
+		})
+	}
+}
+
+func TestRepeatIntervalCallbacks(t *testing.T) {
+	tickCh := make(chan time.Time)
+	newRepeatTicker = func(time.Duration) (<-chan time.Time, func()) {
+		return tickCh, func() {}
+	}
+	defer func() {
+		newRepeatTicker = func(interval time.Duration) (<-chan time.Time, func()) {
+			t := time.NewTicker(interval)
+			return t.C, t.Stop
+		}
+	}()
+
+	ct := &callbackTracker{}
+	b, err := New("hello", ct.callback, RepeatInterval(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+	cvs, err := canvas.New(image.Rect(0, 0, 8, 4))
+	if err != nil {
+		t.Fatalf("canvas.New => unexpected error: %v", err)
+	}
+	if err := b.Draw(cvs, &widgetapi.Meta{}); err != nil {
+		t.Fatalf("Draw => unexpected error: %v", err)
+	}
+	if got := b.Options().RedrawInterval; got != 0 {
+		t.Errorf("Options before the press => RedrawInterval %v, want 0", got)
+	}
+
+	press := &terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonLeft}
+	if err := b.Mouse(press, &widgetapi.EventMeta{}); err != nil {
+		t.Fatalf("Mouse => unexpected error: %v", err)
+	}
+	if got, want := b.Options().RedrawInterval, 10*time.Millisecond; got != want {
+		t.Errorf("Options while repeating => RedrawInterval %v, want %v", got, want)
+	}
+
+	const ticks = 3
+	for i := 0; i < ticks; i++ {
+		tickCh <- time.Now()
+	}
+
+	release := &terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonRelease}
+	if err := b.Mouse(release, &widgetapi.EventMeta{}); err != nil {
+		t.Fatalf("Mouse => unexpected error: %v", err)
+	}
+	if got := b.Options().RedrawInterval; got != 0 {
+		t.Errorf("Options after the release => RedrawInterval %v, want 0", got)
+	}
+
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	if want := ticks + 1; ct.count != want {
+		t.Errorf("callback called %d times, want %d", ct.count, want)
+	}
+}
+
+func TestRepeatIntervalStopsWhenNotDrawn(t *testing.T) {
+	tickCh := make(chan time.Time)
+	newRepeatTicker = func(time.Duration) (<-chan time.Time, func()) {
+		return tickCh, func() {}
+	}
+	defer func() {
+		newRepeatTicker = func(interval time.Duration) (<-chan time.Time, func()) {
+			t := time.NewTicker(interval)
+			return t.C, t.Stop
+		}
+		timeSince = time.Since
+	}()
+
+	ct := &callbackTracker{}
+	b, err := New("hello", ct.callback, RepeatInterval(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+	cvs, err := canvas.New(image.Rect(0, 0, 8, 4))
+	if err != nil {
+		t.Fatalf("canvas.New => unexpected error: %v", err)
+	}
+	if err := b.Draw(cvs, &widgetapi.Meta{}); err != nil {
+		t.Fatalf("Draw => unexpected error: %v", err)
+	}
+
+	press := &terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonLeft}
+	if err := b.Mouse(press, &widgetapi.EventMeta{}); err != nil {
+		t.Fatalf("Mouse => unexpected error: %v", err)
+	}
+	r := b.repeater
+
+	// The button isn't drawn anymore, e.g. because termdash exited, so the
+	// release never arrives.
+	timeSince = func(time.Time) time.Duration { return 2 * time.Second }
+	tickCh <- time.Now()
+	select {
+	case <-r.doneCh:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the repeater didn't stop when the button wasn't drawn")
+	}
+
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	if ct.count != 0 {
+		t.Errorf("callback called %d times, want no calls once the button isn't drawn", ct.count)
+	}
+}
+
+func TestKeyUpRedrawInterval(t *testing.T) {
+	defer func() { timeSince = time.Since }()
+
+	b, err := New("hello", func() error { return nil },
+		GlobalKey(keyboard.KeyEnter),
+		KeyUpDelay(100*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+	if got := b.Options().RedrawInterval; got != 0 {
+		t.Errorf("Options before a key press => RedrawInterval %v, want 0", got)
+	}
+
+	if err := b.Keyboard(&terminalapi.Keyboard{Key: keyboard.KeyEnter}, &widgetapi.EventMeta{}); err != nil {
+		t.Fatalf("Keyboard => unexpected error: %v", err)
+	}
+	if got, want := b.Options().RedrawInterval, 25*time.Millisecond; got != want {
+		t.Errorf("Options while pressed => RedrawInterval %v, want %v", got, want)
+	}
+
+	cvs, err := canvas.New(image.Rect(0, 0, 8, 4))
+	if err != nil {
+		t.Fatalf("canvas.New => unexpected error: %v", err)
+	}
+	timeSince = func(time.Time) time.Duration { return 50 * time.Millisecond }
+	if err := b.Draw(cvs, &widgetapi.Meta{}); err != nil {
+		t.Fatalf("Draw => unexpected error: %v", err)
+	}
+	if got, want := b.Options().RedrawInterval, 25*time.Millisecond; got != want {
+		t.Errorf("Options before the KeyUpDelay elapsed => RedrawInterval %v, want %v", got, want)
+	}
+
+	timeSince = func(time.Time) time.Duration { return 200 * time.Millisecond }
+	if err := b.Draw(cvs, &widgetapi.Meta{}); err != nil {
+		t.Fatalf("Draw => unexpected error: %v", err)
+	}
+	if got := b.Options().RedrawInterval; got != 0 {
+		t.Errorf("Options after the release => RedrawInterval %v, want 0", got)
+	}
+}
+
 func TestOptions(t *testing.T) {
 	tests := []struct {
 		desc string
@@ -1636,6 +2200,33 @@ func TestOptions(t *testing.T) {
 				WantMouse:    widgetapi.MouseScopeGlobal,
 			},
 		},
+		{
+			desc: "reserves rows for the menu and requests keyboard events",
+			text: "hello",
+			opts: []Option{
+				Menu([]MenuItem{{Text: "one"}, {Text: "two"}}, nil),
+			},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{8, 6},
+				MaximumSize:  image.Point{8, 6},
+				WantKeyboard: widgetapi.KeyScopeFocused,
+				WantMouse:    widgetapi.MouseScopeGlobal,
+			},
+		},
+		{
+			desc: "width accounts for the leading and trailing runes",
+			text: "hello",
+			opts: []Option{
+				LeadingRune('✓'),
+				TrailingRune('㈱'),
+			},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{13, 4},
+				MaximumSize:  image.Point{13, 4},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeGlobal,
+			},
+		},
 		{
 			desc: "width specified via WidthFor",
 			text: "hello",
@@ -1704,6 +2295,19 @@ func TestOptions(t *testing.T) {
 				WantMouse:    widgetapi.MouseScopeGlobal,
 			},
 		},
+		{
+			desc: "custom shadow offset",
+			text: "hello",
+			opts: []Option{
+				ShadowOffset(2, 0),
+			},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{9, 3},
+				MaximumSize:  image.Point{9, 3},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeGlobal,
+			},
+		},
 		{
 			desc: "doesn't want keyboard by default without any keys",
 			text: "hello",
@@ -0,0 +1,196 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package button
+
+// menu.go contains code that displays the menu of choices.
+
+import (
+	"image"
+
+	"github.com/woodliu/termdash/cell"
+	"github.com/woodliu/termdash/keyboard"
+	"github.com/woodliu/termdash/mouse"
+	"github.com/woodliu/termdash/private/canvas"
+	"github.com/woodliu/termdash/private/draw"
+	"github.com/woodliu/termdash/terminal/terminalapi"
+	"github.com/woodliu/termdash/widgetapi"
+)
+
+// MenuItem is one of the choices displayed in the menu, see the Menu option.
+type MenuItem struct {
+	// Text is displayed in the menu, cannot be empty.
+	Text string
+}
+
+// MenuCallbackFn is the function called with the item selected from the menu.
+// The same requirements apply to it as to CallbackFn.
+type MenuCallbackFn func(item MenuItem) error
+
+// menu stores the items and the callback provided to the Menu option.
+type menu struct {
+	items []MenuItem
+	fn    MenuCallbackFn
+}
+
+// Menu turns the button into a drop-down menu of the provided items.
+// Activating the button opens the menu under the button, activating it again
+// closes it. While the menu is open and the button is focused, the arrow up and
+// down keys move the highlight between the items, enter selects the highlighted
+// item and escape closes the menu. An item can also be selected using a left
+// mouse click.
+// Selecting an item closes the menu and calls the function with the item, the
+// function can be nil. The callback provided to New is still called on each
+// activation of the button.
+//
+// Widgets can only draw onto their own canvas, so the button reserves one row
+// for each item under the button. The rows are empty while the menu is
+// closed. Items that don't fit into the width of the button are trimmed.
+// At least one item must be provided.
+func Menu(items []MenuItem, fn MenuCallbackFn) Option {
+	return option(func(opts *options) {
+		opts.menu = &menu{
+			// Copy to avoid external modifications.
+			items: append([]MenuItem(nil), items...),
+			fn:    fn,
+		}
+	})
+}
+
+// menuRows returns the number of rows reserved for the menu under the button.
+func (b *Button) menuRows() int {
+	if b.opts.menu == nil {
+		return 0
+	}
+	return len(b.opts.menu.items)
+}
+
+// toggleMenu opens the menu with the first item highlighted or closes it if
+// it is open. Does nothing if the Menu option isn't set.
+// Must be called with the mutex held.
+func (b *Button) toggleMenu() {
+	if b.opts.menu == nil {
+		return
+	}
+	b.menuOpen = !b.menuOpen
+	b.menuHighlighted = 0
+}
+
+// selectMenuItem closes the menu and returns a function that calls the menu
+// callback with the item at index i. Returns nil if there is no callback.
+// Must be called with the mutex held.
+func (b *Button) selectMenuItem(i int) func() error {
+	b.menuOpen = false
+	fn := b.opts.menu.fn
+	if fn == nil {
+		return nil
+	}
+	item := b.opts.menu.items[i]
+	return func() error {
+		return fn(item)
+	}
+}
+
+// menuKeyboard processes the keyboard event if the menu is open and the
+// button is focused.
+// Returns whether the event was consumed by the menu and the function that
+// should be called if an item was selected.
+func (b *Button) menuKeyboard(k *terminalapi.Keyboard, meta *widgetapi.EventMeta) (bool, func() error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.menuOpen || !meta.Focused {
+		return false, nil
+	}
+	switch k.Key {
+	case keyboard.KeyArrowUp:
+		if b.menuHighlighted > 0 {
+			b.menuHighlighted--
+		}
+	case keyboard.KeyArrowDown:
+		if b.menuHighlighted < len(b.opts.menu.items)-1 {
+			b.menuHighlighted++
+		}
+	case keyboard.KeyEnter:
+		return true, b.selectMenuItem(b.menuHighlighted)
+	case keyboard.KeyEsc:
+		b.menuOpen = false
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// menuArea returns the area of the canvas with the rows of the menu items.
+func (b *Button) menuArea() image.Rectangle {
+	size := b.buttonSize()
+	return image.Rect(0, size.Y, size.X, size.Y+b.menuRows())
+}
+
+// menuMouse processes the mouse event if the menu is open.
+// Returns whether the event was consumed by the menu and the function that
+// should be called if an item was selected.
+func (b *Button) menuMouse(m *terminalapi.Mouse) (bool, func() error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.menuOpen || m.Button != mouse.ButtonLeft {
+		return false, nil
+	}
+	ar := b.menuArea()
+	if !m.Position.In(ar) {
+		return false, nil
+	}
+	return true, b.selectMenuItem(m.Position.Y - ar.Min.Y)
+}
+
+// drawMenu draws the items of the open menu in the rows starting at the
+// provided Y coordinate. The highlighted item is drawn inverted.
+func (b *Button) drawMenu(cvs *canvas.Canvas, startY int) error {
+	if !b.menuOpen {
+		return nil
+	}
+
+	cvsAr := cvs.Area()
+	pad := b.opts.textHorizontalPadding
+	for i, item := range b.opts.menu.items {
+		y := startY + i
+		if y >= cvsAr.Max.Y {
+			break
+		}
+
+		cellOpts := []cell.Option{
+			cell.FgColor(b.opts.textColor),
+			cell.BgColor(b.opts.fillColor),
+		}
+		if i == b.menuHighlighted {
+			cellOpts = append(cellOpts, cell.Inverse())
+		}
+		rowAr := image.Rect(cvsAr.Min.X, y, cvsAr.Max.X, y+1)
+		if err := cvs.SetAreaCells(rowAr, buttonRune, cellOpts...); err != nil {
+			return err
+		}
+		if rowAr.Dx() <= 2*pad {
+			continue
+		}
+		if err := draw.Text(cvs, item.Text, image.Point{rowAr.Min.X + pad, y},
+			draw.TextMaxX(rowAr.Max.X-pad),
+			draw.TextOverrunMode(draw.OverrunModeThreeDot),
+			draw.TextCellOpts(cellOpts...),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,293 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package button
+
+import (
+	"image"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/woodliu/termdash/cell"
+	"github.com/woodliu/termdash/keyboard"
+	"github.com/woodliu/termdash/mouse"
+	"github.com/woodliu/termdash/private/canvas"
+	"github.com/woodliu/termdash/private/canvas/testcanvas"
+	"github.com/woodliu/termdash/private/draw"
+	"github.com/woodliu/termdash/private/draw/testdraw"
+	"github.com/woodliu/termdash/private/faketerm"
+	"github.com/woodliu/termdash/terminal/terminalapi"
+	"github.com/woodliu/termdash/widgetapi"
+)
+
+// menuTracker tracks the items selected from the menu.
+type menuTracker struct {
+	selected []string
+}
+
+// callback is the menu callback function.
+func (mt *menuTracker) callback(item MenuItem) error {
+	mt.selected = append(mt.selected, item.Text)
+	return nil
+}
+
+func TestMenu(t *testing.T) {
+	items := []MenuItem{{Text: "one"}, {Text: "two"}, {Text: "three"}}
+
+	tests := []struct {
+		desc   string
+		items  []MenuItem
+		events []terminalapi.Event
+		// unfocused are the indexes of the keyboard events delivered while
+		// the button isn't focused.
+		unfocused    map[int]bool
+		wantNewErr   bool
+		wantSelected []string
+		wantOpen     bool
+		// wantPresses is the number of calls to the regular callback.
+		wantPresses int
+	}{
+		{
+			desc:       "fails without items",
+			items:      []MenuItem{},
+			wantNewErr: true,
+		},
+		{
+			desc:       "fails on an item with an empty text",
+			items:      []MenuItem{{Text: "one"}, {}},
+			wantNewErr: true,
+		},
+		{
+			desc:  "activating the button opens the menu",
+			items: items,
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+			},
+			wantOpen:    true,
+			wantPresses: 1,
+		},
+		{
+			desc:  "activating the button again closes the menu",
+			items: items,
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+				&terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonRelease},
+			},
+			wantPresses: 2,
+		},
+		{
+			desc:  "enter selects the first item",
+			items: items,
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+			},
+			wantSelected: []string{"one"},
+			wantPresses:  1,
+		},
+		{
+			desc:  "arrows move the highlight within the items",
+			items: items,
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowUp},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowDown},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowDown},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowDown},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowUp},
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+			},
+			wantSelected: []string{"two"},
+			wantPresses:  1,
+		},
+		{
+			desc:  "reopened menu highlights the first item",
+			items: items,
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowDown},
+				&terminalapi.Keyboard{Key: keyboard.KeyEsc},
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+			},
+			wantSelected: []string{"one"},
+			wantPresses:  2,
+		},
+		{
+			desc:  "escape closes the menu without a selection",
+			items: items,
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+				&terminalapi.Keyboard{Key: keyboard.KeyEsc},
+			},
+			wantPresses: 1,
+		},
+		{
+			desc:  "keys don't navigate the menu while the button isn't focused",
+			items: items,
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowDown},
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+				&terminalapi.Keyboard{Key: keyboard.KeyEsc},
+			},
+			unfocused:   map[int]bool{1: true, 2: true, 3: true},
+			wantOpen:    true,
+			wantPresses: 1,
+		},
+		{
+			desc:  "clicking an item selects it",
+			items: items,
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonRelease},
+				&terminalapi.Mouse{Position: image.Point{2, 5}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{2, 5}, Button: mouse.ButtonRelease},
+			},
+			wantSelected: []string{"two"},
+			wantPresses:  1,
+		},
+		{
+			desc:  "items can't be clicked while the menu is closed",
+			items: items,
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{2, 5}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{2, 5}, Button: mouse.ButtonRelease},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			presses := &callbackTracker{}
+			mt := &menuTracker{}
+			b, err := New("hello", presses.callback,
+				Key(keyboard.KeyEnter),
+				Menu(tc.items, mt.callback),
+			)
+			if (err != nil) != tc.wantNewErr {
+				t.Errorf("New => unexpected error: %v, wantNewErr: %v", err, tc.wantNewErr)
+			}
+			if err != nil {
+				return
+			}
+
+			// Draw once so the button knows its area.
+			cvs, err := canvas.New(image.Rect(0, 0, 8, 7))
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+			if err := b.Draw(cvs, &widgetapi.Meta{}); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			for i, ev := range tc.events {
+				switch e := ev.(type) {
+				case *terminalapi.Mouse:
+					if err := b.Mouse(e, &widgetapi.EventMeta{}); err != nil {
+						t.Fatalf("Mouse => unexpected error: %v", err)
+					}
+				case *terminalapi.Keyboard:
+					if err := b.Keyboard(e, &widgetapi.EventMeta{Focused: !tc.unfocused[i]}); err != nil {
+						t.Fatalf("Keyboard => unexpected error: %v", err)
+					}
+				default:
+					t.Fatalf("unsupported event type: %T", ev)
+				}
+			}
+
+			if diff := pretty.Compare(tc.wantSelected, mt.selected); diff != "" {
+				t.Errorf("selected items => unexpected diff (-want, +got):\n%s", diff)
+			}
+			if b.menuOpen != tc.wantOpen {
+				t.Errorf("menuOpen => %v, want %v", b.menuOpen, tc.wantOpen)
+			}
+			if presses.count != tc.wantPresses {
+				t.Errorf("regular callback called %d times, want %d", presses.count, tc.wantPresses)
+			}
+		})
+	}
+}
+
+func TestMenuDraw(t *testing.T) {
+	defer func(r rune) {
+		buttonRune = r
+	}(buttonRune)
+	buttonRune = 'x'
+
+	b, err := New("hello", nil,
+		Key(keyboard.KeyEnter),
+		DisableShadow(),
+		Menu([]MenuItem{{Text: "one"}, {Text: "a long item"}}, nil),
+	)
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+
+	size := b.Options().MinimumSize
+	if want := (image.Point{7, 5}); size != want {
+		t.Errorf("Options => MinimumSize %v, want %v", size, want)
+	}
+
+	cvs, err := canvas.New(image.Rect(0, 0, 7, 5))
+	if err != nil {
+		t.Fatalf("canvas.New => unexpected error: %v", err)
+	}
+	if err := b.Keyboard(&terminalapi.Keyboard{Key: keyboard.KeyEnter}, &widgetapi.EventMeta{Focused: true}); err != nil {
+		t.Fatalf("Keyboard => unexpected error: %v", err)
+	}
+	if err := b.Draw(cvs, &widgetapi.Meta{}); err != nil {
+		t.Fatalf("Draw => unexpected error: %v", err)
+	}
+
+	got, err := faketerm.New(cvs.Size())
+	if err != nil {
+		t.Fatalf("faketerm.New => unexpected error: %v", err)
+	}
+	if err := cvs.Apply(got); err != nil {
+		t.Fatalf("Apply => unexpected error: %v", err)
+	}
+
+	ft := faketerm.MustNew(cvs.Size())
+	want := testcanvas.MustNew(ft.Area())
+
+	// Button.
+	testcanvas.MustSetAreaCells(want, image.Rect(0, 0, 7, 3), 'x', cell.BgColor(cell.ColorNumber(117)))
+	testdraw.MustText(want, "hello", image.Point{1, 1},
+		draw.TextCellOpts(
+			cell.FgColor(cell.ColorBlack),
+			cell.BgColor(cell.ColorNumber(117))),
+	)
+
+	// Menu with the first item highlighted.
+	highlighted := []cell.Option{
+		cell.FgColor(cell.ColorBlack),
+		cell.BgColor(cell.ColorNumber(117)),
+		cell.Inverse(),
+	}
+	testcanvas.MustSetAreaCells(want, image.Rect(0, 3, 7, 4), 'x', highlighted...)
+	testdraw.MustText(want, "one", image.Point{1, 3}, draw.TextCellOpts(highlighted...))
+	item := []cell.Option{
+		cell.FgColor(cell.ColorBlack),
+		cell.BgColor(cell.ColorNumber(117)),
+	}
+	testcanvas.MustSetAreaCells(want, image.Rect(0, 4, 7, 5), 'x', item...)
+	testdraw.MustText(want, "a lo…", image.Point{1, 4}, draw.TextCellOpts(item...))
+	testcanvas.MustApply(want, ft)
+
+	if diff := faketerm.Diff(ft, got); diff != "" {
+		t.Errorf("Draw => %v", diff)
+	}
+}
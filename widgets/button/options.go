@@ -17,8 +17,11 @@ package button
 // options.go contains configurable options for Button.
 
 import (
+	"errors"
 	"fmt"
+	"image"
 	"time"
+	"unicode"
 
 	"github.com/woodliu/termdash/cell"
 	"github.com/woodliu/termdash/keyboard"
@@ -48,12 +51,19 @@ type options struct {
 	textColor             cell.Color
 	textHorizontalPadding int
 	shadowColor           cell.Color
+	shadowOffset          image.Point
 	disableShadow         bool
+	leadingRune           *sideRune
+	trailingRune          *sideRune
 	height                int
 	width                 int
 	focusedKeys           map[keyboard.Key]bool
 	globalKeys            map[keyboard.Key]bool
 	keyUpDelay            time.Duration
+	longPressDuration     time.Duration
+	longPressFn           CallbackFn
+	repeatInterval        time.Duration
+	menu                  *menu
 }
 
 // validate validates the provided options.
@@ -61,15 +71,43 @@ func (o *options) validate() error {
 	if min := 0; o.textHorizontalPadding < min {
 		return fmt.Errorf("invalid textHorizontalPadding %d, must be %d <= textHorizontalPadding", o.textHorizontalPadding, min)
 	}
+	for _, sr := range []*sideRune{o.leadingRune, o.trailingRune} {
+		if sr == nil {
+			continue
+		}
+		if !unicode.IsPrint(sr.r) {
+			return fmt.Errorf("invalid leading or trailing rune %q, must be a printable rune", sr.r)
+		}
+	}
 	if min := 1; o.height < min {
 		return fmt.Errorf("invalid height %d, must be %d <= height", o.height, min)
 	}
 	if min := 1; o.width < min {
 		return fmt.Errorf("invalid width %d, must be %d <= width", o.width, min)
 	}
+	if min := 0; o.shadowOffset.X < min || o.shadowOffset.Y < min {
+		return fmt.Errorf("invalid shadowOffset %v, both dx and dy must be %d <= value", o.shadowOffset, min)
+	}
 	if min := time.Duration(0); o.keyUpDelay < min {
 		return fmt.Errorf("invalid keyUpDelay %v, must be %v <= keyUpDelay", o.keyUpDelay, min)
 	}
+	if min := time.Duration(0); o.longPressFn != nil && o.longPressDuration <= min {
+		return fmt.Errorf("invalid long press duration %v, must be %v < duration", o.longPressDuration, min)
+	}
+	if min := time.Duration(0); o.repeatInterval < min {
+		return fmt.Errorf("invalid repeatInterval %v, must be %v <= repeatInterval", o.repeatInterval, min)
+	}
+
+	if m := o.menu; m != nil {
+		if len(m.items) == 0 {
+			return errors.New("at least one item must be provided to Menu")
+		}
+		for i, item := range m.items {
+			if item.Text == "" {
+				return fmt.Errorf("menu item[%d] has an empty text, all items must contain some text", i)
+			}
+		}
+	}
 
 	for k := range o.globalKeys {
 		if o.focusedKeys[k] {
@@ -92,6 +130,7 @@ func newOptions(text string) *options {
 		textColor:             cell.ColorBlack,
 		textHorizontalPadding: DefaultTextHorizontalPadding,
 		shadowColor:           cell.ColorNumber(240),
+		shadowOffset:          image.Point{DefaultShadowOffsetX, DefaultShadowOffsetY},
 		height:                DefaultHeight,
 		width:                 widthFor(text),
 		keyUpDelay:            DefaultKeyUpDelay,
@@ -138,6 +177,23 @@ func ShadowColor(c cell.Color) Option {
 	})
 }
 
+// Default values for the ShadowOffset option.
+const (
+	DefaultShadowOffsetX = 1
+	DefaultShadowOffsetY = 1
+)
+
+// ShadowOffset sets how far the shadow is shifted from the button, dx cells to
+// the right and dy cells down. When pressed, the button moves by the same
+// offset to cover its shadow. The widget's size grows by the offset so the
+// shadow always fits. Both values must be zero or positive integers.
+// Defaults to DefaultShadowOffsetX and DefaultShadowOffsetY.
+func ShadowOffset(dx, dy int) Option {
+	return option(func(opts *options) {
+		opts.shadowOffset = image.Point{dx, dy}
+	})
+}
+
 // DefaultHeight is the default for the Height option.
 const DefaultHeight = 3
 
@@ -222,8 +278,9 @@ const DefaultKeyUpDelay = 250 * time.Millisecond
 // KeyUpDelay is the amount of time the button will remain "pressed down" after
 // triggered by the configured key. Termbox doesn't emit events for key
 // releases so the button simulates it by timing it.
-// This only works if the manual termdash redraw or the periodic redraw
-// interval are reasonably close to this delay.
+// While pressed, the button asks termdash to redraw more often so that the
+// release is drawn close to this delay. The manual redraw mode of the termdash
+// controller doesn't honor this, the caller must redraw in time.
 // The duration cannot be negative.
 // Defaults to DefaultKeyUpDelay.
 func KeyUpDelay(d time.Duration) Option {
@@ -232,6 +289,45 @@ func KeyUpDelay(d time.Duration) Option {
 	})
 }
 
+// OnLongPress sets a callback that is called instead of the regular one when
+// the button is held down using the mouse for at least the specified duration
+// before being released. The duration must be positive.
+// The same requirements apply to the provided function as to the regular
+// callback, see CallbackFn.
+//
+// Only applies to mouse presses. Termbox doesn't emit events for key releases,
+// so presses using the configured keys always call the regular callback.
+func OnLongPress(d time.Duration, fn CallbackFn) Option {
+	return option(func(opts *options) {
+		opts.longPressDuration = d
+		opts.longPressFn = fn
+	})
+}
+
+// RepeatInterval makes the button call its callback repeatedly every d while
+// the button is held down using the mouse. The callback is also called as usual
+// when the button is released, so a press held for N intervals results in N+1
+// calls. The interval cannot be negative, zero disables the repetition.
+// The widget requests a redraw every d while repeating.
+// An error returned by the callback while repeating stops the repetition and
+// is forwarded once the button is released.
+//
+// The repeated calls are made from a separate goroutine, concurrently with
+// the processing of other terminal events, so the callback must be safe for
+// concurrent use by multiple goroutines. The repetition also stops if the
+// button isn't redrawn for ten intervals or one second, whichever is longer,
+// e.g. when termdash exits while the button is held down.
+//
+// Only applies to mouse presses. Termbox doesn't emit events for key releases,
+// however terminals usually repeat the key events themselves while the key
+// is held down, each of which calls the callback.
+// Defaults to zero.
+func RepeatInterval(d time.Duration) Option {
+	return option(func(opts *options) {
+		opts.repeatInterval = d
+	})
+}
+
 // DisableShadow when provided the button will not have a shadow area and will
 // have no animation when pressed.
 func DisableShadow() Option {
@@ -251,6 +347,46 @@ func TextHorizontalPadding(p int) Option {
 	})
 }
 
+// sideRuneGap is the amount of cells between a leading or trailing rune and
+// the button's text.
+const sideRuneGap = 1
+
+// sideRune is a rune displayed in columns reserved on one side of the button.
+type sideRune struct {
+	r        rune
+	cellOpts []cell.Option
+}
+
+// width returns the amount of columns reserved for the side rune, including
+// the gap that separates it from the text. A nil side rune reserves none.
+func (sr *sideRune) width() int {
+	if sr == nil {
+		return 0
+	}
+	return runewidth.RuneWidth(sr.r) + sideRuneGap
+}
+
+// LeadingRune displays the rune, e.g. a status glyph like ✓, on the left side
+// of the button with the provided cell options. The rune is placed in columns
+// reserved after the horizontal padding and is separated from the text by one
+// cell, so the text is centered in the remaining space. The button's width
+// grows accordingly.
+// Defaults to no leading rune.
+func LeadingRune(r rune, cOpts ...cell.Option) Option {
+	return option(func(opts *options) {
+		opts.leadingRune = &sideRune{r: r, cellOpts: cOpts}
+	})
+}
+
+// TrailingRune is like LeadingRune, but displays the rune on the right side of
+// the button.
+// Defaults to no trailing rune.
+func TrailingRune(r rune, cOpts ...cell.Option) Option {
+	return option(func(opts *options) {
+		opts.trailingRune = &sideRune{r: r, cellOpts: cOpts}
+	})
+}
+
 // widthFor returns the required width for the specified text.
 func widthFor(text string) int {
 	return runewidth.StringWidth(text)
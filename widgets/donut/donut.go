@@ -24,6 +24,7 @@ import (
 	"sync"
 
 	"github.com/woodliu/termdash/align"
+	"github.com/woodliu/termdash/cell"
 	"github.com/woodliu/termdash/private/alignfor"
 	"github.com/woodliu/termdash/private/area"
 	"github.com/woodliu/termdash/private/canvas"
@@ -49,17 +50,22 @@ func (pt progressType) String() string {
 var progressTypeNames = map[progressType]string{
 	progressTypePercent:  "progressTypePercent",
 	progressTypeAbsolute: "progressTypeAbsolute",
+	progressTypeRings:    "progressTypeRings",
 }
 
 const (
 	progressTypePercent = iota
 	progressTypeAbsolute
+	progressTypeRings
 )
 
 // Donut displays the progress of an operation by filling a partial circle and
 // eventually by completing a full circle. The circle can have a "hole" in the
 // middle, which is where the name comes from.
 //
+// The donut can also display the progress of several related operations as
+// concentric rings, see Rings().
+//
 // Implements widgetapi.Widget. This object is thread-safe.
 type Donut struct {
 	// pt indicates how current and total are interpreted.
@@ -69,7 +75,11 @@ type Donut struct {
 	// total is the value that represents completion.
 	// For progressTypePercent, this is 100, for progressTypeAbsolute this is
 	// the total provided by the caller.
+	// For progressTypeRings, current is the average of the percentages.
 	total int
+	// rings are the percentages of the rings from the outermost to the
+	// innermost, only populated for progressTypeRings.
+	rings []int
 	// mu protects the Donut.
 	mu sync.Mutex
 
@@ -114,6 +124,7 @@ func (d *Donut) Absolute(done, total int, opts ...Option) error {
 	d.pt = progressTypeAbsolute
 	d.current = done
 	d.total = total
+	d.rings = nil
 	return nil
 }
 
@@ -138,13 +149,55 @@ func (d *Donut) Percent(p int, opts ...Option) error {
 	d.pt = progressTypePercent
 	d.current = p
 	d.total = 100
+	d.rings = nil
+	return nil
+}
+
+// Rings sets the progress of several related operations in percentage, each
+// displayed as a ring of the donut. The first value is displayed by the
+// outermost ring, each following value by a ring inside the previous one.
+// The text progress displays the average of the values.
+// At least one value must be provided and each value must be between 0 and
+// 100.
+// Provided options override values set when New() was called.
+func (d *Donut) Rings(percents []int, opts ...Option) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(percents) == 0 {
+		return errors.New("at least one ring percentage must be provided")
+	}
+	var sum int
+	for i, p := range percents {
+		if p < 0 || p > 100 {
+			return fmt.Errorf("invalid percentage, percents[%d](%d) must be 0 <= p <= 100", i, p)
+		}
+		sum += p
+	}
+
+	for _, opt := range opts {
+		opt.set(d.opts)
+	}
+	if err := d.opts.validate(); err != nil {
+		return err
+	}
+
+	d.pt = progressTypeRings
+	// Copy to avoid external modifications. See #174.
+	d.rings = make([]int, len(percents))
+	copy(d.rings, percents)
+	d.current = int(math.Round(float64(sum) / float64(len(percents))))
+	d.total = 100
 	return nil
 }
 
 // progressText returns the textual representation of the current progress.
 func (d *Donut) progressText() string {
+	if f := d.opts.textFormatter; f != nil {
+		return f(d.current, d.total)
+	}
 	switch d.pt {
-	case progressTypePercent:
+	case progressTypePercent, progressTypeRings:
 		return fmt.Sprintf("%d%%", d.current)
 	case progressTypeAbsolute:
 		return fmt.Sprintf("%d/%d", d.current, d.total)
@@ -163,6 +216,79 @@ func (d *Donut) holeRadius(donutRadius int) int {
 	return r
 }
 
+// arc is a filled arc of the donut, i.e. the whole donut or one of its rings.
+type arc struct {
+	// startA and endA are the angles in degrees where the arc starts and ends.
+	startA, endA int
+	// outer is the radius of the arc in pixels.
+	outer int
+	// inner is the radius of the hole inside the arc in pixels.
+	inner int
+	// cellOpts are the cell options for the cells that contain the arc.
+	cellOpts []cell.Option
+}
+
+// arcs returns the arcs that display the current progress from the outermost
+// to the innermost. The radii of the arcs are populated by placeArcs.
+func (d *Donut) arcs() []*arc {
+	if d.pt != progressTypeRings {
+		startA, endA := startEndAngles(d.current, d.total, d.opts.startAngle, d.opts.direction)
+		return []*arc{{
+			startA:   startA,
+			endA:     endA,
+			cellOpts: d.opts.cellOpts,
+		}}
+	}
+
+	var arcs []*arc
+	for i, p := range d.rings {
+		startA, endA := startEndAngles(p, 100, d.opts.startAngle, d.opts.direction)
+		cOpts := d.opts.cellOpts
+		if i < len(d.opts.ringColors) {
+			cOpts = append(append([]cell.Option{}, cOpts...), cell.FgColor(d.opts.ringColors[i]))
+		}
+		arcs = append(arcs, &arc{
+			startA:   startA,
+			endA:     endA,
+			cellOpts: cOpts,
+		})
+	}
+	return arcs
+}
+
+// placeArcs populates the radii of the arcs on a donut with the radius r.
+// The space between the donut's radius and its hole is partitioned among the
+// rings, unless the RingThickness option sets the thickness of each ring.
+// Returns false if the arcs don't fit.
+func (d *Donut) placeArcs(arcs []*arc, r int) bool {
+	holeR := d.holeRadius(r)
+	if d.pt != progressTypeRings {
+		arcs[0].outer = r
+		arcs[0].inner = holeR
+		return true
+	}
+
+	gap := d.opts.ringGap
+	thickness := d.opts.ringThickness
+	if thickness == 0 {
+		thickness = (r - holeR - gap*(len(arcs)-1)) / len(arcs)
+	}
+	if thickness < 1 {
+		return false
+	}
+
+	outer := r
+	for _, a := range arcs {
+		if outer < 2 { // Smallest possible circle radius.
+			return false
+		}
+		a.outer = outer
+		a.inner = outer - thickness
+		outer = a.inner - gap
+	}
+	return true
+}
+
 // drawText draws the text label showing the progress.
 // The text is only drawn if the radius of the donut "hole" is large enough to
 // accommodate it.
@@ -206,8 +332,14 @@ func (d *Donut) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
-	startA, endA := startEndAngles(d.current, d.total, d.opts.startAngle, d.opts.direction)
-	if startA == endA {
+	arcs := d.arcs()
+	var hasProgress bool
+	for _, a := range arcs {
+		if a.startA != a.endA {
+			hasProgress = true
+		}
+	}
+	if !hasProgress {
 		// No progress recorded, so nothing to do.
 		return nil
 	}
@@ -237,21 +369,32 @@ func (d *Donut) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	}
 
 	mid, r := midAndRadius(bc.Area())
-	if err := draw.BrailleCircle(bc, mid, r,
-		draw.BrailleCircleFilled(),
-		draw.BrailleCircleArcOnly(startA, endA),
-		draw.BrailleCircleCellOpts(d.opts.cellOpts...),
-	); err != nil {
-		return fmt.Errorf("failed to draw the outer circle: %v", err)
+	if !d.placeArcs(arcs, r) {
+		// The rings don't fit into the radius of the donut.
+		return draw.ResizeNeeded(cvs)
 	}
 
-	holeR := d.holeRadius(r)
-	if holeR != 0 {
-		if err := draw.BrailleCircle(bc, mid, holeR,
-			draw.BrailleCircleFilled(),
-			draw.BrailleCircleClearPixels(),
-		); err != nil {
-			return fmt.Errorf("failed to draw the outer circle: %v", err)
+	var holeR int
+	for _, a := range arcs {
+		if a.startA != a.endA {
+			if err := draw.BrailleCircle(bc, mid, a.outer,
+				draw.BrailleCircleFilled(),
+				draw.BrailleCircleArcOnly(a.startA, a.endA),
+				draw.BrailleCircleCellOpts(a.cellOpts...),
+			); err != nil {
+				return fmt.Errorf("failed to draw the outer circle: %v", err)
+			}
+		}
+
+		holeR = 0
+		if a.inner >= 2 { // Smallest possible circle radius.
+			holeR = a.inner
+			if err := draw.BrailleCircle(bc, mid, holeR,
+				draw.BrailleCircleFilled(),
+				draw.BrailleCircleClearPixels(),
+			); err != nil {
+				return fmt.Errorf("failed to draw the hole: %v", err)
+			}
 		}
 	}
 	if err := bc.CopyTo(cvs); err != nil {
@@ -15,6 +15,7 @@
 package donut
 
 import (
+	"fmt"
 	"image"
 	"testing"
 
@@ -131,6 +132,38 @@ func TestDonut(t *testing.T) {
 			},
 			wantUpdateErr: true,
 		},
+		{
+			desc: "New fails on negative ring gap",
+			opts: []Option{
+				RingGap(-1),
+			},
+			canvas:     image.Rect(0, 0, 3, 3),
+			wantNewErr: true,
+		},
+		{
+			desc: "New fails on negative ring thickness",
+			opts: []Option{
+				RingThickness(-1),
+			},
+			canvas:     image.Rect(0, 0, 3, 3),
+			wantNewErr: true,
+		},
+		{
+			desc:   "Rings fails without any rings",
+			canvas: image.Rect(0, 0, 3, 3),
+			update: func(d *Donut) error {
+				return d.Rings(nil)
+			},
+			wantUpdateErr: true,
+		},
+		{
+			desc:   "Rings fails on value too large",
+			canvas: image.Rect(0, 0, 3, 3),
+			update: func(d *Donut) error {
+				return d.Rings([]int{50, 101})
+			},
+			wantUpdateErr: true,
+		},
 
 		{
 			desc:   "draws empty for no data points",
@@ -395,6 +428,35 @@ func TestDonut(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc: "formats the text progress",
+			opts: []Option{
+				CenterTextFormatter(func(current, total int) string {
+					return fmt.Sprintf("%d/%dk", current, total)
+				}),
+			},
+			canvas: image.Rect(0, 0, 7, 7),
+			update: func(d *Donut) error {
+				return d.Absolute(5, 5, HolePercent(80))
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				bc := testbraille.MustNew(c.Area())
+
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 6, draw.BrailleCircleFilled())
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 5,
+					draw.BrailleCircleFilled(),
+					draw.BrailleCircleClearPixels(),
+				)
+				testbraille.MustCopyTo(bc, c)
+
+				testdraw.MustText(c, "5/5k", image.Point{2, 3})
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc:   "sets text cell options",
 			canvas: image.Rect(0, 0, 7, 7),
@@ -496,6 +558,126 @@ func TestDonut(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:   "draws rings in their colors",
+			canvas: image.Rect(0, 0, 6, 6),
+			update: func(d *Donut) error {
+				return d.Rings([]int{100, 100}, RingColors([]cell.Color{cell.ColorRed, cell.ColorBlue}))
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				bc := testbraille.MustNew(ft.Area())
+
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 5,
+					draw.BrailleCircleFilled(),
+					draw.BrailleCircleCellOpts(cell.FgColor(cell.ColorRed)),
+				)
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 4,
+					draw.BrailleCircleFilled(),
+					draw.BrailleCircleClearPixels(),
+				)
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 3,
+					draw.BrailleCircleFilled(),
+					draw.BrailleCircleCellOpts(cell.FgColor(cell.ColorBlue)),
+				)
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 2,
+					draw.BrailleCircleFilled(),
+					draw.BrailleCircleClearPixels(),
+				)
+
+				testbraille.MustApply(bc, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "rings without progress aren't drawn",
+			canvas: image.Rect(0, 0, 6, 6),
+			update: func(d *Donut) error {
+				return d.Rings([]int{0, 100})
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				bc := testbraille.MustNew(ft.Area())
+
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 3, draw.BrailleCircleFilled())
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 2,
+					draw.BrailleCircleFilled(),
+					draw.BrailleCircleClearPixels(),
+				)
+
+				testbraille.MustApply(bc, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "draws rings with set thickness and gap, shows the average",
+			canvas: image.Rect(0, 0, 7, 7),
+			update: func(d *Donut) error {
+				return d.Rings([]int{100, 50}, RingThickness(1), RingGap(0))
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				bc := testbraille.MustNew(c.Area())
+
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 6, draw.BrailleCircleFilled())
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 5,
+					draw.BrailleCircleFilled(),
+					draw.BrailleCircleClearPixels(),
+				)
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 5,
+					draw.BrailleCircleFilled(),
+					draw.BrailleCircleArcOnly(270, 90),
+				)
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 4,
+					draw.BrailleCircleFilled(),
+					draw.BrailleCircleClearPixels(),
+				)
+				testbraille.MustCopyTo(bc, c)
+
+				testdraw.MustText(c, "75%", image.Point{2, 3})
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "draws resize needed character when the rings don't fit",
+			canvas: image.Rect(0, 0, 6, 6),
+			update: func(d *Donut) error {
+				return d.Rings([]int{50, 50}, RingThickness(3))
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustResizeNeeded(cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "Percent replaces the rings",
+			canvas: image.Rect(0, 0, 6, 6),
+			update: func(d *Donut) error {
+				if err := d.Rings([]int{100, 100}); err != nil {
+					return err
+				}
+				return d.Percent(100, HideTextProgress())
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				bc := testbraille.MustNew(ft.Area())
+
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 5, draw.BrailleCircleFilled())
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 2,
+					draw.BrailleCircleFilled(),
+					draw.BrailleCircleClearPixels(),
+				)
+
+				testbraille.MustApply(bc, ft)
+				return ft
+			},
+		},
 		{
 			desc:   "displays 1% progress",
 			canvas: image.Rect(0, 0, 7, 7),
@@ -41,6 +41,7 @@ func (o option) set(opts *options) {
 type options struct {
 	donutHolePercent int
 	hideTextProgress bool
+	textFormatter    func(current, total int) string
 
 	textCellOpts []cell.Option
 	cellOpts     []cell.Option
@@ -54,6 +55,10 @@ type options struct {
 	// The direction in which the donut completes as progress increases.
 	// Positive for counter-clockwise, negative for clockwise.
 	direction int
+
+	ringColors    []cell.Color
+	ringGap       int
+	ringThickness int
 }
 
 // validate validates the provided options.
@@ -66,6 +71,13 @@ func (o *options) validate() error {
 		return fmt.Errorf("invalid start angle %d, must be in range %d <= angle < %d", o.startAngle, min, max)
 	}
 
+	if got, min := o.ringGap, 0; got < min {
+		return fmt.Errorf("invalid RingGap %d, must be %d <= RingGap", got, min)
+	}
+	if got, min := o.ringThickness, 0; got < min {
+		return fmt.Errorf("invalid RingThickness %d, must be %d <= RingThickness", got, min)
+	}
+
 	return nil
 }
 
@@ -80,6 +92,7 @@ func newOptions() *options {
 			cell.BgColor(cell.ColorDefault),
 		},
 		labelAlign: DefaultLabelAlign,
+		ringGap:    DefaultRingGap,
 	}
 }
 
@@ -120,6 +133,18 @@ func HideTextProgress() Option {
 	})
 }
 
+// CenterTextFormatter sets a function that formats the text progress displayed
+// in the middle of the donut, e.g. to display custom units. The function
+// receives the current progress and the total that represents completion.
+// After a call to Percent() or Rings(), the total is 100 and the current
+// progress is the percentage.
+// Providing nil restores the default format described in ShowTextProgress.
+func CenterTextFormatter(f func(current, total int) string) Option {
+	return option(func(opts *options) {
+		opts.textFormatter = f
+	})
+}
+
 // TextCellOpts sets cell options on cells that contain the displayed text
 // progress.
 func TextCellOpts(cOpts ...cell.Option) Option {
@@ -181,3 +206,36 @@ func LabelAlign(la align.Horizontal) Option {
 		opts.labelAlign = la
 	})
 }
+
+// RingColors sets the colors of the rings displayed on a call to Rings().
+// The first supplied color applies to the outermost ring. Any rings that don't
+// have a color specified use the cell options set by the CellOpts option.
+func RingColors(colors []cell.Color) Option {
+	return option(func(opts *options) {
+		opts.ringColors = colors
+	})
+}
+
+// DefaultRingGap is the default value for the RingGap option.
+const DefaultRingGap = 1
+
+// RingGap sets the width of the space between the rings displayed on a call
+// to Rings(). The width is in pixels of the braille canvas, i.e. there are two
+// pixels per cell horizontally and four vertically.
+// Must be a positive or zero integer.
+// Defaults to DefaultRingGap.
+func RingGap(pixels int) Option {
+	return option(func(opts *options) {
+		opts.ringGap = pixels
+	})
+}
+
+// RingThickness sets the thickness of each of the rings displayed on a call to
+// Rings() in pixels of the braille canvas. If not set, or set to zero, the
+// rings share the space between the edge of the donut and its hole equally.
+// Must be a positive or zero integer.
+func RingThickness(pixels int) Option {
+	return option(func(opts *options) {
+		opts.ringThickness = pixels
+	})
+}
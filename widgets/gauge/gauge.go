@@ -21,7 +21,9 @@ import (
 	"image"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/woodliu/termdash/align"
 	"github.com/woodliu/termdash/cell"
 	"github.com/woodliu/termdash/linestyle"
 	"github.com/woodliu/termdash/private/alignfor"
@@ -70,6 +72,14 @@ type Gauge struct {
 	// For progressTypePercent, this is 100, for progressTypeAbsolute this is
 	// the total provided by the caller.
 	total int
+
+	// from is the filled fraction of the gauge the current animation started
+	// from. Only used when the AnimateTo option is set.
+	from float64
+	// animStart is the time when the current animation started, zero if no
+	// animation was started yet.
+	animStart time.Time
+
 	// mu protects the Gauge.
 	mu sync.Mutex
 
@@ -105,6 +115,7 @@ func (g *Gauge) Absolute(done, total int, opts ...Option) error {
 			"and total must be a non-zero positive number", done, total)
 	}
 
+	drawn := g.drawnFrac()
 	for _, opt := range opts {
 		opt.set(g.opts)
 	}
@@ -112,6 +123,7 @@ func (g *Gauge) Absolute(done, total int, opts ...Option) error {
 	g.pt = progressTypeAbsolute
 	g.current = done
 	g.total = total
+	g.startAnimation(drawn)
 	return nil
 }
 
@@ -126,6 +138,7 @@ func (g *Gauge) Percent(p int, opts ...Option) error {
 		return fmt.Errorf("invalid percentage, p(%d) must be 0 <= p <= 100", p)
 	}
 
+	drawn := g.drawnFrac()
 	for _, opt := range opts {
 		opt.set(g.opts)
 	}
@@ -133,9 +146,61 @@ func (g *Gauge) Percent(p int, opts ...Option) error {
 	g.pt = progressTypePercent
 	g.current = p
 	g.total = 100
+	g.startAnimation(drawn)
 	return nil
 }
 
+// timeSince is a function that calculates duration since some time.
+// Replaced from tests.
+var timeSince = time.Since
+
+// animationRedrawInterval is returned as the RedrawInterval in the widget
+// options while the filled portion moves towards the new progress, see the
+// AnimateTo option.
+const animationRedrawInterval = 40 * time.Millisecond
+
+// startAnimation starts animating the gauge from the provided filled fraction
+// towards the current progress if the AnimateTo option is set.
+func (g *Gauge) startAnimation(from float64) {
+	if g.opts.animateTo <= 0 {
+		return
+	}
+	g.from = from
+	g.animStart = time.Now()
+}
+
+// targetFrac returns the fraction of the gauge that is filled once any
+// animation settles.
+func (g *Gauge) targetFrac() float64 {
+	if g.total == 0 {
+		return 0
+	}
+	return float64(g.filled()) / float64(g.total)
+}
+
+// animatedFrac returns the fraction of the gauge that is filled while an
+// animation is in progress. Returns false if there is no animation in
+// progress, i.e. the gauge is settled on its target.
+func (g *Gauge) animatedFrac() (float64, bool) {
+	d := g.opts.animateTo
+	if d <= 0 || g.animStart.IsZero() {
+		return 0, false
+	}
+	progress := float64(timeSince(g.animStart)) / float64(d)
+	if progress >= 1 {
+		return 0, false
+	}
+	return g.from + (g.targetFrac()-g.from)*progress, true
+}
+
+// drawnFrac returns the fraction of the gauge that is currently filled.
+func (g *Gauge) drawnFrac() float64 {
+	if frac, ok := g.animatedFrac(); ok {
+		return frac
+	}
+	return g.targetFrac()
+}
+
 // width determines the X coordinate that represents point w in rectangle ar.
 // This is used to calculate the width of the gauge drawn on the provided area
 // in order to represent the current progress or to figure out the coordinate
@@ -151,17 +216,46 @@ func (g *Gauge) hasBorder() bool {
 	return g.opts.border != linestyle.None
 }
 
+// textOutside determines if the text is drawn on a separate line outside of
+// the gauge.
+func (g *Gauge) textOutside() bool {
+	return g.opts.textPosition != TextInline
+}
+
+// gaugeArea determines the area for the gauge including its border, i.e. the
+// canvas without the line reserved for text drawn outside of the gauge.
+func (g *Gauge) gaugeArea(cvs *canvas.Canvas) image.Rectangle {
+	ar := cvs.Area()
+	switch g.opts.textPosition {
+	case TextAbove:
+		ar.Min.Y++
+	case TextBelow:
+		ar.Max.Y--
+	}
+	return ar
+}
+
+// textArea determines the line for text drawn outside of the gauge.
+func (g *Gauge) textArea(cvs *canvas.Canvas) image.Rectangle {
+	ar := cvs.Area()
+	if g.opts.textPosition == TextBelow {
+		return image.Rect(ar.Min.X, ar.Max.Y-1, ar.Max.X, ar.Max.Y)
+	}
+	return image.Rect(ar.Min.X, ar.Min.Y, ar.Max.X, ar.Min.Y+1)
+}
+
 // usable determines the usable area for the gauge itself.
 func (g *Gauge) usable(cvs *canvas.Canvas) image.Rectangle {
 	if g.hasBorder() {
-		return area.ExcludeBorder(cvs.Area())
+		return area.ExcludeBorder(g.gaugeArea(cvs))
 	}
-	return cvs.Area()
+	return g.gaugeArea(cvs)
 }
 
-// thresholdVisible determines if the threshold line should be drawn.
-func (g *Gauge) thresholdVisible() bool {
-	return g.opts.threshold > 0 && g.opts.threshold < g.total
+// thresholdVisible determines if the threshold line at value t should be
+// drawn.
+func (g *Gauge) thresholdVisible(t int) bool {
+	return t > 0 && t < g.total
 }
 
 // progressText returns the textual representation of the current progress.
@@ -170,12 +264,49 @@ func (g *Gauge) progressText() string {
 		return ""
 	}
 
+	if g.opts.showRemaining {
+		if g.pt == progressTypePercent {
+			return fmt.Sprintf("%d%% left", g.remaining())
+		}
+		return fmt.Sprintf("%d/%d remaining", g.remaining(), g.total)
+	}
+
 	if g.pt == progressTypePercent {
 		return fmt.Sprintf("%d%%", g.current)
 	}
 	return fmt.Sprintf("%d/%d", g.current, g.total)
 }
 
+// percent returns the current progress in percent.
+func (g *Gauge) percent() int {
+	if g.total == 0 {
+		return 0
+	}
+	return g.current * 100 / g.total
+}
+
+// borderTitle returns the title of the border when the progress is at the
+// specified percentage.
+func (g *Gauge) borderTitle(percent int) string {
+	if g.opts.borderTitleFormat != "" {
+		return fmt.Sprintf(g.opts.borderTitleFormat, percent)
+	}
+	return g.opts.borderTitle
+}
+
+// remaining returns the amount remaining to completion.
+func (g *Gauge) remaining() int {
+	return g.total - g.current
+}
+
+// filled returns the amount the filled portion of the gauge represents.
+func (g *Gauge) filled() int {
+	if g.opts.fillRemaining {
+		return g.remaining()
+	}
+	return g.current
+}
+
 // gaugeText returns full text to be displayed within the gauge, i.e. the
 // progress text and the optional label.
 func (g *Gauge) gaugeText() string {
@@ -190,12 +321,34 @@ func (g *Gauge) gaugeText() string {
 	return b.String()
 }
 
+// drawOutsideText draws the text enumerating the progress and the text label
+// on the line outside of the gauge.
+func (g *Gauge) drawOutsideText(cvs *canvas.Canvas, text string) error {
+	ar := g.textArea(cvs)
+	trimmed, err := draw.TrimText(text, ar.Dx(), draw.OverrunModeThreeDot)
+	if err != nil {
+		return err
+	}
+
+	start, err := alignfor.Text(ar, trimmed, g.opts.hTextAlign, align.VerticalTop)
+	if err != nil {
+		return err
+	}
+	return draw.Text(cvs, trimmed, start,
+		draw.TextMaxX(ar.Max.X),
+		draw.TextCellOpts(cell.FgColor(g.opts.emptyTextColor)),
+	)
+}
+
 // drawText draws the text enumerating the progress and the text label.
 func (g *Gauge) drawText(cvs *canvas.Canvas, progress image.Rectangle) error {
 	text := g.gaugeText()
 	if text == "" {
 		return nil
 	}
+	if g.textOutside() {
+		return g.drawOutsideText(cvs, text)
+	}
 
 	ar := g.usable(cvs)
 	trimmed, err := draw.TrimText(text, ar.Dx(), draw.OverrunModeThreeDot)
@@ -218,16 +371,28 @@ func (g *Gauge) drawText(cvs *canvas.Canvas, progress image.Rectangle) error {
 		// If the current rune is full-width and only one of its cells falls
 		// within the filled area of the gauge, extend the gauge by one cell to
 		// fully cover the full-width rune.
-		if rw == 2 && next.In(ar) && cur.In(progress) && !next.In(progress) {
+		inside := cur.In(progress)
+		if rw == 2 && next.In(ar) && cur.In(progress) != next.In(progress) {
+			fixupX := next.X
+			if g.opts.reverseDirection {
+				// The gauge grows towards the left, extend it onto the first
+				// cell of the rune.
+				fixupX = cur.X
+				inside = true
+			}
 			fixup := image.Rect(
-				next.X,
+				fixupX,
 				ar.Min.Y,
-				next.X+1,
+				fixupX+1,
 				ar.Max.Y,
 			)
 			if err := draw.Rectangle(cvs, fixup,
 				draw.RectChar(g.opts.gaugeChar),
-				draw.RectCellOpts(cell.BgColor(g.opts.color)),
+				draw.RectCellOpts(
+					// Reset the color of any EmptyChar drawn in the cell.
+					cell.FgColor(cell.ColorDefault),
+					cell.BgColor(g.colorAt(ar, fixupX)),
+				),
 			); err != nil {
 				return err
 			}
@@ -235,7 +400,7 @@ func (g *Gauge) drawText(cvs *canvas.Canvas, progress image.Rectangle) error {
 		}
 
 		var cellOpts []cell.Option
-		if cur.In(progress) {
+		if inside {
 			cellOpts = append(cellOpts, cell.FgColor(g.opts.filledTextColor))
 		} else {
 			cellOpts = append(cellOpts, cell.FgColor(g.opts.emptyTextColor))
@@ -251,23 +416,100 @@ func (g *Gauge) drawText(cvs *canvas.Canvas, progress image.Rectangle) error {
 	return nil
 }
 
+// colorAt returns the color of the filled gauge in the column x of the usable
+// area ar.
+func (g *Gauge) colorAt(ar image.Rectangle, x int) cell.Color {
+	pos := x - ar.Min.X
+	if g.opts.reverseDirection {
+		pos = ar.Max.X - 1 - x
+	}
+	perc := pos * 100 / ar.Dx()
+	for _, cr := range g.opts.colorRanges {
+		if perc >= cr.Low && perc < cr.High {
+			return cr.Color
+		}
+	}
+	return g.opts.color
+}
+
+// drawProgress draws the filled portion of the gauge, i.e. the progress
+// rectangle within the usable area. Consecutive columns of the same color
+// are drawn as one segment.
+func (g *Gauge) drawProgress(cvs *canvas.Canvas, usable, progress image.Rectangle) error {
+	for start := progress.Min.X; start < progress.Max.X; {
+		color := g.colorAt(usable, start)
+		end := start + 1
+		for end < progress.Max.X && g.colorAt(usable, end) == color {
+			end++
+		}
+
+		segment := image.Rect(start, progress.Min.Y, end, progress.Max.Y)
+		if err := draw.Rectangle(cvs, segment,
+			draw.RectChar(g.opts.gaugeChar),
+			draw.RectCellOpts(cell.BgColor(color)),
+		); err != nil {
+			return err
+		}
+		start = end
+	}
+	return nil
+}
+
+// drawEmpty draws the EmptyChar in the empty area of the gauge, i.e. the part
+// of the usable area outside of the progress rectangle.
+func (g *Gauge) drawEmpty(cvs *canvas.Canvas, usable, progress image.Rectangle) error {
+	if g.opts.emptyChar == 0 {
+		return nil
+	}
+
+	empty := image.Rect(progress.Max.X, usable.Min.Y, usable.Max.X, usable.Max.Y)
+	if g.opts.reverseDirection {
+		empty = image.Rect(usable.Min.X, usable.Min.Y, progress.Min.X, usable.Max.Y)
+	}
+	if empty.Empty() {
+		return nil
+	}
+	return draw.Rectangle(cvs, empty,
+		draw.RectChar(g.opts.emptyChar),
+		draw.RectCellOpts(cell.FgColor(g.opts.emptyColor)),
+	)
+}
+
+// drawThresholds draws the visible threshold lines.
+func (g *Gauge) drawThresholds(cvs *canvas.Canvas) error {
+	for _, t := range g.opts.thresholds {
+		if !g.thresholdVisible(t.Value) {
+			continue
+		}
+		if err := g.drawThreshold(cvs, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // drawThreshold draws the threshold line.
-func (g *Gauge) drawThreshold(cvs *canvas.Canvas) error {
+func (g *Gauge) drawThreshold(cvs *canvas.Canvas, t ThresholdLine) error {
 	ar := g.usable(cvs)
+	gaugeAr := g.gaugeArea(cvs)
 
+	x := ar.Min.X + g.width(ar, t.Value)
+	if g.opts.reverseDirection {
+		x = ar.Max.X - g.width(ar, t.Value) - 1
+	}
 	line := draw.HVLine{
 		Start: image.Point{
-			X: ar.Min.X + g.width(ar, g.opts.threshold),
-			Y: cvs.Area().Min.Y,
+			X: x,
+			Y: gaugeAr.Min.Y,
 		},
 		End: image.Point{
-			X: ar.Min.X + g.width(ar, g.opts.threshold),
-			Y: cvs.Area().Max.Y - 1,
+			X: x,
+			Y: gaugeAr.Max.Y - 1,
 		},
 	}
 	return draw.HVLines(cvs, []draw.HVLine{line},
-		draw.HVLineStyle(g.opts.thresholdLineStyle),
-		draw.HVLineCellOpts(g.opts.thresholdCellOpts...),
+		draw.HVLineStyle(t.LineStyle),
+		draw.HVLineCellOpts(t.CellOpts...),
 	)
 }
 
@@ -286,9 +528,9 @@ func (g *Gauge) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	}
 
 	if g.hasBorder() {
-		if err := draw.Border(cvs, cvs.Area(),
+		if err := draw.Border(cvs, g.gaugeArea(cvs),
 			draw.BorderLineStyle(g.opts.border),
-			draw.BorderTitle(g.opts.borderTitle, draw.OverrunModeThreeDot, g.opts.borderCellOpts...),
+			draw.BorderTitle(g.borderTitle(g.percent()), draw.OverrunModeThreeDot, g.opts.borderCellOpts...),
 			draw.BorderTitleAlign(g.opts.borderTitleHAlign),
 			draw.BorderCellOpts(g.opts.borderCellOpts...),
 		); err != nil {
@@ -297,24 +539,32 @@ func (g *Gauge) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	}
 
 	usable := g.usable(cvs)
+	fillWidth := g.width(usable, g.filled())
+	if frac, ok := g.animatedFrac(); ok {
+		fillWidth = int(float64(usable.Dx()) * frac)
+	}
 	progress := image.Rect(
 		usable.Min.X,
 		usable.Min.Y,
-		usable.Min.X+g.width(usable, g.current),
+		usable.Min.X+fillWidth,
 		usable.Max.Y,
 	)
-	if progress.Dx() > 0 {
-		if err := draw.Rectangle(cvs, progress,
-			draw.RectChar(g.opts.gaugeChar),
-			draw.RectCellOpts(cell.BgColor(g.opts.color)),
-		); err != nil {
-			return err
-		}
+	if g.opts.reverseDirection {
+		progress = image.Rect(
+			usable.Max.X-fillWidth,
+			usable.Min.Y,
+			usable.Max.X,
+			usable.Max.Y,
+		)
 	}
-	if g.thresholdVisible() {
-		if err := g.drawThreshold(cvs); err != nil {
-			return err
-		}
+	if err := g.drawEmpty(cvs, usable, progress); err != nil {
+		return err
+	}
+	if err := g.drawProgress(cvs, usable, progress); err != nil {
+		return err
+	}
+	if err := g.drawThresholds(cvs); err != nil {
+		return err
 	}
 
 	return g.drawText(cvs, progress)
@@ -337,6 +587,10 @@ func (g *Gauge) maxSize() image.Point {
 		// Add the required space for the border.
 		maxHeight += 2
 	}
+	if maxHeight > 0 && g.textOutside() {
+		// Add the line for the text.
+		maxHeight++
+	}
 	return image.Point{0, maxHeight}
 }
 
@@ -348,6 +602,18 @@ func (g *Gauge) minSize() image.Point {
 		// Add the required space for the border.
 		minWidth += 2
 		minHeight += 2
+
+		if g.opts.alwaysShowTitle {
+			// The full title plus the two corners of the border.
+			// A title formatted from the progress is the widest when complete.
+			if tw := runewidth.StringWidth(g.borderTitle(100)) + 2; tw > minWidth {
+				minWidth = tw
+			}
+		}
+	}
+	if g.textOutside() {
+		// Add the line for the text.
+		minHeight++
 	}
 	return image.Point{minWidth, minHeight}
 }
@@ -356,10 +622,15 @@ func (g *Gauge) minSize() image.Point {
 func (g *Gauge) Options() widgetapi.Options {
 	g.mu.Lock()
 	defer g.mu.Unlock()
+	var redraw time.Duration
+	if _, ok := g.animatedFrac(); ok {
+		redraw = animationRedrawInterval
+	}
 	return widgetapi.Options{
-		MaximumSize:  g.maxSize(),
-		MinimumSize:  g.minSize(),
-		WantKeyboard: widgetapi.KeyScopeNone,
-		WantMouse:    widgetapi.MouseScopeNone,
+		MaximumSize:    g.maxSize(),
+		MinimumSize:    g.minSize(),
+		WantKeyboard:   widgetapi.KeyScopeNone,
+		WantMouse:      widgetapi.MouseScopeNone,
+		RedrawInterval: redraw,
 	}
 }
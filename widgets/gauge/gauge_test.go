@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"image"
 	"testing"
+	"time"
 
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/woodliu/termdash/align"
@@ -69,6 +70,17 @@ func TestGauge(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			desc: "fails on full-width empty char",
+			opts: []Option{
+				EmptyChar('你'),
+			},
+			canvas: image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
 		{
 			desc: "fails on negative threshold",
 			opts: []Option{
@@ -80,6 +92,60 @@ func TestGauge(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			desc: "fails on negative value in thresholds",
+			opts: []Option{
+				Thresholds([]ThresholdLine{
+					{Value: 3, LineStyle: linestyle.Light},
+					{Value: -1, LineStyle: linestyle.Light},
+				}),
+			},
+			canvas: image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "fails on unsupported line style in thresholds",
+			opts: []Option{
+				Thresholds([]ThresholdLine{
+					{Value: 3, LineStyle: linestyle.Light},
+					{Value: 5, LineStyle: linestyle.LineStyle(-1)},
+				}),
+			},
+			canvas: image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "threshold with zero line style defaults to light",
+			opts: []Option{
+				Char('o'),
+				Thresholds([]ThresholdLine{{Value: 7}}),
+				Border(linestyle.None),
+				HideTextProgress(),
+			},
+			absolute: &absoluteCall{done: 4, total: 10},
+			canvas:   image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 4, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testdraw.MustHVLines(c, []draw.HVLine{{
+					Start: image.Point{X: 7, Y: 0},
+					End:   image.Point{X: 7, Y: 2},
+				}}, draw.HVLineStyle(linestyle.Light))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc: "gauge without progress text",
 			opts: []Option{
@@ -141,6 +207,51 @@ func TestGauge(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc: "gauge showing remaining percentage",
+			opts: []Option{
+				Char('o'),
+				ShowRemaining(),
+				HorizontalTextAlign(align.HorizontalRight),
+			},
+			percent: &percentCall{p: 35},
+			canvas:  image.Rect(0, 0, 14, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 4, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testdraw.MustText(c, "65% left", image.Point{6, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "gauge filling by the remaining percentage",
+			opts: []Option{
+				Char('o'),
+				FillRemaining(),
+			},
+			percent: &percentCall{p: 35},
+			canvas:  image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 6, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testdraw.MustText(c, "35%", image.Point{3, 1},
+					draw.TextCellOpts(cell.FgColor(cell.ColorBlack)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc: "fails when Percent is less than zero",
 			opts: []Option{
@@ -176,6 +287,221 @@ func TestGauge(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc: "fails on negative AnimateTo",
+			opts: []Option{
+				AnimateTo(-1 * time.Second),
+			},
+			canvas: image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "fails on ColorRange with Low not less than High",
+			opts: []Option{
+				ColorRanges([]ColorRange{
+					{Low: 50, High: 50, Color: cell.ColorRed},
+				}),
+			},
+			canvas: image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "fails on ColorRange above 100 percent",
+			opts: []Option{
+				ColorRanges([]ColorRange{
+					{Low: 50, High: 101, Color: cell.ColorRed},
+				}),
+			},
+			canvas: image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "draws segments colored per the color ranges",
+			opts: []Option{
+				Char('o'),
+				HideTextProgress(),
+				ColorRanges([]ColorRange{
+					{Low: 0, High: 50, Color: cell.ColorGreen},
+					{Low: 50, High: 80, Color: cell.ColorYellow},
+					{Low: 80, High: 100, Color: cell.ColorRed},
+				}),
+			},
+			percent: &percentCall{p: 90},
+			canvas:  image.Rect(0, 0, 10, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 5, 1),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testdraw.MustRectangle(c, image.Rect(5, 0, 8, 1),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorYellow)),
+				)
+				testdraw.MustRectangle(c, image.Rect(8, 0, 9, 1),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorRed)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "parts not covered by color ranges use the gauge color",
+			opts: []Option{
+				Char('o'),
+				HideTextProgress(),
+				ReverseDirection(),
+				Color(cell.ColorBlue),
+				ColorRanges([]ColorRange{
+					{Low: 0, High: 20, Color: cell.ColorRed},
+				}),
+			},
+			percent: &percentCall{p: 40},
+			canvas:  image.Rect(0, 0, 10, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(6, 0, 8, 1),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorBlue)),
+				)
+				testdraw.MustRectangle(c, image.Rect(8, 0, 10, 1),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorRed)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "fails on AlwaysShowTitle without a border",
+			opts: []Option{
+				BorderTitle("title"),
+				AlwaysShowTitle(),
+			},
+			canvas: image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "draws resize needed character when canvas is too narrow for the title",
+			opts: []Option{
+				Char('o'),
+				Border(linestyle.Light),
+				BorderTitle("long title"),
+				AlwaysShowTitle(),
+			},
+			percent: &percentCall{p: 35},
+			canvas:  image.Rect(0, 0, 11, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustResizeNeeded(c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "draws the full title when it fits",
+			opts: []Option{
+				Char('o'),
+				Border(linestyle.Light),
+				BorderTitle("long title"),
+				AlwaysShowTitle(),
+				HideTextProgress(),
+			},
+			percent: &percentCall{p: 0},
+			canvas:  image.Rect(0, 0, 12, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustBorder(c, c.Area(),
+					draw.BorderTitle("long title", draw.OverrunModeThreeDot),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "fails on BorderTitleFromProgress without an integer verb",
+			opts: []Option{
+				Border(linestyle.Light),
+				BorderTitleFromProgress("Progress: %s"),
+			},
+			canvas: image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "border title reflects the progress and overrides the static title",
+			opts: []Option{
+				Char('o'),
+				Border(linestyle.Light),
+				BorderTitle("title"),
+				BorderTitleFromProgress("Progress: %d%%"),
+				HideTextProgress(),
+			},
+			percent: &percentCall{p: 73},
+			canvas:  image.Rect(0, 0, 20, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustBorder(c, c.Area(),
+					draw.BorderTitle("Progress: 73%", draw.OverrunModeThreeDot),
+				)
+				testdraw.MustRectangle(c, image.Rect(1, 1, 14, 2),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "border title from absolute progress is trimmed",
+			opts: []Option{
+				Char('o'),
+				Border(linestyle.Light),
+				BorderTitleFromProgress("Progress: %d%%"),
+				HideTextProgress(),
+			},
+			absolute: &absoluteCall{done: 1, total: 4},
+			canvas:   image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustBorder(c, c.Area(),
+					draw.BorderTitle("Progress: 25%", draw.OverrunModeThreeDot),
+				)
+				testdraw.MustRectangle(c, image.Rect(1, 1, 3, 2),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc: "aligns the progress text top and left",
 			opts: []Option{
@@ -387,6 +713,29 @@ func TestGauge(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc: "gauge showing and filling by the remaining absolute progress",
+			opts: []Option{
+				Char('o'),
+				ShowRemaining(),
+				FillRemaining(),
+				HorizontalTextAlign(align.HorizontalRight),
+			},
+			absolute: &absoluteCall{done: 7, total: 10},
+			canvas:   image.Rect(0, 0, 20, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 6, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testdraw.MustText(c, "3/10 remaining", image.Point{6, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc: "fails when Absolute done is negative",
 			opts: []Option{
@@ -520,6 +869,160 @@ func TestGauge(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc: "HideTextProgress keeps the text label",
+			opts: []Option{
+				Char('o'),
+				TextLabel("Downloading"),
+			},
+			percent: &percentCall{p: 0, opts: []Option{HideTextProgress()}},
+			canvas:  image.Rect(0, 0, 15, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "(Downloading)", image.Point{1, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "gauge shows progress without the text label",
+			opts: []Option{
+				Char('o'),
+				HideTextProgress(),
+				TextLabel("Downloading"),
+			},
+			absolute: &absoluteCall{done: 0, total: 10, opts: []Option{ShowTextProgress(), TextLabel("")}},
+			canvas:   image.Rect(0, 0, 15, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "0/10", image.Point{5, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "draws the empty char with no progress",
+			opts: []Option{
+				Char('o'),
+				HideTextProgress(),
+				EmptyChar('·'),
+				EmptyColor(cell.ColorBlue),
+			},
+			percent: &percentCall{p: 0},
+			canvas:  image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 10, 3),
+					draw.RectChar('·'),
+					draw.RectCellOpts(cell.FgColor(cell.ColorBlue)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "draws the empty char only in the empty area, text over it",
+			opts: []Option{
+				Char('o'),
+				EmptyChar('·'),
+				EmptyColor(cell.ColorBlue),
+			},
+			percent: &percentCall{p: 50},
+			canvas:  image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(5, 0, 10, 3),
+					draw.RectChar('·'),
+					draw.RectCellOpts(cell.FgColor(cell.ColorBlue)),
+				)
+				testdraw.MustRectangle(c, image.Rect(0, 0, 5, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testdraw.MustText(c, "50", image.Point{3, 1},
+					draw.TextCellOpts(cell.FgColor(cell.ColorBlack)),
+				)
+				testdraw.MustText(c, "%", image.Point{5, 1},
+					draw.TextCellOpts(cell.FgColor(cell.ColorDefault)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "draws the empty char in the empty area of a reversed gauge",
+			opts: []Option{
+				Char('o'),
+				HideTextProgress(),
+				ReverseDirection(),
+				EmptyChar('·'),
+			},
+			percent: &percentCall{p: 30},
+			canvas:  image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 7, 3),
+					draw.RectChar('·'),
+				)
+				testdraw.MustRectangle(c, image.Rect(7, 0, 10, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "full-width runes compose with the empty char when the gauge is extended",
+			opts: []Option{
+				Char('o'),
+				HideTextProgress(),
+				TextLabel("你好"),
+				EmptyChar('·'),
+				EmptyColor(cell.ColorBlue),
+			},
+			percent: &percentCall{p: 40},
+			canvas:  image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(4, 0, 10, 3),
+					draw.RectChar('·'),
+					draw.RectCellOpts(cell.FgColor(cell.ColorBlue)),
+				)
+				testdraw.MustRectangle(c, image.Rect(0, 0, 4, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				// The gauge is extended to cover the full-width rune.
+				testdraw.MustRectangle(c, image.Rect(4, 0, 5, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(
+						cell.FgColor(cell.ColorDefault),
+						cell.BgColor(cell.ColorGreen),
+					),
+				)
+				testdraw.MustText(c, "(你", image.Point{2, 1},
+					draw.TextCellOpts(cell.FgColor(cell.ColorBlack)),
+				)
+				testdraw.MustText(c, "好)", image.Point{5, 1},
+					draw.TextCellOpts(cell.FgColor(cell.ColorDefault)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc: "gauge with text label, full-width runes",
 			opts: []Option{
@@ -572,28 +1075,100 @@ func TestGauge(t *testing.T) {
 			},
 		},
 		{
-			desc: "gauge with text label, full-width runes, gauge extended to cover full rune",
+			desc: "gauge with text label, full-width runes, gauge extended to cover full rune",
+			opts: []Option{
+				Char('o'),
+				HideTextProgress(),
+				TextLabel("你好"),
+			},
+			percent: &percentCall{p: 40},
+			canvas:  image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 5, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testdraw.MustText(c, "(你", image.Point{2, 1},
+					draw.TextCellOpts(cell.FgColor(cell.ColorBlack)),
+				)
+				testdraw.MustText(c, "好)", image.Point{5, 1},
+					draw.TextCellOpts(cell.FgColor(cell.ColorDefault)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "reverse direction fills from the right",
+			opts: []Option{
+				Char('o'),
+				ReverseDirection(),
+			},
+			percent: &percentCall{p: 35},
+			canvas:  image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(7, 0, 10, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testdraw.MustText(c, "35%", image.Point{3, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "reverse direction with border",
+			opts: []Option{
+				Char('o'),
+				ReverseDirection(),
+				Border(linestyle.Light),
+				HideTextProgress(),
+			},
+			percent: &percentCall{p: 50},
+			canvas:  image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustBorder(c, c.Area())
+				testdraw.MustRectangle(c, image.Rect(5, 1, 9, 2),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "reverse direction, full-width runes, gauge extended to cover full rune",
 			opts: []Option{
 				Char('o'),
+				ReverseDirection(),
 				HideTextProgress(),
 				TextLabel("你好"),
 			},
-			percent: &percentCall{p: 40},
+			percent: &percentCall{p: 60},
 			canvas:  image.Rect(0, 0, 10, 3),
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
-				testdraw.MustRectangle(c, image.Rect(0, 0, 5, 3),
+				testdraw.MustRectangle(c, image.Rect(3, 0, 10, 3),
 					draw.RectChar('o'),
 					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
 				)
-				testdraw.MustText(c, "(你", image.Point{2, 1},
-					draw.TextCellOpts(cell.FgColor(cell.ColorBlack)),
-				)
-				testdraw.MustText(c, "好)", image.Point{5, 1},
+				testdraw.MustText(c, "(", image.Point{2, 1},
 					draw.TextCellOpts(cell.FgColor(cell.ColorDefault)),
 				)
+				testdraw.MustText(c, "你好)", image.Point{3, 1},
+					draw.TextCellOpts(cell.FgColor(cell.ColorBlack)),
+				)
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
@@ -866,6 +1441,152 @@ func TestGauge(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc: "dashed threshold",
+			opts: []Option{
+				Char('o'),
+				HideTextProgress(),
+				Threshold(20, linestyle.Dashed),
+			},
+			percent: &percentCall{p: 35},
+			canvas:  image.Rect(0, 0, 10, 5),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 3, 5),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				for _, y := range []int{0, 2, 4} {
+					testcanvas.MustSetCell(c, image.Point{2, y}, '│')
+				}
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "threshold is mirrored in reverse direction",
+			opts: []Option{
+				Char('o'),
+				ReverseDirection(),
+				Threshold(20, linestyle.Double),
+			},
+			percent: &percentCall{p: 35},
+			canvas:  image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(7, 0, 10, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testdraw.MustText(c, "35%", image.Point{3, 1})
+				testdraw.MustHVLines(c, []draw.HVLine{{
+					Start: image.Point{X: 7, Y: 0},
+					End:   image.Point{X: 7, Y: 2},
+				}}, draw.HVLineStyle(linestyle.Double))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "fails on unsupported text position",
+			opts: []Option{
+				TextPosition(TextPlacement(-1)),
+			},
+			canvas: image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "draws text above the gauge",
+			opts: []Option{
+				Char('o'),
+				TextPosition(TextAbove),
+				TextLabel("label"),
+			},
+			percent: &percentCall{p: 35},
+			canvas:  image.Rect(0, 0, 20, 2),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "35% (label)", image.Point{4, 0})
+				testdraw.MustRectangle(c, image.Rect(0, 1, 7, 2),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "draws text below the gauge with border and threshold",
+			opts: []Option{
+				Char('o'),
+				TextPosition(TextBelow),
+				HorizontalTextAlign(align.HorizontalLeft),
+				Border(linestyle.Light),
+				Threshold(20, linestyle.Double),
+			},
+			percent: &percentCall{p: 50},
+			canvas:  image.Rect(0, 0, 12, 4),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustBorder(c, image.Rect(0, 0, 12, 3))
+				testdraw.MustRectangle(c, image.Rect(1, 1, 6, 2),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testdraw.MustHVLines(c, []draw.HVLine{{
+					Start: image.Point{X: 3, Y: 0},
+					End:   image.Point{X: 3, Y: 2},
+				}}, draw.HVLineStyle(linestyle.Double))
+				testdraw.MustText(c, "50%", image.Point{0, 3})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "trims text drawn outside of the gauge",
+			opts: []Option{
+				Char('o'),
+				TextPosition(TextAbove),
+				TextLabel("long label"),
+			},
+			percent: &percentCall{p: 0},
+			canvas:  image.Rect(0, 0, 6, 2),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "0% (l…", image.Point{0, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "requests resize when there is no space for text outside of the gauge",
+			opts: []Option{
+				TextPosition(TextBelow),
+			},
+			percent: &percentCall{p: 35},
+			canvas:  image.Rect(0, 0, 10, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustResizeNeeded(c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc: "threshold without border absolute",
 			opts: []Option{
@@ -893,6 +1614,72 @@ func TestGauge(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc: "draws multiple thresholds",
+			opts: []Option{
+				Char('o'),
+				Thresholds([]ThresholdLine{
+					{Value: 7, LineStyle: linestyle.Light, CellOpts: []cell.Option{cell.FgColor(cell.ColorYellow)}},
+					{Value: 9, LineStyle: linestyle.Double, CellOpts: []cell.Option{cell.FgColor(cell.ColorRed)}},
+					{Value: 10, LineStyle: linestyle.Light}, // ignored
+				}),
+				Border(linestyle.None),
+				HideTextProgress(),
+			},
+			absolute: &absoluteCall{done: 4, total: 10},
+			canvas:   image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 4, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testdraw.MustHVLines(c, []draw.HVLine{{
+					Start: image.Point{X: 7, Y: 0},
+					End:   image.Point{X: 7, Y: 2},
+				}}, draw.HVLineStyle(linestyle.Light),
+					draw.HVLineCellOpts(cell.FgColor(cell.ColorYellow)))
+				testdraw.MustHVLines(c, []draw.HVLine{{
+					Start: image.Point{X: 9, Y: 0},
+					End:   image.Point{X: 9, Y: 2},
+				}}, draw.HVLineStyle(linestyle.Double),
+					draw.HVLineCellOpts(cell.FgColor(cell.ColorRed)))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "threshold replaces previously set thresholds",
+			opts: []Option{
+				Char('o'),
+				Thresholds([]ThresholdLine{
+					{Value: 7, LineStyle: linestyle.Light},
+					{Value: 9, LineStyle: linestyle.Double},
+				}),
+				Threshold(3, linestyle.Light),
+				Border(linestyle.None),
+				HideTextProgress(),
+			},
+			absolute: &absoluteCall{done: 4, total: 10},
+			canvas:   image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 4, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testdraw.MustHVLines(c, []draw.HVLine{{
+					Start: image.Point{X: 3, Y: 0},
+					End:   image.Point{X: 3, Y: 2},
+				}}, draw.HVLineStyle(linestyle.Light))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc: "threshold outside of bounds (>=max)",
 			opts: []Option{
@@ -1054,6 +1841,111 @@ func TestGauge(t *testing.T) {
 	}
 }
 
+func TestAnimateTo(t *testing.T) {
+	tests := []struct {
+		desc string
+		// first is the percentage provided before the animation starts.
+		first int
+		// second is the percentage the gauge animates to.
+		second int
+		// elapsed is the time elapsed since the second call to Percent.
+		elapsed time.Duration
+		// wantWidth is the expected width of the filled portion of the gauge.
+		wantWidth int
+		// wantRedrawInterval is the expected RedrawInterval in the options.
+		wantRedrawInterval time.Duration
+	}{
+		{
+			desc:               "animation just started",
+			first:              20,
+			second:             80,
+			wantWidth:          2,
+			wantRedrawInterval: animationRedrawInterval,
+		},
+		{
+			desc:               "animation half way through",
+			first:              20,
+			second:             80,
+			elapsed:            500 * time.Millisecond,
+			wantWidth:          5,
+			wantRedrawInterval: animationRedrawInterval,
+		},
+		{
+			desc:               "animation shrinking the gauge",
+			first:              80,
+			second:             20,
+			elapsed:            500 * time.Millisecond,
+			wantWidth:          5,
+			wantRedrawInterval: animationRedrawInterval,
+		},
+		{
+			desc:      "animation settles on the target",
+			first:     20,
+			second:    80,
+			elapsed:   2 * time.Second,
+			wantWidth: 8,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			// The animation to the first percentage completes before the
+			// second percentage is provided.
+			timeSince = func(time.Time) time.Duration {
+				return time.Hour
+			}
+			defer func() {
+				timeSince = time.Since
+			}()
+
+			g, err := New(
+				Char('o'),
+				HideTextProgress(),
+				AnimateTo(time.Second),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if err := g.Percent(tc.first); err != nil {
+				t.Fatalf("Percent => unexpected error: %v", err)
+			}
+			if err := g.Percent(tc.second); err != nil {
+				t.Fatalf("Percent => unexpected error: %v", err)
+			}
+
+			timeSince = func(time.Time) time.Duration {
+				return tc.elapsed
+			}
+			c := testcanvas.MustNew(image.Rect(0, 0, 10, 1))
+			if err := g.Draw(c, &widgetapi.Meta{}); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+			got, err := faketerm.New(c.Size())
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			if err := c.Apply(got); err != nil {
+				t.Fatalf("Apply => unexpected error: %v", err)
+			}
+
+			want := faketerm.MustNew(c.Size())
+			wantCvs := testcanvas.MustNew(want.Area())
+			testdraw.MustRectangle(wantCvs, image.Rect(0, 0, tc.wantWidth, 1),
+				draw.RectChar('o'),
+				draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+			)
+			testcanvas.MustApply(wantCvs, want)
+			if diff := faketerm.Diff(want, got); diff != "" {
+				t.Errorf("Draw => %v", diff)
+			}
+
+			if got := g.Options().RedrawInterval; got != tc.wantRedrawInterval {
+				t.Errorf("Options => RedrawInterval %v, want %v", got, tc.wantRedrawInterval)
+			}
+		})
+	}
+}
+
 func TestKeyboard(t *testing.T) {
 	g, err := New()
 	if err != nil {
@@ -1134,6 +2026,60 @@ func TestOptions(t *testing.T) {
 				WantMouse:    widgetapi.MouseScopeNone,
 			},
 		},
+		{
+			desc: "border title isn't accounted for by default",
+			opts: []Option{
+				Border(linestyle.Light),
+				BorderTitle("title"),
+			},
+			want: widgetapi.Options{
+				MaximumSize:  image.Point{0, 2},
+				MinimumSize:  image.Point{3, 3},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeNone,
+			},
+		},
+		{
+			desc: "text outside of the gauge is accounted for in maximum and minimum size",
+			opts: []Option{
+				TextPosition(TextAbove),
+				Height(1),
+			},
+			want: widgetapi.Options{
+				MaximumSize:  image.Point{0, 2},
+				MinimumSize:  image.Point{1, 2},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeNone,
+			},
+		},
+		{
+			desc: "minimum size fits the border title when it must always be shown",
+			opts: []Option{
+				Border(linestyle.Light),
+				BorderTitle("title"),
+				AlwaysShowTitle(),
+			},
+			want: widgetapi.Options{
+				MaximumSize:  image.Point{0, 2},
+				MinimumSize:  image.Point{7, 3},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeNone,
+			},
+		},
+		{
+			desc: "minimum size fits the border title from progress when complete",
+			opts: []Option{
+				Border(linestyle.Light),
+				BorderTitleFromProgress("Progress: %d%%"),
+				AlwaysShowTitle(),
+			},
+			want: widgetapi.Options{
+				MaximumSize:  image.Point{0, 2},
+				MinimumSize:  image.Point{16, 3},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeNone,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -17,12 +17,17 @@ package gauge
 // options.go contains configurable options for Gauge.
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+	"time"
+	"unicode"
 
 	"github.com/woodliu/termdash/align"
 	"github.com/woodliu/termdash/cell"
 	"github.com/woodliu/termdash/linestyle"
 	"github.com/woodliu/termdash/private/draw"
+	"github.com/woodliu/termdash/private/runewidth"
 )
 
 // Option is used to provide options.
@@ -35,22 +40,30 @@ type Option interface {
 type options struct {
 	gaugeChar        rune
 	hideTextProgress bool
+	showRemaining    bool
+	fillRemaining    bool
+	reverseDirection bool
+	animateTo        time.Duration
+	colorRanges      []ColorRange
 	height           int
 	textLabel        string
 	hTextAlign       align.Horizontal
 	vTextAlign       align.Vertical
+	textPosition     TextPlacement
 	color            cell.Color
 	filledTextColor  cell.Color
 	emptyTextColor   cell.Color
+	emptyChar        rune
+	emptyColor       cell.Color
 	// If set, draws a border around the gauge.
 	border            linestyle.LineStyle
 	borderCellOpts    []cell.Option
 	borderTitle       string
+	borderTitleFormat string
 	borderTitleHAlign align.Horizontal
-	// If set draws a vertical line representing the threshold.
-	threshold          int
-	thresholdCellOpts  []cell.Option
-	thresholdLineStyle linestyle.LineStyle
+	alwaysShowTitle   bool
+	// If set draws vertical lines representing the thresholds.
+	thresholds []ThresholdLine
 }
 
 // newOptions returns options with the default values set.
@@ -59,9 +72,11 @@ func newOptions() *options {
 		gaugeChar:       DefaultChar,
 		hTextAlign:      DefaultHorizontalTextAlign,
 		vTextAlign:      DefaultVerticalTextAlign,
+		textPosition:    DefaultTextPosition,
 		color:           DefaultColor,
 		filledTextColor: DefaultFilledTextColor,
 		emptyTextColor:  DefaultEmptyTextColor,
+		emptyColor:      DefaultEmptyColor,
 	}
 }
 
@@ -70,8 +85,33 @@ func (o *options) validate() error {
 	if got, min := o.height, 0; got < min {
 		return fmt.Errorf("invalid Height %d, must be %d <= Height", got, min)
 	}
-	if got, min := o.threshold, 0; got < min {
-		return fmt.Errorf("invalid Threshold %d, must be %d <= Threshold", got, min)
+	for i, t := range o.thresholds {
+		if got, min := t.Value, 0; got < min {
+			return fmt.Errorf("invalid Thresholds[%d] value %d, must be %d <= value", i, got, min)
+		}
+		if ls := t.LineStyle; ls < linestyle.Light || ls > linestyle.Dotted {
+			return fmt.Errorf("invalid Thresholds[%d] line style %v, must be one of the supported line styles", i, ls)
+		}
+	}
+	if got, min := o.animateTo, time.Duration(0); got < min {
+		return fmt.Errorf("invalid AnimateTo %v, must be %v <= AnimateTo", got, min)
+	}
+	for i, cr := range o.colorRanges {
+		if min, max := 0, 100; cr.Low < min || cr.High > max || cr.Low >= cr.High {
+			return fmt.Errorf("invalid ColorRange[%d] %+v, must be %d <= Low < High <= %d", i, cr, min, max)
+		}
+	}
+	if o.emptyChar != 0 && (!unicode.IsPrint(o.emptyChar) || runewidth.RuneWidth(o.emptyChar) != 1) {
+		return fmt.Errorf("invalid EmptyChar %q, must be a printable half-width rune", o.emptyChar)
+	}
+	if _, ok := textPlacementNames[o.textPosition]; !ok {
+		return fmt.Errorf("unsupported TextPosition %v", o.textPosition)
+	}
+	if o.borderTitleFormat != "" && strings.Contains(fmt.Sprintf(o.borderTitleFormat, 0), "%!") {
+		return fmt.Errorf("invalid BorderTitleFromProgress format %q, must contain a single integer verb", o.borderTitleFormat)
+	}
+	if o.alwaysShowTitle && o.border == linestyle.None {
+		return errors.New("the AlwaysShowTitle option requires the Border option")
 	}
 	return nil
 }
@@ -99,20 +139,71 @@ func Char(ch rune) Option {
 // enumerating the progress. This is the default behavior.
 // If the progress is set by a call to Percent(), the displayed text will show
 // the percentage, e.g. "50%". If the progress is set by a call to Absolute(),
-// the displayed text will whos the absolute numbers, e.g. "5/10".
+// the displayed text will show the absolute numbers, e.g. "5/10".
+// The progress is displayed independently of the TextLabel option.
 func ShowTextProgress() Option {
 	return option(func(opts *options) {
 		opts.hideTextProgress = false
 	})
 }
 
-// HideTextProgress disables the display of a text enumerating the progress.
+// HideTextProgress disables the display of the text enumerating the progress,
+// e.g. "70%" or "7/10". The text label set by the TextLabel option is still
+// displayed, so the Gauge can display e.g. just "(Downloading)".
 func HideTextProgress() Option {
 	return option(func(opts *options) {
 		opts.hideTextProgress = true
 	})
 }
 
+// ShowRemaining configures the Gauge so that the text enumerating the progress
+// displays the amount remaining to completion instead of the amount completed.
+// If the progress is set by a call to Percent(), the displayed text will show
+// the remaining percentage, e.g. "27% left". If the progress is set by a call
+// to Absolute(), the displayed text will show the remaining absolute numbers,
+// e.g. "3/10 remaining".
+// The gauge still fills by the completed progress, see FillRemaining.
+// Has no effect if HideTextProgress is provided.
+func ShowRemaining() Option {
+	return option(func(opts *options) {
+		opts.showRemaining = true
+	})
+}
+
+// FillRemaining configures the Gauge so that the filled portion of the bar
+// represents the amount remaining to completion instead of the amount
+// completed, i.e. the bar empties as the progress grows. Useful for
+// countdown-style indicators, usually combined with ShowRemaining.
+func FillRemaining() Option {
+	return option(func(opts *options) {
+		opts.fillRemaining = true
+	})
+}
+
+// ReverseDirection configures the Gauge to fill from the right edge towards the
+// left, e.g. for right-to-left dashboards. The threshold line is mirrored
+// accordingly.
+func ReverseDirection() Option {
+	return option(func(opts *options) {
+		opts.reverseDirection = true
+	})
+}
+
+// AnimateTo makes the filled portion of the gauge gradually move towards the
+// progress provided on a call to Percent() or Absolute() instead of jumping to
+// it. The width is interpolated between the previously drawn and the new
+// progress over the specified duration and settles exactly on the new
+// progress. While the filled portion is moving, the gauge requests its own
+// redraws, so the animation doesn't depend on the redraw interval used by
+// termdash. The text enumerating the progress always shows the new progress.
+// Must be a positive or zero duration, zero disables the animation.
+// Defaults to zero.
+func AnimateTo(d time.Duration) Option {
+	return option(func(opts *options) {
+		opts.animateTo = d
+	})
+}
+
 // Height sets the height of the drawn Gauge. Must be a positive number.
 // Defaults to zero which means the height of the container.
 func Height(height int) Option {
@@ -121,9 +212,9 @@ func Height(height int) Option {
 	})
 }
 
-// TextLabel configures the Gauge to display the provided text.
-// If the ShowTextProgress() option is also provided, this label is drawn right
-// after the progress text.
+// TextLabel configures the Gauge to display the provided text in parentheses.
+// Unless the HideTextProgress() option is provided, this label is drawn right
+// after the progress text. Provide an empty text to remove the label.
 func TextLabel(text string) Option {
 	return option(func(opts *options) {
 		opts.textLabel = text
@@ -140,6 +231,29 @@ func Color(c cell.Color) Option {
 	})
 }
 
+// ColorRange is a range of the gauge that is filled with a specific color.
+type ColorRange struct {
+	// Low is the percentage where the range starts, inclusive.
+	Low int
+	// High is the percentage where the range ends, exclusive.
+	High int
+	// Color is the color of the filled gauge within the range.
+	Color cell.Color
+}
+
+// ColorRanges sets the colors of the individual segments of the filled gauge,
+// e.g. green below 50%, yellow below 80% and red above. The bounds are
+// percentages of the gauge width regardless of whether the progress is set by
+// a call to Percent() or Absolute(). Each range must satisfy
+// 0 <= Low < High <= 100. If the ranges overlap, the first matching range
+// applies. Parts of the gauge not covered by any range use the color set by
+// the Color option.
+func ColorRanges(ranges []ColorRange) Option {
+	return option(func(opts *options) {
+		opts.colorRanges = ranges
+	})
+}
+
 // DefaultFilledTextColor is the default value for the FilledTextColor option.
 const DefaultFilledTextColor = cell.ColorBlack
 
@@ -164,6 +278,28 @@ func EmptyTextColor(c cell.Color) Option {
 	})
 }
 
+// EmptyChar sets the rune that fills the empty area of the Gauge, i.e. the
+// portion the progress didn't fill yet, so that the extent of the Gauge is
+// visible even with no progress. The text progress and text label are drawn
+// over it. Must be a printable half-width rune.
+// Defaults to no rune, the empty area is left blank.
+func EmptyChar(r rune) Option {
+	return option(func(opts *options) {
+		opts.emptyChar = r
+	})
+}
+
+// DefaultEmptyColor is the default value for the EmptyColor option.
+const DefaultEmptyColor = cell.ColorDefault
+
+// EmptyColor sets the color of the rune set by the EmptyChar option.
+// Has no effect unless EmptyChar is provided.
+func EmptyColor(c cell.Color) Option {
+	return option(func(opts *options) {
+		opts.emptyColor = c
+	})
+}
+
 // DefaultHorizontalTextAlign is the default value for the HorizontalTextAlign option.
 const DefaultHorizontalTextAlign = align.HorizontalCenter
 
@@ -186,6 +322,50 @@ func VerticalTextAlign(v align.Vertical) Option {
 	})
 }
 
+// TextPlacement indicates where the text progress and text label are drawn.
+type TextPlacement int
+
+// String implements fmt.Stringer()
+func (tp TextPlacement) String() string {
+	if n, ok := textPlacementNames[tp]; ok {
+		return n
+	}
+	return "TextPlacementUnknown"
+}
+
+// textPlacementNames maps TextPlacement values to human readable names.
+var textPlacementNames = map[TextPlacement]string{
+	TextInline: "TextInline",
+	TextAbove:  "TextAbove",
+	TextBelow:  "TextBelow",
+}
+
+const (
+	// TextInline draws the text over the gauge.
+	TextInline TextPlacement = iota
+
+	// TextAbove draws the text on a separate line above the gauge.
+	TextAbove
+
+	// TextBelow draws the text on a separate line below the gauge.
+	TextBelow
+)
+
+// DefaultTextPosition is the default value for the TextPosition option.
+const DefaultTextPosition = TextInline
+
+// TextPosition sets where the text progress and text label are drawn.
+// When drawn above or below the gauge, the text occupies an additional line
+// outside of the gauge and its border, uses the EmptyTextColor and the
+// VerticalTextAlign option is ignored. Useful for gauges with the height of
+// one line where the text is hard to read over the filled gauge.
+// Defaults to DefaultTextPosition.
+func TextPosition(tp TextPlacement) Option {
+	return option(func(opts *options) {
+		opts.textPosition = tp
+	})
+}
+
 // Border configures the gauge to have a border of the specified style.
 func Border(ls linestyle.LineStyle, cOpts ...cell.Option) Option {
 	return option(func(opts *options) {
@@ -201,6 +381,16 @@ func BorderTitle(title string) Option {
 	})
 }
 
+// BorderTitleFromProgress sets a border title that reflects the current
+// progress. The title is formatted on each draw using the format string, which
+// must contain a single integer verb that receives the progress in percent,
+// e.g. "Progress: %d%%". Overrides the title set with BorderTitle.
+func BorderTitleFromProgress(format string) Option {
+	return option(func(opts *options) {
+		opts.borderTitleFormat = format
+	})
+}
+
 // BorderTitleAlign sets the horizontal alignment for the border title.
 // Defaults to alignment on the left.
 func BorderTitleAlign(h align.Horizontal) Option {
@@ -209,16 +399,58 @@ func BorderTitleAlign(h align.Horizontal) Option {
 	})
 }
 
+// AlwaysShowTitle guarantees that the border title is always fully visible.
+// The minimum width of the gauge grows to fit the entire border title and the
+// widget asks for a resize instead of trimming the title on smaller canvases.
+// Requires the Border option.
+func AlwaysShowTitle() Option {
+	return option(func(opts *options) {
+		opts.alwaysShowTitle = true
+	})
+}
+
 // Threshold configures the Gauge to display a vertical threshold line at value
 // t. If the progress is set by a call to Percent(), t represents a percentage,
 // e.g. "40" means line is displayed at 40%. If the progress is set by a call to
 // Absolute(), the threshold is considered an absolute number.
 // Threshold must be positive to be displayed. If the threshold is zero or
 // greater than total, it won't be displayed. Defaults to zero.
+//
+// This is a shorthand for Thresholds with a single line, it replaces any
+// thresholds set previously.
 func Threshold(t int, ls linestyle.LineStyle, cOpts ...cell.Option) Option {
+	return Thresholds([]ThresholdLine{
+		{Value: t, LineStyle: ls, CellOpts: cOpts},
+	})
+}
+
+// ThresholdLine is a vertical line displayed at a threshold, see Thresholds.
+type ThresholdLine struct {
+	// Value is the position of the line, interpreted the same way as the
+	// value provided to the Threshold option.
+	Value int
+	// LineStyle is the style of the line.
+	// The zero value linestyle.None is replaced with linestyle.Light.
+	LineStyle linestyle.LineStyle
+	// CellOpts are the cell options for the cells that contain the line.
+	CellOpts []cell.Option
+}
+
+// Thresholds configures the Gauge to display multiple vertical threshold
+// lines, e.g. one for a warning and one for a critical value, each with its
+// own style. The lines are drawn in the provided order, so later lines win
+// where they overlap. Lines whose value is zero or not less than the total
+// aren't displayed. Values must be positive or zero.
+// Replaces any thresholds set previously, including by the Threshold option.
+func Thresholds(lines []ThresholdLine) Option {
 	return option(func(opts *options) {
-		opts.threshold = t
-		opts.thresholdLineStyle = ls
-		opts.thresholdCellOpts = cOpts
+		// Copy to avoid external modifications. See #174.
+		opts.thresholds = make([]ThresholdLine, len(lines))
+		copy(opts.thresholds, lines)
+		for i := range opts.thresholds {
+			if opts.thresholds[i].LineStyle == linestyle.None {
+				opts.thresholds[i].LineStyle = linestyle.Light
+			}
+		}
 	})
 }
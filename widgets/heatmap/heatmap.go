@@ -17,11 +17,21 @@ package heatmap
 
 import (
 	"errors"
+	"fmt"
 	"image"
+	"math"
+	"strconv"
 	"sync"
+	"time"
 
+	"github.com/woodliu/termdash/align"
 	"github.com/woodliu/termdash/cell"
+	"github.com/woodliu/termdash/keyboard"
+	"github.com/woodliu/termdash/private/alignfor"
+	"github.com/woodliu/termdash/private/area"
 	"github.com/woodliu/termdash/private/canvas"
+	"github.com/woodliu/termdash/private/draw"
+	"github.com/woodliu/termdash/private/runewidth"
 	"github.com/woodliu/termdash/terminal/terminalapi"
 	"github.com/woodliu/termdash/widgetapi"
 	"github.com/woodliu/termdash/widgets/heatmap/internal/axes"
@@ -35,7 +45,8 @@ import (
 // The two dimensions of the values (cells) array are determined by the length of
 // the xLabels and yLabels arrays respectively.
 //
-// HeatMap does not support mouse based zoom.
+// HeatMap does not support mouse based zoom. Cells can be inspected with the
+// mouse and the keyboard, see the EnableHover and EnableInspection options.
 //
 // Implements widgetapi.Widget. This object is thread-safe.
 type HeatMap struct {
@@ -53,6 +64,17 @@ type HeatMap struct {
 
 	// lastWidth is the width of the canvas as of the last time when Draw was called.
 	lastWidth int
+	// lastHeight is the height of the canvas as of the last time when Draw was called.
+	lastHeight int
+
+	// hovered is the column (X) and row (Y) of the cell under the mouse
+	// cursor. Nil if the mouse cursor isn't over any cell.
+	// Only tracked when the EnableHover option is set.
+	hovered *image.Point
+
+	// selected is the column (X) and row (Y) of the cell selected with the
+	// keyboard. Only tracked when the EnableInspection option is set.
+	selected image.Point
 
 	// opts are the provided options.
 	opts *options
@@ -63,7 +85,13 @@ type HeatMap struct {
 
 // New returns a new HeatMap widget.
 func New(opts ...Option) (*HeatMap, error) {
-	return nil, errors.New("not implemented")
+	opt := newOptions(opts...)
+	if err := opt.validate(); err != nil {
+		return nil, err
+	}
+	return &HeatMap{
+		opts: opt,
+	}, nil
 }
 
 // Values sets the values to be displayed by the HeatMap.
@@ -73,19 +101,176 @@ func New(opts ...Option) (*HeatMap, error) {
 // But labels could be empty strings.
 // When no labels are provided, labels will be "0", "1", "2"...
 //
+// Values must be finite numbers, except for math.NaN() which marks a missing
+// value. Missing values don't affect the color scale and are drawn in the
+// color set by the MissingColor option.
+//
 // Each call to Values overwrites any previously provided values.
 // Provided options override values set when New() was called.
 func (hp *HeatMap) Values(xLabels []string, yLabels []string, values [][]float64, opts ...Option) error {
-	return errors.New("not implemented")
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+
+	if err := validateValues(xLabels, yLabels, values); err != nil {
+		return err
+	}
+
+	opt := *hp.opts
+	for _, o := range opts {
+		o.set(&opt)
+	}
+	if err := opt.validate(); err != nil {
+		return err
+	}
+	hp.opts = &opt
+
+	// Copy to avoid external modifications.
+	hp.values = make([][]float64, len(values))
+	for i, row := range values {
+		hp.values[i] = make([]float64, len(row))
+		copy(hp.values[i], row)
+	}
+
+	var cols int
+	if len(values) > 0 {
+		cols = len(values[0])
+	}
+	hp.xLabels = labelsOrDefault(xLabels, cols)
+	hp.yLabels = labelsOrDefault(yLabels, len(values))
+	hp.minValue, hp.maxValue = minMax(values)
+	hp.selected = hp.clampCell(hp.selected)
+	return nil
+}
+
+// validateValues validates the values and labels provided to Values.
+func validateValues(xLabels, yLabels []string, values [][]float64) error {
+	if len(yLabels) > 0 && len(yLabels) != len(values) {
+		return fmt.Errorf("got %d yLabels, must be zero or match the number of rows in values (%d)", len(yLabels), len(values))
+	}
+	if len(values) == 0 {
+		if len(xLabels) > 0 {
+			return fmt.Errorf("got %d xLabels, but no values", len(xLabels))
+		}
+		return nil
+	}
+
+	cols := len(values[0])
+	for i, row := range values {
+		if len(row) != cols {
+			return fmt.Errorf("values[%d] has %d values, all rows must have the same length as values[0] (%d)", i, len(row), cols)
+		}
+		for j, v := range row {
+			if math.IsInf(v, 0) {
+				return fmt.Errorf("values[%d][%d] is %v, must be a finite number or NaN", i, j, v)
+			}
+		}
+	}
+	if len(xLabels) > 0 && len(xLabels) != cols {
+		return fmt.Errorf("got %d xLabels, must be zero or match the number of values in each row (%d)", len(xLabels), cols)
+	}
+	return nil
+}
+
+// labelsOrDefault returns a copy of the labels or if none were provided, n
+// labels enumerating the rows or columns, i.e. "0", "1", "2"...
+func labelsOrDefault(labels []string, n int) []string {
+	if len(labels) > 0 {
+		res := make([]string, len(labels))
+		copy(res, labels)
+		return res
+	}
+
+	res := make([]string, n)
+	for i := range res {
+		res[i] = strconv.Itoa(i)
+	}
+	return res
+}
+
+// minMax returns the smallest and the largest value, ignoring missing values.
+// Returns zeroes if there are no values.
+func minMax(values [][]float64) (float64, float64) {
+	var (
+		min, max float64
+		seen     bool
+	)
+	for _, row := range values {
+		for _, v := range row {
+			if math.IsNaN(v) {
+				continue
+			}
+			if !seen || v < min {
+				min = v
+			}
+			if !seen || v > max {
+				max = v
+			}
+			seen = true
+		}
+	}
+	return min, max
+}
+
+// ValuesOverTime sets the values to be displayed by the HeatMap where each
+// column of values represents a consecutive time bucket, e.g. when drawing a
+// spectrogram or a calendar heat map.
+//
+// The first column starts at the provided start time and each following
+// column starts one interval after the previous one. The labels on the X axis
+// are generated from the start times of the columns and formatted based on the
+// length of the interval. The interval must be positive.
+//
+// Otherwise behaves like Values.
+func (hp *HeatMap) ValuesOverTime(start time.Time, interval time.Duration, yLabels []string, values [][]float64, opts ...Option) error {
+	if interval <= 0 {
+		return fmt.Errorf("invalid interval %v, must be a positive duration", interval)
+	}
+
+	var cols int
+	if len(values) > 0 {
+		cols = len(values[0])
+	}
+	return hp.Values(timeLabels(start, interval, cols), yLabels, values, opts...)
+}
+
+// timeLabels returns n labels for consecutive time buckets of the provided
+// interval, the first bucket starts at the start time.
+func timeLabels(start time.Time, interval time.Duration, n int) []string {
+	layout := timeLayout(interval)
+	labels := make([]string, n)
+	for i := range labels {
+		labels[i] = start.Add(time.Duration(i) * interval).Format(layout)
+	}
+	return labels
+}
+
+// timeLayout returns the layout used to format time labels for buckets of the
+// provided interval. The layout is as short as possible while still
+// distinguishing neighboring buckets.
+func timeLayout(interval time.Duration) string {
+	switch {
+	case interval < time.Second:
+		return "15:04:05.000"
+	case interval < time.Minute:
+		return "15:04:05"
+	case interval < 24*time.Hour:
+		return "15:04"
+	default:
+		return "2006-01-02"
+	}
 }
 
 // ClearXLabels clear the X labels.
 func (hp *HeatMap) ClearXLabels() {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
 	hp.xLabels = nil
 }
 
 // ClearYLabels clear the Y labels.
 func (hp *HeatMap) ClearYLabels() {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
 	hp.yLabels = nil
 }
 
@@ -97,57 +282,530 @@ func (hp *HeatMap) ClearYLabels() {
 // no guarantee this remains the same next time Draw is called.
 // Should be used as a hint only.
 func (hp *HeatMap) ValueCapacity() int {
-	return 0
+	hp.mu.RLock()
+	defer hp.mu.RUnlock()
+
+	cols := (hp.lastWidth-hp.yTitleWidth()-hp.yLabelsWidth())/hp.opts.cellWidth - hp.summaryColumns()
+	rows := hp.lastHeight - 1 // One row for the X labels.
+	rows -= hp.summaryRows()
+	if hp.opts.xAxisTitle != "" {
+		rows-- // One row for the X axis title.
+	}
+	if hp.hasValueLabel() {
+		rows-- // One row for the value of the hovered or selected cell.
+	}
+	if cols <= 0 || rows <= 0 {
+		return 0
+	}
+	return cols * rows
+}
+
+// yLabelsWidth returns the width of the Y labels including the Y axis.
+func (hp *HeatMap) yLabelsWidth() int {
+	return axes.LongestString(hp.displayedYLabels()) + 1 // One cell for the Y axis.
+}
+
+// yTitleGap is the number of columns between the Y axis title and the Y labels.
+const yTitleGap = 1
+
+// yTitleWidth returns the width of the column with the vertical Y axis title
+// including the gap between the title and the Y labels. Returns zero if there
+// is no Y axis title.
+func (hp *HeatMap) yTitleWidth() int {
+	var width int
+	for _, r := range hp.opts.yAxisTitle {
+		if rw := runewidth.RuneWidth(r); rw > width {
+			width = rw
+		}
+	}
+	if width == 0 {
+		return 0
+	}
+	return width + yTitleGap
+}
+
+// graphArea returns the area of the canvas for the cells, the Y labels and the
+// X labels, i.e. the area on the right of the Y axis title and above the X
+// axis title.
+func (hp *HeatMap) graphArea(cvs *canvas.Canvas) image.Rectangle {
+	return image.Rect(hp.yTitleWidth(), 0, cvs.Area().Max.X, hp.rows()+hp.summaryRows()+1)
 }
 
 // axesDetails determines the details about the X and Y axes.
+// The provided canvas is the one for the graph area, see graphArea.
+// The axes include the rows and columns of the summaries.
 func (hp *HeatMap) axesDetails(cvs *canvas.Canvas) (*axes.XDetails, *axes.YDetails, error) {
-	return nil, nil, errors.New("not implemented")
+	yd, err := axes.NewYDetails(hp.displayedYLabels())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	xd, err := axes.NewXDetails(cvs.Area(), yd.End, hp.displayedXLabels(), hp.opts.cellWidth)
+	if err != nil {
+		return nil, nil, err
+	}
+	return xd, yd, nil
+}
+
+// labelsFor returns the labels for n rows or columns. If the labels were
+// cleared, returns n empty labels instead.
+func labelsFor(labels []string, n int) []string {
+	if len(labels) == n {
+		return labels
+	}
+	return make([]string, n)
+}
+
+// rows returns the number of rows of cells.
+func (hp *HeatMap) rows() int {
+	return len(hp.values)
+}
+
+// columns returns the number of columns of cells.
+func (hp *HeatMap) columns() int {
+	if len(hp.values) == 0 {
+		return 0
+	}
+	return len(hp.values[0])
 }
 
 // Draw draws cells, X labels and Y labels as HeatMap.
 // Implements widgetapi.Widget.Draw.
 func (hp *HeatMap) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
-	return errors.New("not implemented")
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+
+	hp.lastWidth = cvs.Area().Dx()
+	hp.lastHeight = cvs.Area().Dy()
+	if len(hp.values) == 0 {
+		return nil
+	}
+
+	needAr, err := area.FromSize(hp.minSize())
+	if err != nil {
+		return err
+	}
+	if !needAr.In(cvs.Area()) {
+		return draw.ResizeNeeded(cvs)
+	}
+
+	graphAr := hp.graphArea(cvs)
+	gCvs, err := canvas.New(graphAr)
+	if err != nil {
+		return err
+	}
+	xd, yd, err := hp.axesDetails(gCvs)
+	if err != nil {
+		return err
+	}
+	if err := hp.drawCells(gCvs, xd, yd); err != nil {
+		return err
+	}
+	if err := hp.drawSummaries(gCvs, xd, yd); err != nil {
+		return err
+	}
+	if err := hp.drawSelected(gCvs, xd, yd); err != nil {
+		return err
+	}
+	if err := hp.drawLabels(gCvs, xd, yd); err != nil {
+		return err
+	}
+	if err := gCvs.CopyTo(cvs); err != nil {
+		return err
+	}
+
+	if err := hp.drawXTitle(cvs, xd.Start.Add(graphAr.Min), xd.End.Add(graphAr.Min)); err != nil {
+		return err
+	}
+	if err := hp.drawYTitle(cvs); err != nil {
+		return err
+	}
+
+	labelY := graphAr.Max.Y
+	if hp.opts.xAxisTitle != "" {
+		labelY++
+	}
+	return hp.drawValueLabel(cvs, labelY)
+}
+
+// drawXTitle draws the X axis title in the row under the X labels. The title
+// is centered under the cells between the start and the end of the X axis.
+// A title wider than the cells starts with the cells and is trimmed at the edge
+// of the canvas.
+func (hp *HeatMap) drawXTitle(cvs *canvas.Canvas, xStart, xEnd image.Point) error {
+	title := hp.opts.xAxisTitle
+	if title == "" {
+		return nil
+	}
+
+	ar := image.Rect(xStart.X, xStart.Y+1, xEnd.X, xStart.Y+2)
+	if runewidth.StringWidth(title) > ar.Dx() {
+		ar.Max.X = cvs.Area().Max.X
+	}
+	trimmed, err := draw.TrimText(title, ar.Dx(), draw.OverrunModeThreeDot)
+	if err != nil {
+		return err
+	}
+	start, err := alignfor.Text(ar, trimmed, align.HorizontalCenter, align.VerticalMiddle)
+	if err != nil {
+		return err
+	}
+	return draw.Text(cvs, trimmed, start,
+		draw.TextMaxX(ar.Max.X),
+		draw.TextCellOpts(hp.opts.xLabelCellOpts...),
+	)
+}
+
+// drawYTitle draws the Y axis title vertically, one rune per row, in the
+// left-most column of the canvas. The title is centered along the rows of
+// cells and trimmed with an ellipsis if it is longer than the number of rows.
+func (hp *HeatMap) drawYTitle(cvs *canvas.Canvas) error {
+	title := []rune(hp.opts.yAxisTitle)
+	if len(title) == 0 {
+		return nil
+	}
+
+	if rows := hp.rows(); len(title) > rows {
+		title = append(title[:rows-1], '…')
+	}
+	startY := (hp.rows() - len(title)) / 2
+	for i, r := range title {
+		if _, err := cvs.SetCell(image.Point{0, startY + i}, r, hp.opts.yLabelCellOpts...); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // drawCells draws m*n cells (rectangles) representing the stored values.
-// The height of each cell is 1 and the default width is 3.
+// The height of each cell is 1 and the width is set by the CellWidth option.
 func (hp *HeatMap) drawCells(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.YDetails) error {
-	return errors.New("not implemented")
+	cw := hp.opts.cellWidth
+	for row, rowValues := range hp.values {
+		y := yd.Start.Y + row
+		for col, v := range rowValues {
+			x := xd.Start.X + col*cw
+			cellAr := image.Rect(x, y, x+cw, y+1)
+			if err := hp.drawCell(cvs, cellAr, v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// drawCell draws a single cell representing the value.
+func (hp *HeatMap) drawCell(cvs *canvas.Canvas, cellAr image.Rectangle, v float64) error {
+	color := hp.getCellColor(v)
+	if err := draw.Rectangle(cvs, cellAr, draw.RectCellOpts(cell.BgColor(color))); err != nil {
+		return err
+	}
+	if math.IsNaN(v) {
+		return drawMissing(cvs, cellAr, color)
+	}
+	return hp.drawValue(cvs, cellAr, v, color)
+}
+
+// missingGlyph is drawn in the cells of missing values.
+const missingGlyph = '·'
+
+// drawMissing draws the glyph marking a missing value centered in the cell.
+func drawMissing(cvs *canvas.Canvas, cellAr image.Rectangle, bg cell.Color) error {
+	p := image.Point{cellAr.Min.X + (cellAr.Dx()-1)/2, cellAr.Min.Y}
+	_, err := cvs.SetCell(p, missingGlyph,
+		cell.FgColor(contrastColor(bg)),
+		cell.BgColor(bg),
+	)
+	return err
+}
+
+// drawValue draws the formatted value centered in the cell if ShowValues was
+// provided. The value isn't drawn if it doesn't fit into the cell.
+func (hp *HeatMap) drawValue(cvs *canvas.Canvas, cellAr image.Rectangle, value float64, bg cell.Color) error {
+	if !hp.opts.showValues {
+		return nil
+	}
+	text := fmt.Sprintf(hp.opts.valuesFormat, value)
+	if runewidth.StringWidth(text) > cellAr.Dx() {
+		return nil
+	}
+	start, err := alignfor.Text(cellAr, text, align.HorizontalCenter, align.VerticalMiddle)
+	if err != nil {
+		return err
+	}
+	return draw.Text(cvs, text, start, draw.TextCellOpts(
+		cell.FgColor(contrastColor(bg)),
+		cell.BgColor(bg),
+	))
+}
+
+// Runes that outline the cell selected with the keyboard.
+const (
+	// selectedLeft marks the left edge of the selected cell.
+	selectedLeft = '['
+	// selectedRight marks the right edge of the selected cell.
+	selectedRight = ']'
+	// selectedNarrow marks the selected cell when it is only one column wide.
+	selectedNarrow = '*'
+)
+
+// drawSelected outlines the cell selected with the keyboard if the
+// EnableInspection option was provided.
+func (hp *HeatMap) drawSelected(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.YDetails) error {
+	if !hp.opts.inspect || hp.columns() == 0 || hp.rows() == 0 {
+		return nil
+	}
+
+	col, row := hp.selected.X, hp.selected.Y
+	color := hp.getCellColor(hp.values[row][col])
+	cellOpts := []cell.Option{
+		cell.FgColor(contrastColor(color)),
+		cell.BgColor(color),
+	}
+
+	cw := hp.opts.cellWidth
+	left := image.Point{xd.Start.X + col*cw, yd.Start.Y + row}
+	if cw == 1 {
+		_, err := cvs.SetCell(left, selectedNarrow, cellOpts...)
+		return err
+	}
+	if _, err := cvs.SetCell(left, selectedLeft, cellOpts...); err != nil {
+		return err
+	}
+	_, err := cvs.SetCell(image.Point{left.X + cw - 1, left.Y}, selectedRight, cellOpts...)
+	return err
 }
 
 // drawAxes draws X labels (under the cells) and Y Labels (on the left side of the cell).
 func (hp *HeatMap) drawLabels(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.YDetails) error {
-	return errors.New("not implemented")
+	for _, l := range yd.Labels {
+		if err := drawLabel(cvs, l, hp.opts.yLabelCellOpts); err != nil {
+			return err
+		}
+	}
+	for _, l := range xd.Labels {
+		if err := drawLabel(cvs, l, hp.opts.xLabelCellOpts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasValueLabel asserts whether the HeatMap displays the value of the
+// hovered or selected cell in a label.
+func (hp *HeatMap) hasValueLabel() bool {
+	return hp.opts.hover || hp.opts.inspect
+}
+
+// labeledCell returns the column (X) and row (Y) of the cell whose value is
+// displayed in the label. That is the cell under the mouse cursor if there is
+// one, otherwise the cell selected with the keyboard.
+// Returns false if no cell should be labeled.
+func (hp *HeatMap) labeledCell() (image.Point, bool) {
+	if hp.opts.hover && hp.hovered != nil {
+		return *hp.hovered, true
+	}
+	if hp.opts.inspect {
+		return hp.selected, true
+	}
+	return image.Point{}, false
+}
+
+// drawValueLabel draws the value of the hovered or selected cell in a label
+// in the row y under the X labels and the X axis title.
+func (hp *HeatMap) drawValueLabel(cvs *canvas.Canvas, y int) error {
+	labeled, ok := hp.labeledCell()
+	if !ok {
+		return nil
+	}
+	col, row := labeled.X, labeled.Y
+	if row >= hp.rows() || col >= hp.columns() {
+		// The values changed since the mouse event.
+		return nil
+	}
+
+	text := fmt.Sprintf("%v", hp.values[row][col])
+	if math.IsNaN(hp.values[row][col]) {
+		text = "missing"
+	}
+	return draw.Text(cvs, text, image.Point{0, y},
+		draw.TextMaxX(cvs.Area().Max.X),
+		draw.TextOverrunMode(draw.OverrunModeThreeDot),
+	)
+}
+
+// drawLabel draws a single label with the provided cell options.
+func drawLabel(cvs *canvas.Canvas, l *axes.Label, cellOpts []cell.Option) error {
+	if l.Text == "" {
+		return nil
+	}
+	if err := draw.Text(cvs, l.Text, l.Pos, draw.TextCellOpts(cellOpts...)); err != nil {
+		return fmt.Errorf("failed to draw label %q: %v", l.Text, err)
+	}
+	return nil
 }
 
 // minSize determines the minimum required size to draw HeatMap.
+// The cells need one row each and are cellWidth wide, the Y labels are on the
+// left of the cells and the X labels take one row under the cells. The axis
+// titles take one column on the left and one row under the X labels. The
+// summaries take one column on the right and one row under the cells.
 func (hp *HeatMap) minSize() image.Point {
-	return image.Point{}
+	if len(hp.values) == 0 {
+		return image.Point{}
+	}
+
+	height := hp.rows() + hp.summaryRows() + 1
+	if hp.opts.xAxisTitle != "" {
+		height++ // One row for the X axis title.
+	}
+	if hp.hasValueLabel() {
+		height++ // One row for the value of the hovered or selected cell.
+	}
+	return image.Point{
+		X: hp.yTitleWidth() + hp.yLabelsWidth() + (hp.columns()+hp.summaryColumns())*hp.opts.cellWidth,
+		Y: height,
+	}
 }
 
-// Keyboard input isn't supported on the HeatMap widget.
-func (*HeatMap) Keyboard(k *terminalapi.Keyboard, meta *widgetapi.EventMeta) error {
-	return errors.New("the HeatMap widget doesn't support keyboard events")
+// cellAt returns the column (X) and row (Y) of the cell at the provided
+// point on the canvas. Returns nil if the point doesn't fall onto any cell.
+// Uses the same layout as drawCells.
+func (hp *HeatMap) cellAt(p image.Point) *image.Point {
+	x := p.X - hp.yTitleWidth() - hp.yLabelsWidth()
+	if x < 0 || p.Y < 0 {
+		return nil
+	}
+
+	col, row := x/hp.opts.cellWidth, p.Y
+	if col >= hp.columns() || row >= hp.rows() {
+		return nil
+	}
+	return &image.Point{col, row}
 }
 
-// Mouse input isn't supported on the HeatMap widget.
-func (*HeatMap) Mouse(m *terminalapi.Mouse, meta *widgetapi.EventMeta) error {
-	return errors.New("the HeatMap widget doesn't support mouse events")
+// clampCell returns the provided column (X) and row (Y) adjusted so that it
+// falls onto a cell. Returns the first cell if there are no values.
+func (hp *HeatMap) clampCell(p image.Point) image.Point {
+	clamp := func(v, n int) int {
+		if v >= n {
+			v = n - 1
+		}
+		if v < 0 {
+			v = 0
+		}
+		return v
+	}
+	return image.Point{clamp(p.X, hp.columns()), clamp(p.Y, hp.rows())}
+}
+
+// Keyboard moves the selected cell with the arrow keys when the
+// EnableInspection option is set, keyboard input isn't supported otherwise.
+// Implements widgetapi.Widget.Keyboard.
+func (hp *HeatMap) Keyboard(k *terminalapi.Keyboard, meta *widgetapi.EventMeta) error {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+
+	if !hp.opts.inspect {
+		return errors.New("the HeatMap widget doesn't support keyboard events unless the EnableInspection option is set")
+	}
+
+	sel := hp.selected
+	switch k.Key {
+	case keyboard.KeyArrowLeft:
+		sel.X--
+	case keyboard.KeyArrowRight:
+		sel.X++
+	case keyboard.KeyArrowUp:
+		sel.Y--
+	case keyboard.KeyArrowDown:
+		sel.Y++
+	}
+	hp.selected = hp.clampCell(sel)
+	return nil
+}
+
+// Mouse tracks the cell under the mouse cursor when the EnableHover option
+// is set, mouse input isn't supported otherwise.
+// Implements widgetapi.Widget.Mouse.
+func (hp *HeatMap) Mouse(m *terminalapi.Mouse, meta *widgetapi.EventMeta) error {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+
+	if !hp.opts.hover {
+		return errors.New("the HeatMap widget doesn't support mouse events unless the EnableHover option is set")
+	}
+	hp.hovered = hp.cellAt(m.Position)
+	return nil
 }
 
 // Options implements widgetapi.Widget.Options.
 func (hp *HeatMap) Options() widgetapi.Options {
 	hp.mu.Lock()
 	defer hp.mu.Unlock()
-	return widgetapi.Options{}
+	wantKeyboard := widgetapi.KeyScopeNone
+	if hp.opts.inspect {
+		wantKeyboard = widgetapi.KeyScopeFocused
+	}
+	wantMouse := widgetapi.MouseScopeNone
+	if hp.opts.hover {
+		// Events outside of the canvas are needed to detect that the mouse
+		// left the widget, their position is reset to image.Point{-1, -1}.
+		wantMouse = widgetapi.MouseScopeGlobal
+	}
+	return widgetapi.Options{
+		MinimumSize:  hp.minSize(),
+		WantKeyboard: wantKeyboard,
+		WantMouse:    wantMouse,
+	}
+}
+
+// Xterm color numbers at the endpoints of the color scale.
+const (
+	// lightestColorNumber is used for the minimum value.
+	lightestColorNumber = 255
+	// darkestColorNumber is used for the maximum value.
+	darkestColorNumber = 232
+)
+
+// scale returns the minimum and maximum value of the color scale.
+func (hp *HeatMap) scale() (float64, float64) {
+	if sr := hp.opts.scaleRange; sr != nil {
+		return sr.min, sr.max
+	}
+	return hp.minValue, hp.maxValue
 }
 
 // getCellColor returns the color of the cell according to its value.
 // The larger the value, the darker the color.
 // The color range is in Xterm color, from 232 to 255.
 // Refer to https://jonasjacek.github.io/colors/.
+// Values outside of the scale are clamped to its endpoints.
+// Missing values are drawn in the color set by the MissingColor option.
 func (hp *HeatMap) getCellColor(value float64) cell.Color {
-	return cell.ColorDefault
+	if math.IsNaN(value) {
+		return hp.opts.missingColor
+	}
+
+	min, max := hp.scale()
+	switch {
+	case value <= min || min >= max:
+		return cell.ColorNumber(lightestColorNumber)
+	case value >= max:
+		return cell.ColorNumber(darkestColorNumber)
+	}
+
+	steps := float64(lightestColorNumber - darkestColorNumber)
+	step := int(math.Round((value - min) / (max - min) * steps))
+	return cell.ColorNumber(lightestColorNumber - step)
+}
+
+// contrastColor returns the text color readable on a cell with the provided
+// background color. That is black on the light cells and white on the dark
+// ones and on backgrounds of unknown brightness, e.g. the default color.
+func contrastColor(bg cell.Color) cell.Color {
+	if l, ok := cell.Luminance(bg); ok && l >= 0.5 {
+		return cell.ColorBlack
+	}
+	return cell.ColorWhite
 }
@@ -13,3 +13,1259 @@
 // limitations under the License.
 
 package heatmap
+
+import (
+	"image"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/woodliu/termdash/cell"
+	"github.com/woodliu/termdash/keyboard"
+	"github.com/woodliu/termdash/mouse"
+	"github.com/woodliu/termdash/private/canvas"
+	"github.com/woodliu/termdash/private/canvas/testcanvas"
+	"github.com/woodliu/termdash/private/draw"
+	"github.com/woodliu/termdash/private/draw/testdraw"
+	"github.com/woodliu/termdash/private/faketerm"
+	"github.com/woodliu/termdash/terminal/terminalapi"
+	"github.com/woodliu/termdash/widgetapi"
+)
+
+// valuesCall contains arguments for a call to HeatMap.Values.
+type valuesCall struct {
+	xLabels []string
+	yLabels []string
+	values  [][]float64
+}
+
+func TestHeatMap(t *testing.T) {
+	tests := []struct {
+		desc        string
+		opts        []Option
+		values      *valuesCall // if set, the test case calls HeatMap.Values.
+		clearX      bool        // if set, the test case calls HeatMap.ClearXLabels.
+		keyboard    []*terminalapi.Keyboard
+		mouse       []*terminalapi.Mouse
+		canvas      image.Rectangle
+		want        func(size image.Point) *faketerm.Terminal
+		wantDrawErr bool
+	}{
+		{
+			desc:   "draws nothing without values",
+			canvas: image.Rect(0, 0, 10, 4),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+		},
+		{
+			desc: "draws resize needed when the canvas is too small",
+			values: &valuesCall{
+				values: [][]float64{{0, 1}, {2, 3}},
+			},
+			canvas: image.Rect(0, 0, 6, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustResizeNeeded(c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "draws cells and labels",
+			values: &valuesCall{
+				xLabels: []string{"x", "y"},
+				yLabels: []string{"a", "bb"},
+				values:  [][]float64{{0, 1}, {2, 3}},
+			},
+			canvas: image.Rect(0, 0, 10, 4),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(3, 0, 6, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustRectangle(c, image.Rect(6, 0, 9, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(247))))
+				testdraw.MustRectangle(c, image.Rect(3, 1, 6, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(240))))
+				testdraw.MustRectangle(c, image.Rect(6, 1, 9, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(232))))
+				testdraw.MustText(c, "a", image.Point{1, 0})
+				testdraw.MustText(c, "bb", image.Point{0, 1})
+				testdraw.MustText(c, "x", image.Point{4, 2})
+				testdraw.MustText(c, "y", image.Point{7, 2})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "draws the row and column summaries",
+			opts: []Option{
+				CellWidth(4),
+				ShowRowSummary(AggAvg),
+				ShowColumnSummary(AggSum),
+			},
+			values: &valuesCall{
+				xLabels: []string{"x", "y"},
+				yLabels: []string{"a", "bb"},
+				values:  [][]float64{{0, 1}, {2, 3}},
+			},
+			canvas: image.Rect(0, 0, 16, 4),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(4, 0, 8, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustRectangle(c, image.Rect(8, 0, 12, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(247))))
+				testdraw.MustRectangle(c, image.Rect(4, 1, 8, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(240))))
+				testdraw.MustRectangle(c, image.Rect(8, 1, 12, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(232))))
+
+				// Row averages.
+				testdraw.MustRectangle(c, image.Rect(12, 0, 16, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(251))))
+				testdraw.MustRectangle(c, image.Rect(12, 1, 16, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(236))))
+				// Column sums.
+				testdraw.MustRectangle(c, image.Rect(4, 2, 8, 3), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(240))))
+				testdraw.MustRectangle(c, image.Rect(8, 2, 12, 3), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(232))))
+
+				testdraw.MustText(c, "a", image.Point{2, 0})
+				testdraw.MustText(c, "bb", image.Point{1, 1})
+				testdraw.MustText(c, "sum", image.Point{0, 2})
+				testdraw.MustText(c, "x", image.Point{5, 3})
+				testdraw.MustText(c, "y", image.Point{9, 3})
+				testdraw.MustText(c, "avg", image.Point{12, 3})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "draws summaries of missing values as missing",
+			opts: []Option{
+				ShowColumnSummary(AggAvg),
+			},
+			values: &valuesCall{
+				xLabels: []string{"x", "y"},
+				yLabels: []string{"a", "b"},
+				values:  [][]float64{{0, math.NaN()}, {2, math.NaN()}},
+			},
+			canvas: image.Rect(0, 0, 10, 4),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				missing := cell.ColorNumber(244)
+				testdraw.MustRectangle(c, image.Rect(4, 0, 7, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustRectangle(c, image.Rect(7, 0, 10, 1), draw.RectCellOpts(cell.BgColor(missing)))
+				testcanvas.MustSetCell(c, image.Point{8, 0}, '·', cell.FgColor(cell.ColorBlack), cell.BgColor(missing))
+				testdraw.MustRectangle(c, image.Rect(4, 1, 7, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(232))))
+				testdraw.MustRectangle(c, image.Rect(7, 1, 10, 2), draw.RectCellOpts(cell.BgColor(missing)))
+				testcanvas.MustSetCell(c, image.Point{8, 1}, '·', cell.FgColor(cell.ColorBlack), cell.BgColor(missing))
+
+				// Column averages.
+				testdraw.MustRectangle(c, image.Rect(4, 2, 7, 3), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(243))))
+				testdraw.MustRectangle(c, image.Rect(7, 2, 10, 3), draw.RectCellOpts(cell.BgColor(missing)))
+				testcanvas.MustSetCell(c, image.Point{8, 2}, '·', cell.FgColor(cell.ColorBlack), cell.BgColor(missing))
+
+				testdraw.MustText(c, "a", image.Point{2, 0})
+				testdraw.MustText(c, "b", image.Point{2, 1})
+				testdraw.MustText(c, "avg", image.Point{0, 2})
+				testdraw.MustText(c, "x", image.Point{5, 3})
+				testdraw.MustText(c, "y", image.Point{8, 3})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "draws the axis titles",
+			opts: []Option{
+				XAxisTitle("time"),
+				YAxisTitle("y"),
+			},
+			values: &valuesCall{
+				xLabels: []string{"x", "y"},
+				yLabels: []string{"a", "bb"},
+				values:  [][]float64{{0, 1}, {2, 3}},
+			},
+			canvas: image.Rect(0, 0, 12, 4),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(5, 0, 8, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustRectangle(c, image.Rect(8, 0, 11, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(247))))
+				testdraw.MustRectangle(c, image.Rect(5, 1, 8, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(240))))
+				testdraw.MustRectangle(c, image.Rect(8, 1, 11, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(232))))
+				testdraw.MustText(c, "y", image.Point{0, 0})
+				testdraw.MustText(c, "a", image.Point{3, 0})
+				testdraw.MustText(c, "bb", image.Point{2, 1})
+				testdraw.MustText(c, "x", image.Point{6, 2})
+				testdraw.MustText(c, "y", image.Point{9, 2})
+				testdraw.MustText(c, "time", image.Point{6, 3})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "axis titles that don't fit are trimmed",
+			opts: []Option{
+				XAxisTitle("temperature"),
+				YAxisTitle("val"),
+			},
+			values: &valuesCall{
+				yLabels: []string{"a"},
+				values:  [][]float64{{1}},
+			},
+			canvas: image.Rect(0, 0, 8, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(4, 0, 7, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustText(c, "…", image.Point{0, 0})
+				testdraw.MustText(c, "a", image.Point{2, 0})
+				testdraw.MustText(c, "0", image.Point{5, 1})
+				testdraw.MustText(c, "tem…", image.Point{4, 2})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "axis titles draw resize needed when there is no space for them",
+			opts: []Option{
+				XAxisTitle("x"),
+				YAxisTitle("y"),
+			},
+			values: &valuesCall{
+				values: [][]float64{{0, 1}, {2, 3}},
+			},
+			canvas: image.Rect(0, 0, 12, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustResizeNeeded(c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "draws the values in contrasting colors",
+			opts: []Option{ShowValues("%.0f")},
+			values: &valuesCall{
+				xLabels: []string{"x", "y"},
+				yLabels: []string{"a", "bb"},
+				values:  [][]float64{{0, 1}, {2, 3}},
+			},
+			canvas: image.Rect(0, 0, 10, 4),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(3, 0, 6, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustRectangle(c, image.Rect(6, 0, 9, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(247))))
+				testdraw.MustRectangle(c, image.Rect(3, 1, 6, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(240))))
+				testdraw.MustRectangle(c, image.Rect(6, 1, 9, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(232))))
+				testdraw.MustText(c, "0", image.Point{4, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorBlack), cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustText(c, "1", image.Point{7, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorBlack), cell.BgColor(cell.ColorNumber(247))))
+				testdraw.MustText(c, "2", image.Point{4, 1}, draw.TextCellOpts(cell.FgColor(cell.ColorWhite), cell.BgColor(cell.ColorNumber(240))))
+				testdraw.MustText(c, "3", image.Point{7, 1}, draw.TextCellOpts(cell.FgColor(cell.ColorWhite), cell.BgColor(cell.ColorNumber(232))))
+				testdraw.MustText(c, "a", image.Point{1, 0})
+				testdraw.MustText(c, "bb", image.Point{0, 1})
+				testdraw.MustText(c, "x", image.Point{4, 2})
+				testdraw.MustText(c, "y", image.Point{7, 2})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "skips values that don't fit into the cells",
+			opts: []Option{ShowValues("%.1f")},
+			values: &valuesCall{
+				xLabels: []string{"x", "y"},
+				yLabels: []string{"a"},
+				values:  [][]float64{{1, 10.5}},
+			},
+			canvas: image.Rect(0, 0, 8, 2),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(2, 0, 5, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustRectangle(c, image.Rect(5, 0, 8, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(232))))
+				testdraw.MustText(c, "1.0", image.Point{2, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorBlack), cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustText(c, "a", image.Point{0, 0})
+				testdraw.MustText(c, "x", image.Point{3, 1})
+				testdraw.MustText(c, "y", image.Point{6, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "labels wider than the cells are spread out",
+			values: &valuesCall{
+				xLabels: []string{"10:00", "11:00", "12:00", "13:00"},
+				yLabels: []string{"a"},
+				values:  [][]float64{{0, 0, 0, 0}},
+			},
+			canvas: image.Rect(0, 0, 14, 2),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(2, 0, 14, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustText(c, "a", image.Point{0, 0})
+				testdraw.MustText(c, "11:00", image.Point{4, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "draws with cell options and custom cell width",
+			opts: []Option{
+				CellWidth(1),
+				XLabelCellOpts(cell.FgColor(cell.ColorRed)),
+				YLabelCellOpts(cell.FgColor(cell.ColorBlue)),
+			},
+			values: &valuesCall{
+				xLabels: []string{"x"},
+				yLabels: []string{"a"},
+				values:  [][]float64{{1}},
+			},
+			canvas: image.Rect(0, 0, 3, 2),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(2, 0, 3, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustText(c, "a", image.Point{0, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorBlue)))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "hover draws resize needed when there is no row for the value",
+			opts: []Option{EnableHover()},
+			values: &valuesCall{
+				values: [][]float64{{0, 1}, {2, 3}},
+			},
+			canvas: image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustResizeNeeded(c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "hover draws the value of the cell under the mouse",
+			opts: []Option{EnableHover()},
+			values: &valuesCall{
+				xLabels: []string{"x", "y"},
+				yLabels: []string{"a", "bb"},
+				values:  [][]float64{{0, 1.5}, {2, 3}},
+			},
+			mouse: []*terminalapi.Mouse{
+				{Position: image.Point{8, 0}, Button: mouse.ButtonRelease},
+			},
+			canvas: image.Rect(0, 0, 10, 4),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(3, 0, 6, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustRectangle(c, image.Rect(6, 0, 9, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(243))))
+				testdraw.MustRectangle(c, image.Rect(3, 1, 6, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(240))))
+				testdraw.MustRectangle(c, image.Rect(6, 1, 9, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(232))))
+				testdraw.MustText(c, "a", image.Point{1, 0})
+				testdraw.MustText(c, "bb", image.Point{0, 1})
+				testdraw.MustText(c, "x", image.Point{4, 2})
+				testdraw.MustText(c, "y", image.Point{7, 2})
+				testdraw.MustText(c, "1.5", image.Point{0, 3})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "hover draws nothing when the mouse leaves the cells",
+			opts: []Option{EnableHover()},
+			values: &valuesCall{
+				yLabels: []string{"a"},
+				values:  [][]float64{{1}},
+			},
+			mouse: []*terminalapi.Mouse{
+				{Position: image.Point{2, 0}, Button: mouse.ButtonRelease},
+				{Position: image.Point{1, 0}, Button: mouse.ButtonRelease},
+			},
+			canvas: image.Rect(0, 0, 5, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(2, 0, 5, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustText(c, "a", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{3, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "hover draws nothing when the mouse moves off the widget",
+			opts: []Option{EnableHover()},
+			values: &valuesCall{
+				yLabels: []string{"a"},
+				values:  [][]float64{{1}},
+			},
+			mouse: []*terminalapi.Mouse{
+				{Position: image.Point{2, 0}, Button: mouse.ButtonRelease},
+				// The position of events outside of the canvas.
+				{Position: image.Point{-1, -1}, Button: mouse.ButtonRelease},
+			},
+			canvas: image.Rect(0, 0, 5, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(2, 0, 5, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustText(c, "a", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{3, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "inspection outlines the first cell and draws its value",
+			opts: []Option{EnableInspection()},
+			values: &valuesCall{
+				xLabels: []string{"x", "y"},
+				yLabels: []string{"a", "bb"},
+				values:  [][]float64{{0, 1.5}, {2, 3}},
+			},
+			canvas: image.Rect(0, 0, 10, 4),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(3, 0, 6, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustRectangle(c, image.Rect(6, 0, 9, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(243))))
+				testdraw.MustRectangle(c, image.Rect(3, 1, 6, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(240))))
+				testdraw.MustRectangle(c, image.Rect(6, 1, 9, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(232))))
+				selOpts := draw.TextCellOpts(cell.FgColor(cell.ColorBlack), cell.BgColor(cell.ColorNumber(255)))
+				testdraw.MustText(c, "[", image.Point{3, 0}, selOpts)
+				testdraw.MustText(c, "]", image.Point{5, 0}, selOpts)
+				testdraw.MustText(c, "a", image.Point{1, 0})
+				testdraw.MustText(c, "bb", image.Point{0, 1})
+				testdraw.MustText(c, "x", image.Point{4, 2})
+				testdraw.MustText(c, "y", image.Point{7, 2})
+				testdraw.MustText(c, "0", image.Point{0, 3})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "inspection doesn't outline any cell when the rows are empty",
+			opts: []Option{EnableInspection()},
+			values: &valuesCall{
+				values: [][]float64{{}},
+			},
+			keyboard: []*terminalapi.Keyboard{
+				{Key: keyboard.KeyArrowRight},
+				{Key: keyboard.KeyArrowDown},
+			},
+			canvas: image.Rect(0, 0, 10, 4),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "0", image.Point{0, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "inspection moves the selection with arrow keys and clamps it",
+			opts: []Option{EnableInspection()},
+			values: &valuesCall{
+				xLabels: []string{"x", "y"},
+				yLabels: []string{"a", "bb"},
+				values:  [][]float64{{0, 1.5}, {2, 3}},
+			},
+			keyboard: []*terminalapi.Keyboard{
+				{Key: keyboard.KeyArrowRight},
+				{Key: keyboard.KeyArrowRight},
+				{Key: keyboard.KeyArrowDown},
+				{Key: keyboard.KeyArrowDown},
+				{Key: keyboard.KeyArrowUp},
+				{Key: keyboard.KeyArrowUp},
+				{Key: keyboard.KeyArrowDown},
+				{Key: 'q'},
+			},
+			canvas: image.Rect(0, 0, 10, 4),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(3, 0, 6, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustRectangle(c, image.Rect(6, 0, 9, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(243))))
+				testdraw.MustRectangle(c, image.Rect(3, 1, 6, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(240))))
+				testdraw.MustRectangle(c, image.Rect(6, 1, 9, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(232))))
+				selOpts := draw.TextCellOpts(cell.FgColor(cell.ColorWhite), cell.BgColor(cell.ColorNumber(232)))
+				testdraw.MustText(c, "[", image.Point{6, 1}, selOpts)
+				testdraw.MustText(c, "]", image.Point{8, 1}, selOpts)
+				testdraw.MustText(c, "a", image.Point{1, 0})
+				testdraw.MustText(c, "bb", image.Point{0, 1})
+				testdraw.MustText(c, "x", image.Point{4, 2})
+				testdraw.MustText(c, "y", image.Point{7, 2})
+				testdraw.MustText(c, "3", image.Point{0, 3})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "inspection marks narrow cells and prefers the hovered cell",
+			opts: []Option{
+				CellWidth(1),
+				EnableHover(),
+				EnableInspection(),
+			},
+			values: &valuesCall{
+				yLabels: []string{"a"},
+				values:  [][]float64{{0, 1.5}},
+			},
+			mouse: []*terminalapi.Mouse{
+				{Position: image.Point{3, 0}, Button: mouse.ButtonRelease},
+			},
+			canvas: image.Rect(0, 0, 5, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(2, 0, 3, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustRectangle(c, image.Rect(3, 0, 4, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(232))))
+				testdraw.MustText(c, "*", image.Point{2, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorBlack), cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustText(c, "a", image.Point{0, 0})
+				testdraw.MustText(c, "1.5", image.Point{0, 2})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "draws the missing value glyph readable on a custom MissingColor",
+			opts: []Option{
+				MissingColor(cell.ColorYellow),
+			},
+			values: &valuesCall{
+				xLabels: []string{"x", "y"},
+				yLabels: []string{"a"},
+				values:  [][]float64{{0, math.NaN()}},
+			},
+			canvas: image.Rect(0, 0, 8, 2),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(2, 0, 5, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustRectangle(c, image.Rect(5, 0, 8, 1), draw.RectCellOpts(cell.BgColor(cell.ColorYellow)))
+				testdraw.MustText(c, "·", image.Point{6, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorBlack), cell.BgColor(cell.ColorYellow)))
+				testdraw.MustText(c, "a", image.Point{0, 0})
+				testdraw.MustText(c, "x", image.Point{3, 1})
+				testdraw.MustText(c, "y", image.Point{6, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "draws missing values with a glyph and excludes them from the scale",
+			opts: []Option{
+				ShowValues("%.0f"),
+				EnableHover(),
+			},
+			values: &valuesCall{
+				xLabels: []string{"x", "y"},
+				yLabels: []string{"a", "b"},
+				values:  [][]float64{{0, math.NaN()}, {2, 4}},
+			},
+			mouse: []*terminalapi.Mouse{
+				{Position: image.Point{5, 0}, Button: mouse.ButtonRelease},
+			},
+			canvas: image.Rect(0, 0, 8, 4),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(2, 0, 5, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustRectangle(c, image.Rect(5, 0, 8, 1), draw.RectCellOpts(cell.BgColor(DefaultMissingColor)))
+				testdraw.MustRectangle(c, image.Rect(2, 1, 5, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(243))))
+				testdraw.MustRectangle(c, image.Rect(5, 1, 8, 2), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(232))))
+				testdraw.MustText(c, "0", image.Point{3, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorBlack), cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustText(c, "·", image.Point{6, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorBlack), cell.BgColor(DefaultMissingColor)))
+				testdraw.MustText(c, "2", image.Point{3, 1}, draw.TextCellOpts(cell.FgColor(cell.ColorWhite), cell.BgColor(cell.ColorNumber(243))))
+				testdraw.MustText(c, "4", image.Point{6, 1}, draw.TextCellOpts(cell.FgColor(cell.ColorWhite), cell.BgColor(cell.ColorNumber(232))))
+				testdraw.MustText(c, "a", image.Point{0, 0})
+				testdraw.MustText(c, "b", image.Point{0, 1})
+				testdraw.MustText(c, "x", image.Point{3, 2})
+				testdraw.MustText(c, "y", image.Point{6, 2})
+				testdraw.MustText(c, "missing", image.Point{0, 3})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "doesn't draw cleared labels",
+			values: &valuesCall{
+				xLabels: []string{"x"},
+				yLabels: []string{"a"},
+				values:  [][]float64{{1}},
+			},
+			clearX: true,
+			canvas: image.Rect(0, 0, 5, 2),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(2, 0, 5, 1), draw.RectCellOpts(cell.BgColor(cell.ColorNumber(255))))
+				testdraw.MustText(c, "a", image.Point{0, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			hp, err := New(tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			if tc.values != nil {
+				if err := hp.Values(tc.values.xLabels, tc.values.yLabels, tc.values.values); err != nil {
+					t.Fatalf("Values => unexpected error: %v", err)
+				}
+			}
+			if tc.clearX {
+				hp.ClearXLabels()
+			}
+			for _, k := range tc.keyboard {
+				if err := hp.Keyboard(k, &widgetapi.EventMeta{}); err != nil {
+					t.Fatalf("Keyboard => unexpected error: %v", err)
+				}
+			}
+			for _, m := range tc.mouse {
+				if err := hp.Mouse(m, &widgetapi.EventMeta{}); err != nil {
+					t.Fatalf("Mouse => unexpected error: %v", err)
+				}
+			}
+
+			c, err := canvas.New(tc.canvas)
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+
+			err = hp.Draw(c, &widgetapi.Meta{})
+			if (err != nil) != tc.wantDrawErr {
+				t.Errorf("Draw => unexpected error: %v, wantDrawErr: %v", err, tc.wantDrawErr)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := faketerm.New(c.Size())
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			if err := c.Apply(got); err != nil {
+				t.Fatalf("Apply => unexpected error: %v", err)
+			}
+			if diff := faketerm.Diff(tc.want(c.Size()), got); diff != "" {
+				t.Errorf("Draw => %v", diff)
+			}
+		})
+	}
+}
+
+func TestOptions(t *testing.T) {
+	tests := []struct {
+		desc string
+		opts []Option
+		want widgetapi.Options
+	}{
+		{
+			desc: "default options",
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{9, 3},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeNone,
+			},
+		},
+		{
+			desc: "requests mouse events when hover is enabled",
+			opts: []Option{EnableHover()},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{9, 4},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeGlobal,
+			},
+		},
+		{
+			desc: "requests keyboard events when inspection is enabled",
+			opts: []Option{EnableInspection()},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{9, 4},
+				WantKeyboard: widgetapi.KeyScopeFocused,
+				WantMouse:    widgetapi.MouseScopeNone,
+			},
+		},
+		{
+			desc: "reserves space for the summaries",
+			opts: []Option{ShowRowSummary(AggSum), ShowColumnSummary(AggSum)},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{13, 4},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeNone,
+			},
+		},
+		{
+			desc: "hover and inspection share the row for the value",
+			opts: []Option{EnableHover(), EnableInspection()},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{9, 4},
+				WantKeyboard: widgetapi.KeyScopeFocused,
+				WantMouse:    widgetapi.MouseScopeGlobal,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			hp, err := New(tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if err := hp.Values(nil, []string{"a", "bb"}, [][]float64{{0, 1}, {2, 3}}); err != nil {
+				t.Fatalf("Values => unexpected error: %v", err)
+			}
+
+			got := hp.Options()
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("Options => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMouse(t *testing.T) {
+	hp, err := New()
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+	if err := hp.Mouse(&terminalapi.Mouse{}, &widgetapi.EventMeta{}); err == nil {
+		t.Errorf("Mouse => got nil err, wanted one when hover isn't enabled")
+	}
+}
+
+func TestKeyboard(t *testing.T) {
+	hp, err := New()
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+	if err := hp.Keyboard(&terminalapi.Keyboard{}, &widgetapi.EventMeta{}); err == nil {
+		t.Errorf("Keyboard => got nil err, wanted one when inspection isn't enabled")
+	}
+}
+
+func TestTimeLabels(t *testing.T) {
+	start := time.Date(2020, time.March, 1, 10, 30, 15, 0, time.UTC)
+	tests := []struct {
+		desc     string
+		interval time.Duration
+		n        int
+		want     []string
+	}{
+		{
+			desc:     "no labels",
+			interval: time.Second,
+			want:     []string{},
+		},
+		{
+			desc:     "sub-second buckets",
+			interval: 250 * time.Millisecond,
+			n:        3,
+			want:     []string{"10:30:15.000", "10:30:15.250", "10:30:15.500"},
+		},
+		{
+			desc:     "second buckets",
+			interval: 30 * time.Second,
+			n:        3,
+			want:     []string{"10:30:15", "10:30:45", "10:31:15"},
+		},
+		{
+			desc:     "just under minute buckets",
+			interval: 59 * time.Second,
+			n:        2,
+			want:     []string{"10:30:15", "10:31:14"},
+		},
+		{
+			desc:     "minute buckets",
+			interval: time.Minute,
+			n:        2,
+			want:     []string{"10:30", "10:31"},
+		},
+		{
+			desc:     "hour buckets",
+			interval: time.Hour,
+			n:        2,
+			want:     []string{"10:30", "11:30"},
+		},
+		{
+			desc:     "day buckets",
+			interval: 24 * time.Hour,
+			n:        2,
+			want:     []string{"2020-03-01", "2020-03-02"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := timeLabels(start, tc.interval, tc.n)
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("timeLabels => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetCellColor(t *testing.T) {
+	tests := []struct {
+		desc     string
+		opts     []Option
+		minValue float64
+		maxValue float64
+		value    float64
+		want     cell.Color
+	}{
+		{
+			desc:     "minimum value is the lightest",
+			minValue: 0,
+			maxValue: 10,
+			value:    0,
+			want:     cell.ColorNumber(255),
+		},
+		{
+			desc:     "maximum value is the darkest",
+			minValue: 0,
+			maxValue: 10,
+			value:    10,
+			want:     cell.ColorNumber(232),
+		},
+		{
+			desc:     "value in the middle of the range",
+			minValue: 0,
+			maxValue: 46,
+			value:    23,
+			want:     cell.ColorNumber(243),
+		},
+		{
+			desc:     "all values equal",
+			minValue: 5,
+			maxValue: 5,
+			value:    5,
+			want:     cell.ColorNumber(255),
+		},
+		{
+			desc:     "uses the scale range instead of the values",
+			opts:     []Option{ScaleRange(0, 23)},
+			minValue: 10,
+			maxValue: 20,
+			value:    20,
+			want:     cell.ColorNumber(235),
+		},
+		{
+			desc:     "clamps values below the scale range",
+			opts:     []Option{ScaleRange(0, 23)},
+			minValue: -10,
+			maxValue: 20,
+			value:    -10,
+			want:     cell.ColorNumber(255),
+		},
+		{
+			desc:     "clamps values above the scale range",
+			opts:     []Option{ScaleRange(0, 23)},
+			minValue: 0,
+			maxValue: 100,
+			value:    100,
+			want:     cell.ColorNumber(232),
+		},
+		{
+			desc:     "missing value uses the default color",
+			minValue: 0,
+			maxValue: 10,
+			value:    math.NaN(),
+			want:     DefaultMissingColor,
+		},
+		{
+			desc:     "missing value uses the custom color",
+			opts:     []Option{MissingColor(cell.ColorBlue)},
+			minValue: 0,
+			maxValue: 10,
+			value:    math.NaN(),
+			want:     cell.ColorBlue,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			hp := &HeatMap{
+				minValue: tc.minValue,
+				maxValue: tc.maxValue,
+				opts:     newOptions(tc.opts...),
+			}
+			if got := hp.getCellColor(tc.value); got != tc.want {
+				t.Errorf("getCellColor(%v) => %v, want %v", tc.value, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContrastColor(t *testing.T) {
+	tests := []struct {
+		desc string
+		bg   cell.Color
+		want cell.Color
+	}{
+		{
+			desc: "white on the default color",
+			bg:   cell.ColorDefault,
+			want: cell.ColorWhite,
+		},
+		{
+			desc: "black on the lightest shade of grey",
+			bg:   cell.ColorNumber(lightestColorNumber),
+			want: cell.ColorBlack,
+		},
+		{
+			desc: "black on the darkest of the light shades of grey",
+			bg:   cell.ColorNumber(244),
+			want: cell.ColorBlack,
+		},
+		{
+			desc: "white on the lightest of the dark shades of grey",
+			bg:   cell.ColorNumber(243),
+			want: cell.ColorWhite,
+		},
+		{
+			desc: "white on the darkest shade of grey",
+			bg:   cell.ColorNumber(darkestColorNumber),
+			want: cell.ColorWhite,
+		},
+		{
+			desc: "black on yellow",
+			bg:   cell.ColorYellow,
+			want: cell.ColorBlack,
+		},
+		{
+			desc: "black on aqua",
+			bg:   cell.ColorAqua,
+			want: cell.ColorBlack,
+		},
+		{
+			desc: "white on blue",
+			bg:   cell.ColorBlue,
+			want: cell.ColorWhite,
+		},
+		{
+			desc: "white on navy",
+			bg:   cell.ColorNavy,
+			want: cell.ColorWhite,
+		},
+		{
+			desc: "black on a light RGB color",
+			bg:   cell.ColorRGB(255, 200, 150),
+			want: cell.ColorBlack,
+		},
+		{
+			desc: "white on a dark RGB color",
+			bg:   cell.ColorRGB(80, 20, 40),
+			want: cell.ColorWhite,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := contrastColor(tc.bg); got != tc.want {
+				t.Errorf("contrastColor(%v) => %v, want %v", tc.bg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestOptionsValidate(t *testing.T) {
+	tests := []struct {
+		desc    string
+		opts    []Option
+		wantErr bool
+	}{
+		{
+			desc: "valid cell width",
+			opts: []Option{CellWidth(1)},
+		},
+		{
+			desc:    "fails on zero cell width",
+			opts:    []Option{CellWidth(0)},
+			wantErr: true,
+		},
+		{
+			desc:    "fails on negative cell width",
+			opts:    []Option{CellWidth(-1)},
+			wantErr: true,
+		},
+		{
+			desc: "valid scale range",
+			opts: []Option{ScaleRange(-1, 1)},
+		},
+		{
+			desc:    "fails when the scale range min equals max",
+			opts:    []Option{ScaleRange(1, 1)},
+			wantErr: true,
+		},
+		{
+			desc:    "fails when the scale range min is larger than max",
+			opts:    []Option{ScaleRange(2, 1)},
+			wantErr: true,
+		},
+		{
+			desc:    "fails when the scale range isn't a number",
+			opts:    []Option{ScaleRange(math.NaN(), 1)},
+			wantErr: true,
+		},
+		{
+			desc: "valid values format",
+			opts: []Option{ShowValues("%.1f")},
+		},
+		{
+			desc:    "fails on an empty values format",
+			opts:    []Option{ShowValues("")},
+			wantErr: true,
+		},
+		{
+			desc: "valid summaries",
+			opts: []Option{ShowRowSummary(AggSum), ShowColumnSummary(AggAvg)},
+		},
+		{
+			desc:    "fails on an invalid row summary aggregation",
+			opts:    []Option{ShowRowSummary(Aggregation(-1))},
+			wantErr: true,
+		},
+		{
+			desc:    "fails on an invalid column summary aggregation",
+			opts:    []Option{ShowColumnSummary(Aggregation(2))},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := newOptions(tc.opts...).validate()
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validate => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValues(t *testing.T) {
+	tests := []struct {
+		desc        string
+		xLabels     []string
+		yLabels     []string
+		values      [][]float64
+		opts        []Option
+		wantXLabels []string
+		wantYLabels []string
+		wantMin     float64
+		wantMax     float64
+		wantErr     bool
+	}{
+		{
+			desc:        "no values",
+			wantXLabels: []string{},
+			wantYLabels: []string{},
+		},
+		{
+			desc:    "fails on ragged rows",
+			values:  [][]float64{{1, 2}, {3}},
+			wantErr: true,
+		},
+		{
+			desc:    "fails when xLabels don't match the row length",
+			xLabels: []string{"a"},
+			values:  [][]float64{{1, 2}},
+			wantErr: true,
+		},
+		{
+			desc:    "fails when yLabels don't match the number of rows",
+			yLabels: []string{"a", "b"},
+			values:  [][]float64{{1, 2}},
+			wantErr: true,
+		},
+		{
+			desc:    "fails on xLabels without values",
+			xLabels: []string{"a"},
+			wantErr: true,
+		},
+		{
+			desc:    "fails on infinite values",
+			values:  [][]float64{{1, math.Inf(1)}},
+			wantErr: true,
+		},
+		{
+			desc:        "missing values are excluded from the minimum and maximum",
+			values:      [][]float64{{math.NaN(), 2}, {5, math.NaN()}},
+			wantXLabels: []string{"0", "1"},
+			wantYLabels: []string{"0", "1"},
+			wantMin:     2,
+			wantMax:     5,
+		},
+		{
+			desc:    "fails on invalid options",
+			values:  [][]float64{{1, 2}},
+			opts:    []Option{ScaleRange(1, 0)},
+			wantErr: true,
+		},
+		{
+			desc:        "defaults the labels",
+			values:      [][]float64{{1, -2, 3}, {4, 5, 6}},
+			wantXLabels: []string{"0", "1", "2"},
+			wantYLabels: []string{"0", "1"},
+			wantMin:     -2,
+			wantMax:     6,
+		},
+		{
+			desc:        "uses the provided labels",
+			xLabels:     []string{"a", ""},
+			yLabels:     []string{"c"},
+			values:      [][]float64{{7, 7}},
+			wantXLabels: []string{"a", ""},
+			wantYLabels: []string{"c"},
+			wantMin:     7,
+			wantMax:     7,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			hp, err := New()
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			err = hp.Values(tc.xLabels, tc.yLabels, tc.values, tc.opts...)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Values => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := pretty.Compare(tc.wantXLabels, hp.xLabels); diff != "" {
+				t.Errorf("Values => unexpected xLabels diff (-want, +got):\n%s", diff)
+			}
+			if diff := pretty.Compare(tc.wantYLabels, hp.yLabels); diff != "" {
+				t.Errorf("Values => unexpected yLabels diff (-want, +got):\n%s", diff)
+			}
+			if hp.minValue != tc.wantMin || hp.maxValue != tc.wantMax {
+				t.Errorf("Values => min %v, max %v, want min %v, max %v", hp.minValue, hp.maxValue, tc.wantMin, tc.wantMax)
+			}
+		})
+	}
+}
+
+func TestValueCapacity(t *testing.T) {
+	tests := []struct {
+		desc    string
+		opts    []Option
+		yLabels []string
+		canvas  image.Rectangle // if not empty, the test case calls Draw.
+		want    int
+	}{
+		{
+			desc: "zero before the first draw",
+			want: 0,
+		},
+		{
+			desc:    "subtracts the Y labels and the X labels row",
+			yLabels: []string{"a", "bb"},
+			canvas:  image.Rect(0, 0, 12, 4),
+			want:    9,
+		},
+		{
+			desc:    "rounds down to whole cells",
+			yLabels: []string{"a", "bb"},
+			canvas:  image.Rect(0, 0, 14, 4),
+			want:    9,
+		},
+		{
+			desc:    "accounts for the cell width",
+			opts:    []Option{CellWidth(1)},
+			yLabels: []string{"a", "bb"},
+			canvas:  image.Rect(0, 0, 14, 4),
+			want:    33,
+		},
+		{
+			desc:    "accounts for wide cells",
+			opts:    []Option{CellWidth(5)},
+			yLabels: []string{"a", "bb"},
+			canvas:  image.Rect(0, 0, 14, 4),
+			want:    6,
+		},
+		{
+			desc:    "subtracts the axis titles",
+			opts:    []Option{XAxisTitle("x"), YAxisTitle("y")},
+			yLabels: []string{"a", "bb"},
+			canvas:  image.Rect(0, 0, 14, 5),
+			want:    9,
+		},
+		{
+			desc:    "subtracts the summaries",
+			opts:    []Option{ShowRowSummary(AggSum), ShowColumnSummary(AggSum)},
+			yLabels: []string{"a", "bb"},
+			canvas:  image.Rect(0, 0, 16, 5),
+			want:    9,
+		},
+		{
+			desc:    "zero when not even one cell fits",
+			yLabels: []string{"a", "bb"},
+			canvas:  image.Rect(0, 0, 5, 4),
+			want:    0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			hp, err := New(tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if len(tc.yLabels) > 0 {
+				values := make([][]float64, len(tc.yLabels))
+				for i := range values {
+					values[i] = []float64{0}
+				}
+				if err := hp.Values(nil, tc.yLabels, values); err != nil {
+					t.Fatalf("Values => unexpected error: %v", err)
+				}
+			}
+
+			if !tc.canvas.Empty() {
+				c, err := canvas.New(tc.canvas)
+				if err != nil {
+					t.Fatalf("canvas.New => unexpected error: %v", err)
+				}
+				if err := hp.Draw(c, &widgetapi.Meta{}); err != nil {
+					t.Fatalf("Draw => unexpected error: %v", err)
+				}
+			}
+
+			if got := hp.ValueCapacity(); got != tc.want {
+				t.Errorf("ValueCapacity => %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
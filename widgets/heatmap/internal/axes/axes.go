@@ -16,7 +16,7 @@
 package axes
 
 import (
-	"errors"
+	"fmt"
 	"image"
 
 	"github.com/woodliu/termdash/private/runewidth"
@@ -51,8 +51,23 @@ func RequiredWidth(ls string) int {
 
 // NewYDetails retrieves details about the Y axis required
 // to draw it on a canvas of the provided area.
+// Each label corresponds to one row of cells, the labels are placed on the
+// left side of the cells.
 func NewYDetails(labels []string) (*YDetails, error) {
-	return nil, errors.New("not implemented")
+	graphHeight := len(labels)
+	labelWidth := LongestString(labels)
+	lbls, err := yLabels(graphHeight, labelWidth, labels)
+	if err != nil {
+		return nil, err
+	}
+
+	width := labelWidth + axisWidth
+	return &YDetails{
+		Width:  width,
+		Start:  image.Point{width - axisWidth, 0},
+		End:    image.Point{width - axisWidth, graphHeight},
+		Labels: lbls,
+	}, nil
 }
 
 // LongestString returns the length of the longest string in the string array.
@@ -82,6 +97,27 @@ type XDetails struct {
 // NewXDetails retrieves details about the X axis required to draw it on a canvas
 // of the provided area.
 // The yEnd is the point where the Y axis ends.
+// Each label corresponds to one column of cells that are cellWidth wide, the
+// labels are placed under the cells.
 func NewXDetails(cvsAr image.Rectangle, yEnd image.Point, labels []string, cellWidth int) (*XDetails, error) {
-	return nil, errors.New("not implemented")
+	if min := 1; cellWidth < min {
+		return nil, fmt.Errorf("cellWidth must be at least %d, got %d", min, cellWidth)
+	}
+
+	start := image.Point{yEnd.X + axisWidth, yEnd.Y}
+	graphWidth := len(labels) * cellWidth
+	end := image.Point{start.X + graphWidth, yEnd.Y}
+	if labelsAr := image.Rect(start.X, start.Y, end.X, end.Y+1); !labelsAr.In(cvsAr) {
+		return nil, fmt.Errorf("the X axis with its labels needs area %v which doesn't fit into the canvas %v", labelsAr, cvsAr)
+	}
+
+	lbls, err := xLabels(yEnd, graphWidth, labels, cellWidth)
+	if err != nil {
+		return nil, err
+	}
+	return &XDetails{
+		Start:  start,
+		End:    end,
+		Labels: lbls,
+	}, nil
 }
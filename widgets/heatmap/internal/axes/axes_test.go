@@ -13,3 +13,143 @@
 // limitations under the License.
 
 package axes
+
+import (
+	"image"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+)
+
+func TestNewYDetails(t *testing.T) {
+	tests := []struct {
+		desc    string
+		labels  []string
+		want    *YDetails
+		wantErr bool
+	}{
+		{
+			desc: "no labels",
+			want: &YDetails{
+				Width: 1,
+				Start: image.Point{0, 0},
+				End:   image.Point{0, 0},
+			},
+		},
+		{
+			desc:   "labels are aligned to the right",
+			labels: []string{"a", "bbb", ""},
+			want: &YDetails{
+				Width: 4,
+				Start: image.Point{3, 0},
+				End:   image.Point{3, 3},
+				Labels: []*Label{
+					{Text: "a", Pos: image.Point{2, 0}},
+					{Text: "bbb", Pos: image.Point{0, 1}},
+					{Text: "", Pos: image.Point{3, 2}},
+				},
+			},
+		},
+		{
+			desc:    "fails on a label with a newline",
+			labels:  []string{"a\nb"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := NewYDetails(tc.labels)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("NewYDetails => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("NewYDetails => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewXDetails(t *testing.T) {
+	tests := []struct {
+		desc      string
+		cvsAr     image.Rectangle
+		yEnd      image.Point
+		labels    []string
+		cellWidth int
+		want      *XDetails
+		wantErr   bool
+	}{
+		{
+			desc:      "fails on zero cell width",
+			cvsAr:     image.Rect(0, 0, 10, 10),
+			labels:    []string{"a"},
+			cellWidth: 0,
+			wantErr:   true,
+		},
+		{
+			desc:      "fails when the labels don't fit into the canvas",
+			cvsAr:     image.Rect(0, 0, 6, 3),
+			yEnd:      image.Point{1, 2},
+			labels:    []string{"a", "b"},
+			cellWidth: 3,
+			wantErr:   true,
+		},
+		{
+			desc:      "fails when there is no row for the labels",
+			cvsAr:     image.Rect(0, 0, 10, 2),
+			yEnd:      image.Point{1, 2},
+			labels:    []string{"a"},
+			cellWidth: 3,
+			wantErr:   true,
+		},
+		{
+			desc:      "each column has a label",
+			cvsAr:     image.Rect(0, 0, 10, 3),
+			yEnd:      image.Point{1, 2},
+			labels:    []string{"a", "b"},
+			cellWidth: 3,
+			want: &XDetails{
+				Start: image.Point{2, 2},
+				End:   image.Point{8, 2},
+				Labels: []*Label{
+					{Text: "a", Pos: image.Point{3, 2}},
+					{Text: "b", Pos: image.Point{6, 2}},
+				},
+			},
+		},
+		{
+			desc:      "wide labels skip columns",
+			cvsAr:     image.Rect(0, 0, 20, 1),
+			yEnd:      image.Point{0, 0},
+			labels:    []string{"aaaa", "bbbb", "cccc", "dddd", "eeee", "ffff"},
+			cellWidth: 2,
+			want: &XDetails{
+				Start: image.Point{1, 0},
+				End:   image.Point{13, 0},
+				Labels: []*Label{
+					{Text: "bbbb", Pos: image.Point{2, 0}},
+					{Text: "eeee", Pos: image.Point{8, 0}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := NewXDetails(tc.cvsAr, tc.yEnd, tc.labels, tc.cellWidth)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("NewXDetails => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("NewXDetails => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,8 +17,11 @@ package axes
 // label.go contains code that calculates the positions of labels on the axes.
 
 import (
-	"errors"
+	"fmt"
 	"image"
+
+	"github.com/woodliu/termdash/align"
+	"github.com/woodliu/termdash/private/alignfor"
 )
 
 // Label is one text label on an axis.
@@ -37,20 +40,70 @@ type Label struct {
 // Labels are returned with Y coordinates in ascending order.
 // Y coordinates grow down.
 func yLabels(graphHeight, labelWidth int, labels []string) ([]*Label, error) {
-	return nil, errors.New("not implemented")
+	if min := 0; labelWidth < min {
+		return nil, fmt.Errorf("labelWidth must be at least %d, got %d", min, labelWidth)
+	}
+
+	var ret []*Label
+	for row, l := range labels {
+		if row >= graphHeight {
+			break
+		}
+		label, err := rowLabel(row, l, labelWidth)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, label)
+	}
+	return ret, nil
 }
 
 // rowLabel returns one label for the specified row.
 // The row is the Y coordinate of the row, Y coordinates grow down.
+// The label is aligned to the right, i.e. next to the cells.
 func rowLabel(row int, label string, labelWidth int) (*Label, error) {
-	return nil, errors.New("not implemented")
+	ar := image.Rect(0, row, labelWidth, row+1)
+	pos, err := alignfor.Text(ar, label, align.HorizontalRight, align.VerticalMiddle)
+	if err != nil {
+		return nil, fmt.Errorf("unable to align the label value: %v", err)
+	}
+	return &Label{
+		Text: label,
+		Pos:  pos,
+	}, nil
 }
 
 // xLabels returns labels that should be placed under the cells.
 // Labels are returned with X coordinates in ascending order.
 // X coordinates grow right.
+// Not every column gets a label if the labels are wider than the cells, see
+// paddedLabelLength.
 func xLabels(yEnd image.Point, graphWidth int, labels []string, cellWidth int) ([]*Label, error) {
-	return nil, errors.New("not implemented")
+	length, index := paddedLabelLength(graphWidth, LongestString(labels), cellWidth)
+	if length == 0 {
+		return nil, nil
+	}
+
+	var ret []*Label
+	startX := yEnd.X + axisWidth
+	columns := length / cellWidth
+	for col := index; col < len(labels); col += columns {
+		x := startX + (col-index)*cellWidth
+		if x+length > startX+graphWidth {
+			break
+		}
+
+		ar := image.Rect(x, yEnd.Y, x+length, yEnd.Y+1)
+		pos, err := alignfor.Text(ar, labels[col], align.HorizontalCenter, align.VerticalMiddle)
+		if err != nil {
+			return nil, fmt.Errorf("unable to align the label value: %v", err)
+		}
+		ret = append(ret, &Label{
+			Text: labels[col],
+			Pos:  pos,
+		})
+	}
+	return ret, nil
 }
 
 // paddedLabelLength calculates the length of the padded X label and
@@ -59,6 +112,17 @@ func xLabels(yEnd image.Point, graphWidth int, labels []string, cellWidth int) (
 // So in order to better display, every three columns of cells will display a X label,
 // the X label belongs to the middle column of the three columns,
 // and the padded length is 3*3 (cellWidth multiplies the number of columns), which is 9.
+// The padded length is always longer than the longest label, so that the
+// labels are separated by at least one cell.
+// Returns zeroes if not even one padded label fits into the graphWidth.
 func paddedLabelLength(graphWidth, longest, cellWidth int) (l, index int) {
-	return
+	if cellWidth <= 0 {
+		return 0, 0
+	}
+	for cols := 1; cols*cellWidth <= graphWidth; cols += 2 {
+		if cols*cellWidth > longest {
+			return cols * cellWidth, cols / 2
+		}
+	}
+	return 0, 0
 }
@@ -13,3 +13,69 @@
 // limitations under the License.
 
 package axes
+
+import "testing"
+
+func TestPaddedLabelLength(t *testing.T) {
+	tests := []struct {
+		desc       string
+		graphWidth int
+		longest    int
+		cellWidth  int
+		wantL      int
+		wantIndex  int
+	}{
+		{
+			desc:       "labels narrower than the cells",
+			graphWidth: 9,
+			longest:    2,
+			cellWidth:  3,
+			wantL:      3,
+			wantIndex:  0,
+		},
+		{
+			desc:       "labels as wide as the cells",
+			graphWidth: 9,
+			longest:    3,
+			cellWidth:  3,
+			wantL:      9,
+			wantIndex:  1,
+		},
+		{
+			desc:       "example from the doc comment",
+			graphWidth: 30,
+			longest:    5,
+			cellWidth:  3,
+			wantL:      9,
+			wantIndex:  1,
+		},
+		{
+			desc:       "very wide labels",
+			graphWidth: 30,
+			longest:    10,
+			cellWidth:  2,
+			wantL:      14,
+			wantIndex:  3,
+		},
+		{
+			desc:       "label doesn't fit",
+			graphWidth: 6,
+			longest:    5,
+			cellWidth:  3,
+		},
+		{
+			desc:       "zero cell width",
+			graphWidth: 6,
+			longest:    5,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			gotL, gotIndex := paddedLabelLength(tc.graphWidth, tc.longest, tc.cellWidth)
+			if gotL != tc.wantL || gotIndex != tc.wantIndex {
+				t.Errorf("paddedLabelLength(%d, %d, %d) => %d, %d, want %d, %d", tc.graphWidth, tc.longest, tc.cellWidth, gotL, gotIndex, tc.wantL, tc.wantIndex)
+			}
+		})
+	}
+}
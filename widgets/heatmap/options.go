@@ -16,6 +16,9 @@ package heatmap
 
 import (
 	"errors"
+	"fmt"
+	"math"
+
 	"github.com/woodliu/termdash/cell"
 )
 
@@ -29,21 +32,55 @@ type Option interface {
 
 // options stores the provided options.
 type options struct {
-	// The default value is 3
 	cellWidth      int
 	xLabelCellOpts []cell.Option
 	yLabelCellOpts []cell.Option
+	scaleRange     *scaleRange
+	hover          bool
+	inspect        bool
+	xAxisTitle     string
+	yAxisTitle     string
+	showValues     bool
+	valuesFormat   string
+	missingColor   cell.Color
+	rowSummary     *Aggregation
+	columnSummary  *Aggregation
 }
 
 // validate validates the provided options.
 func (o *options) validate() error {
-	return errors.New("not implemented")
+	if min := 1; o.cellWidth < min {
+		return fmt.Errorf("invalid CellWidth(%d), must be value in range %d <= value", o.cellWidth, min)
+	}
+	if sr := o.scaleRange; sr != nil {
+		if math.IsNaN(sr.min) || math.IsNaN(sr.max) {
+			return fmt.Errorf("both the min(%v) and the max(%v) provided as ScaleRange must be valid numbers", sr.min, sr.max)
+		}
+		if sr.min >= sr.max {
+			return fmt.Errorf("the min(%v) must be less than the max(%v) provided as ScaleRange", sr.min, sr.max)
+		}
+	}
+	if o.showValues && o.valuesFormat == "" {
+		return errors.New("the format provided to ShowValues cannot be empty")
+	}
+	if agg := o.rowSummary; agg != nil {
+		if _, ok := aggregationNames[*agg]; !ok {
+			return fmt.Errorf("invalid Aggregation(%d) provided to ShowRowSummary", *agg)
+		}
+	}
+	if agg := o.columnSummary; agg != nil {
+		if _, ok := aggregationNames[*agg]; !ok {
+			return fmt.Errorf("invalid Aggregation(%d) provided to ShowColumnSummary", *agg)
+		}
+	}
+	return nil
 }
 
 // newOptions returns a new options instance.
 func newOptions(opts ...Option) *options {
 	opt := &options{
-		cellWidth: 3,
+		cellWidth:    DefaultCellWidth,
+		missingColor: DefaultMissingColor,
 	}
 	for _, o := range opts {
 		o.set(opt)
@@ -59,8 +96,14 @@ func (o option) set(opts *options) {
 	o(opts)
 }
 
+// DefaultCellWidth is the default value for the CellWidth option.
+const DefaultCellWidth = 3
+
 // CellWidth set the width of cells (or grids) in the heat map, not the terminal cell.
-// The default height of each cell (grid) is 1 and the width is 3.
+// The height of each cell (grid) is always 1. Must be a value in the range
+// 1 <= w. Narrow cells fit more columns onto the canvas, but X labels wider
+// than the cells are only displayed under some of the columns.
+// Defaults to DefaultCellWidth.
 func CellWidth(w int) Option {
 	return option(func(opts *options) {
 		opts.cellWidth = w
@@ -80,3 +123,115 @@ func YLabelCellOpts(co ...cell.Option) Option {
 		opts.yLabelCellOpts = co
 	})
 }
+
+// scaleRange is the range of the color scale provided via the ScaleRange
+// option.
+type scaleRange struct {
+	min, max float64
+}
+
+// ScaleRange when provided, the endpoints of the color scale will be the
+// specified minimum and maximum value instead of the minimum and maximum
+// determined from the values. Values outside of the range are drawn with the
+// color of the nearest endpoint. Useful to keep the colors comparable across
+// successive calls to Values with data of different ranges.
+// The min must be less than the max.
+func ScaleRange(min, max float64) Option {
+	return option(func(opts *options) {
+		opts.scaleRange = &scaleRange{
+			min: min,
+			max: max,
+		}
+	})
+}
+
+// EnableHover when provided, the HeatMap requests mouse events and displays
+// the value of the cell under the mouse cursor in a label under the X labels.
+// The label is cleared once the mouse moves off the cells, including outside
+// of the widget. This requires one additional row on the canvas.
+func EnableHover() Option {
+	return option(func(opts *options) {
+		opts.hover = true
+	})
+}
+
+// EnableInspection when provided, the HeatMap requests keyboard events when
+// focused and highlights a selected cell that can be moved with the arrow
+// keys. The value of the selected cell is displayed in a label under the X
+// labels, unless the mouse hovers over another cell when EnableHover is also
+// set. This requires one additional row on the canvas.
+func EnableInspection() Option {
+	return option(func(opts *options) {
+		opts.inspect = true
+	})
+}
+
+// XAxisTitle sets the title of the X axis displayed in a row under the X
+// labels, centered under the cells. The title is trimmed with an ellipsis if it
+// doesn't fit. It is drawn with the cell options set by XLabelCellOpts.
+// This requires one additional row on the canvas.
+func XAxisTitle(title string) Option {
+	return option(func(opts *options) {
+		opts.xAxisTitle = title
+	})
+}
+
+// YAxisTitle sets the title of the Y axis displayed vertically, one rune per
+// row, in a column on the left of the Y labels and centered along the rows of
+// cells. The title is trimmed with an ellipsis if it is longer than the number
+// of rows. It is drawn with the cell options set by YLabelCellOpts.
+// This requires additional columns on the canvas, one for the title and one
+// separating it from the Y labels.
+func YAxisTitle(title string) Option {
+	return option(func(opts *options) {
+		opts.yAxisTitle = title
+	})
+}
+
+// ShowValues instructs the HeatMap to display the value of each cell inside
+// the cell. The values are formatted using fmt.Sprintf with the provided
+// format, e.g. "%.1f", and centered in the cells. The text color is chosen
+// to contrast with the background of the cell.
+// Values that don't fit into the cells aren't displayed, see CellWidth.
+func ShowValues(format string) Option {
+	return option(func(opts *options) {
+		opts.showValues = true
+		opts.valuesFormat = format
+	})
+}
+
+// DefaultMissingColor is the default value for the MissingColor option.
+var DefaultMissingColor = cell.ColorNumber(244)
+
+// MissingColor sets the background color of the cells of missing values, i.e.
+// values provided as math.NaN(). The cells are also marked with a '·' glyph
+// to tell them apart from the cells with values.
+// Defaults to DefaultMissingColor.
+func MissingColor(c cell.Color) Option {
+	return option(func(opts *options) {
+		opts.missingColor = c
+	})
+}
+
+// ShowRowSummary instructs the HeatMap to display an additional column on the
+// right of the cells with the aggregate of the values in each row, e.g. their
+// sum. The summary cells are colored on the same scale as the other cells,
+// so sums larger than the largest value are drawn in the darkest color unless
+// the scale is widened with ScaleRange. Missing values are ignored.
+// The column is labeled with the name of the aggregation, e.g. "sum", and
+// requires additional space on the canvas of one cell per row.
+func ShowRowSummary(agg Aggregation) Option {
+	return option(func(opts *options) {
+		opts.rowSummary = &agg
+	})
+}
+
+// ShowColumnSummary instructs the HeatMap to display an additional row under
+// the cells with the aggregate of the values in each column, e.g. their
+// average. Otherwise behaves like ShowRowSummary.
+// This requires one additional row on the canvas.
+func ShowColumnSummary(agg Aggregation) Option {
+	return option(func(opts *options) {
+		opts.columnSummary = &agg
+	})
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package heatmap
+
+// summary.go contains code that calculates and draws the row and column
+// summaries.
+
+import (
+	"image"
+	"math"
+
+	"github.com/woodliu/termdash/private/canvas"
+	"github.com/woodliu/termdash/widgets/heatmap/internal/axes"
+)
+
+// Aggregation is the function that summarizes a row or a column of values.
+type Aggregation int
+
+// String implements fmt.Stringer()
+func (a Aggregation) String() string {
+	if n, ok := aggregationNames[a]; ok {
+		return n
+	}
+	return "AggregationUnknown"
+}
+
+// aggregationNames maps Aggregation values to human readable names.
+var aggregationNames = map[Aggregation]string{
+	AggSum: "AggSum",
+	AggAvg: "AggAvg",
+}
+
+// aggregationLabels maps Aggregation values to the labels of the summary row
+// or column.
+var aggregationLabels = map[Aggregation]string{
+	AggSum: "sum",
+	AggAvg: "avg",
+}
+
+// Supported aggregations.
+const (
+	// AggSum summarizes the values by their sum.
+	AggSum Aggregation = iota
+
+	// AggAvg summarizes the values by their average.
+	AggAvg
+)
+
+// aggregate returns the aggregation of the values, ignoring missing values.
+// Returns math.NaN() if all the values are missing.
+func aggregate(agg Aggregation, values []float64) float64 {
+	var (
+		sum   float64
+		count int
+	)
+	for _, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		sum += v
+		count++
+	}
+
+	if count == 0 {
+		return math.NaN()
+	}
+	if agg == AggAvg {
+		return sum / float64(count)
+	}
+	return sum
+}
+
+// summaryRows returns the number of rows reserved for the column summary.
+func (hp *HeatMap) summaryRows() int {
+	if hp.opts.columnSummary == nil {
+		return 0
+	}
+	return 1
+}
+
+// summaryColumns returns the number of columns reserved for the row summary.
+func (hp *HeatMap) summaryColumns() int {
+	if hp.opts.rowSummary == nil {
+		return 0
+	}
+	return 1
+}
+
+// withSummaryLabel returns a copy of the labels with the label of the
+// aggregation appended. Returns the labels unchanged if agg is nil.
+func withSummaryLabel(labels []string, agg *Aggregation) []string {
+	if agg == nil {
+		return labels
+	}
+	res := make([]string, len(labels), len(labels)+1)
+	copy(res, labels)
+	return append(res, aggregationLabels[*agg])
+}
+
+// displayedXLabels returns the labels of the columns of cells, including the
+// column with the row summary.
+func (hp *HeatMap) displayedXLabels() []string {
+	return withSummaryLabel(labelsFor(hp.xLabels, hp.columns()), hp.opts.rowSummary)
+}
+
+// displayedYLabels returns the labels of the rows of cells, including the row
+// with the column summary.
+func (hp *HeatMap) displayedYLabels() []string {
+	return withSummaryLabel(labelsFor(hp.yLabels, hp.rows()), hp.opts.columnSummary)
+}
+
+// drawSummaries draws the column with the row summary on the right of the
+// cells and the row with the column summary under the cells. The summaries
+// use the same color scale as the cells.
+func (hp *HeatMap) drawSummaries(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.YDetails) error {
+	cw := hp.opts.cellWidth
+	if agg := hp.opts.rowSummary; agg != nil {
+		x := xd.Start.X + hp.columns()*cw
+		for row, rowValues := range hp.values {
+			y := yd.Start.Y + row
+			cellAr := image.Rect(x, y, x+cw, y+1)
+			if err := hp.drawCell(cvs, cellAr, aggregate(*agg, rowValues)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if agg := hp.opts.columnSummary; agg != nil {
+		y := yd.Start.Y + hp.rows()
+		colValues := make([]float64, hp.rows())
+		for col := 0; col < hp.columns(); col++ {
+			for row, rowValues := range hp.values {
+				colValues[row] = rowValues[col]
+			}
+			x := xd.Start.X + col*cw
+			cellAr := image.Rect(x, y, x+cw, y+1)
+			if err := hp.drawCell(cvs, cellAr, aggregate(*agg, colValues)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package heatmap
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAggregate(t *testing.T) {
+	tests := []struct {
+		desc   string
+		agg    Aggregation
+		values []float64
+		want   float64
+	}{
+		{
+			desc:   "sum",
+			agg:    AggSum,
+			values: []float64{1, 2, 3.5},
+			want:   6.5,
+		},
+		{
+			desc:   "average",
+			agg:    AggAvg,
+			values: []float64{1, 2, 6},
+			want:   3,
+		},
+		{
+			desc:   "ignores missing values",
+			agg:    AggAvg,
+			values: []float64{1, math.NaN(), 3},
+			want:   2,
+		},
+		{
+			desc:   "missing when all the values are missing",
+			agg:    AggSum,
+			values: []float64{math.NaN(), math.NaN()},
+			want:   math.NaN(),
+		},
+		{
+			desc: "missing without values",
+			agg:  AggAvg,
+			want: math.NaN(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := aggregate(tc.agg, tc.values)
+			if math.IsNaN(tc.want) {
+				if !math.IsNaN(got) {
+					t.Errorf("aggregate => %v, want NaN", got)
+				}
+				return
+			}
+			if got != tc.want {
+				t.Errorf("aggregate => %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAggregationString(t *testing.T) {
+	tests := []struct {
+		agg  Aggregation
+		want string
+	}{
+		{AggSum, "AggSum"},
+		{AggAvg, "AggAvg"},
+		{Aggregation(-1), "AggregationUnknown"},
+	}
+
+	for _, tc := range tests {
+		if got := tc.agg.String(); got != tc.want {
+			t.Errorf("String(%d) => %q, want %q", tc.agg, got, tc.want)
+		}
+	}
+}
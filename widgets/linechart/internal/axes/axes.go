@@ -84,7 +84,11 @@ func NewYDetails(cvsAr image.Rectangle, yp *YProperties) (*YDetails, error) {
 	cvsWidth := cvsAr.Dx()
 	cvsHeight := cvsAr.Dy()
 	maxWidth := cvsWidth - 1 // Reserve one column for the line chart itself.
-	if req := RequiredWidth(yp.Min, yp.Max); maxWidth < req {
+	min, max := yp.Min, yp.Max
+	if yp.ScaleMode == YScaleModeLog {
+		min, max = LogBounds(min, max)
+	}
+	if req := RequiredWidth(min, max); maxWidth < req {
 		return nil, fmt.Errorf("the available maxWidth %d is smaller than the reported required width %d", maxWidth, req)
 	}
 
@@ -126,6 +130,51 @@ func NewYDetails(cvsAr image.Rectangle, yp *YProperties) (*YDetails, error) {
 	}, nil
 }
 
+// NewRightYDetails is like NewYDetails, but retrieves details about a
+// secondary Y axis placed on the right side of the canvas with its labels to
+// the right of the axis. The axis takes as much width as its widest label
+// requires.
+func NewRightYDetails(cvsAr image.Rectangle, yp *YProperties) (*YDetails, error) {
+	cvsWidth := cvsAr.Dx()
+	cvsHeight := cvsAr.Dy()
+	maxWidth := cvsWidth - 1 // Reserve one column for the line chart itself.
+	min, max := yp.Min, yp.Max
+	if yp.ScaleMode == YScaleModeLog {
+		min, max = LogBounds(min, max)
+	}
+	if req := RequiredWidth(min, max); maxWidth < req {
+		return nil, fmt.Errorf("the available maxWidth %d is smaller than the reported required width %d", maxWidth, req)
+	}
+
+	graphHeight := cvsHeight - yp.ReqXHeight
+	scale, err := NewYScale(yp.Min, yp.Max, graphHeight, nonZeroDecimals, yp.ScaleMode, yp.ValueFormatter)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := yLabels(scale, maxWidth-axisWidth)
+	if err != nil {
+		return nil, err
+	}
+	width := longestLabel(labels) + axisWidth
+	if width > maxWidth {
+		width = maxWidth
+	}
+
+	axisX := cvsAr.Max.X - width
+	for _, l := range labels {
+		// Labels are aligned to the left, right next to the axis.
+		l.Pos.X = axisX + axisWidth
+	}
+	return &YDetails{
+		Width:  width,
+		Start:  image.Point{axisX, 0},
+		End:    image.Point{axisX, graphHeight},
+		Scale:  scale,
+		Labels: labels,
+	}, nil
+}
+
 // longestLabel returns the width of the widest label.
 func longestLabel(labels []*Label) int {
 	var widest int
@@ -241,6 +241,80 @@ func TestY(t *testing.T) {
 	}
 }
 
+func TestRightY(t *testing.T) {
+	tests := []struct {
+		desc    string
+		yp      *YProperties
+		cvsAr   image.Rectangle
+		want    *YDetails
+		wantErr bool
+	}{
+		{
+			desc: "fails on cvsWidth less than required width",
+			yp: &YProperties{
+				Min:        0,
+				Max:        3,
+				ReqXHeight: 2,
+			},
+			cvsAr:   image.Rect(0, 0, 2, 4),
+			wantErr: true,
+		},
+		{
+			desc: "places the axis on the right with labels aligned to its right side",
+			yp: &YProperties{
+				Min:        0,
+				Max:        3,
+				ReqXHeight: 2,
+			},
+			cvsAr: image.Rect(0, 0, 10, 4),
+			want: &YDetails{
+				Width: 5,
+				Start: image.Point{5, 0},
+				End:   image.Point{5, 2},
+				Scale: mustNewYScale(0, 3, 2, nonZeroDecimals, YScaleModeAnchored, nil),
+				Labels: []*Label{
+					{NewValue(0, nonZeroDecimals), image.Point{6, 1}},
+					{NewValue(1.72, nonZeroDecimals), image.Point{6, 0}},
+				},
+			},
+		},
+		{
+			desc: "the axis is placed relative to the right side of the area",
+			yp: &YProperties{
+				Min:        0,
+				Max:        3,
+				ReqXHeight: 2,
+			},
+			cvsAr: image.Rect(4, 0, 10, 4),
+			want: &YDetails{
+				Width: 5,
+				Start: image.Point{5, 0},
+				End:   image.Point{5, 2},
+				Scale: mustNewYScale(0, 3, 2, nonZeroDecimals, YScaleModeAnchored, nil),
+				Labels: []*Label{
+					{NewValue(0, nonZeroDecimals), image.Point{6, 1}},
+					{NewValue(1.72, nonZeroDecimals), image.Point{6, 0}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := NewRightYDetails(tc.cvsAr, tc.yp)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("NewRightYDetails => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("NewRightYDetails => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestNewXDetails(t *testing.T) {
 	tests := []struct {
 		desc    string
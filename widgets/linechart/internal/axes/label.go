@@ -19,9 +19,11 @@ package axes
 import (
 	"fmt"
 	"image"
+	"math"
 
 	"github.com/woodliu/termdash/align"
 	"github.com/woodliu/termdash/private/alignfor"
+	"github.com/woodliu/termdash/private/canvas/braille"
 )
 
 // LabelOrientation represents the orientation of text labels.
@@ -74,6 +76,9 @@ func yLabels(scale *YScale, labelWidth int) ([]*Label, error) {
 	if min := 0; labelWidth < min {
 		return nil, fmt.Errorf("cannot place labels in label area width %d, minimum is %d", labelWidth, min)
 	}
+	if scale.mode == YScaleModeLog {
+		return logYLabels(scale, labelWidth)
+	}
 
 	var labels []*Label
 	const labelSpacing = 4
@@ -102,6 +107,33 @@ func yLabels(scale *YScale, labelWidth int) ([]*Label, error) {
 	return labels, nil
 }
 
+// logYLabels returns labels for a logarithmic Y scale, which are placed at
+// the powers of ten. Only the first of the powers that fall onto the same row
+// gets a label.
+func logYLabels(scale *YScale, labelWidth int) ([]*Label, error) {
+	var labels []*Label
+	seen := map[int]bool{}
+	for e := log10(scale.Min.Value); e <= log10(scale.Max.Value); e++ {
+		v := math.Pow(10, e)
+		pixelY, err := scale.ValueToPixel(v)
+		if err != nil {
+			return nil, err
+		}
+		row := pixelY / braille.RowMult
+		if seen[row] {
+			continue
+		}
+		seen[row] = true
+
+		label, err := valueLabel(yScaleNewValue(v, scale.Min.NonZeroDecimals, scale.valueFormatter), row, labelWidth)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, label)
+	}
+	return labels, nil
+}
+
 // rowLabelArea determines the area available for labels on the specified row.
 // The row is the Y coordinate of the row, Y coordinates grow down.
 func rowLabelArea(row int, labelWidth int) image.Rectangle {
@@ -114,7 +146,11 @@ func rowLabel(scale *YScale, y int, labelWidth int) (*Label, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to determine label value for row %d: %v", y, err)
 	}
+	return valueLabel(v, y, labelWidth)
+}
 
+// valueLabel returns label with the value placed on the specified row.
+func valueLabel(v *Value, y int, labelWidth int) (*Label, error) {
 	ar := rowLabelArea(y, labelWidth)
 	pos, err := alignfor.Text(ar, v.Text(), align.HorizontalRight, align.VerticalMiddle)
 	if err != nil {
@@ -29,6 +29,7 @@ func TestYLabels(t *testing.T) {
 		max         float64
 		graphHeight int
 		labelWidth  int
+		mode        YScaleMode
 		want        []*Label
 		wantErr     bool
 	}{
@@ -125,11 +126,37 @@ func TestYLabels(t *testing.T) {
 				{NewValue(4.16, nonZeroDecimals), image.Point{0, 1}},
 			},
 		},
+		{
+			desc:        "logarithmic scale places labels at powers of ten",
+			min:         1,
+			max:         1000,
+			graphHeight: 7,
+			labelWidth:  4,
+			mode:        YScaleModeLog,
+			want: []*Label{
+				{NewValue(1, nonZeroDecimals), image.Point{3, 6}},
+				{NewValue(10, nonZeroDecimals), image.Point{2, 4}},
+				{NewValue(100, nonZeroDecimals), image.Point{1, 2}},
+				{NewValue(1000, nonZeroDecimals), image.Point{0, 0}},
+			},
+		},
+		{
+			desc:        "logarithmic scale skips powers of ten that fall onto the same row",
+			min:         1,
+			max:         1000,
+			graphHeight: 2,
+			labelWidth:  4,
+			mode:        YScaleModeLog,
+			want: []*Label{
+				{NewValue(1, nonZeroDecimals), image.Point{3, 1}},
+				{NewValue(100, nonZeroDecimals), image.Point{1, 0}},
+			},
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
-			scale, err := NewYScale(tc.min, tc.max, tc.graphHeight, nonZeroDecimals, YScaleModeAnchored, nil)
+			scale, err := NewYScale(tc.min, tc.max, tc.graphHeight, nonZeroDecimals, tc.mode, nil)
 			if err != nil {
 				t.Fatalf("NewYScale => unexpected error: %v", err)
 			}
@@ -23,7 +23,8 @@ import (
 	"github.com/woodliu/termdash/private/canvas/braille"
 )
 
-// YScaleMode determines whether the Y scale is anchored to the zero value.
+// YScaleMode determines whether the Y scale is anchored to the zero value and
+// whether it is linear or logarithmic.
 type YScaleMode int
 
 // String implements fmt.Stringer()
@@ -38,6 +39,7 @@ func (ysm YScaleMode) String() string {
 var yScaleModeNames = map[YScaleMode]string{
 	YScaleModeAnchored: "YScaleModeAnchored",
 	YScaleModeAdaptive: "YScaleModeAdaptive",
+	YScaleModeLog:      "YScaleModeLog",
 }
 
 const (
@@ -50,6 +52,10 @@ const (
 	// I.e. it starts at min for all-positive series and at max for
 	// all-negative series.
 	YScaleModeAdaptive
+
+	// YScaleModeLog is a mode where the Y scale is logarithmic and spans
+	// whole decades, see LogBounds. Only positive values can be displayed.
+	YScaleModeLog
 )
 
 // YScale is the scale of the Y axis.
@@ -67,6 +73,10 @@ type YScale struct {
 	// brailleHeight is the height of the braille canvas based on the GraphHeight.
 	brailleHeight int
 
+	// mode is the mode of the scale. On a logarithmic scale, the Step is the
+	// step in the exponent of the value between pixels.
+	mode YScaleMode
+
 	// valueFormatter is the value formatter used for the labels
 	// represented by the values on the scale.
 	valueFormatter func(float64) string
@@ -112,10 +122,15 @@ func NewYScale(min, max float64, graphHeight, nonZeroDecimals int, mode YScaleMo
 		if max < 0 && min == max {
 			max = 0
 		}
+	case YScaleModeLog:
+		min, max = LogBounds(min, max)
 	default:
 		return nil, fmt.Errorf("unsupported mode: %v(%d)", mode, mode)
 	}
 	diff := max - min
+	if mode == YScaleModeLog {
+		diff = log10(max) - log10(min)
+	}
 	step := NewValue(diff/float64(usablePixels), nonZeroDecimals)
 	return &YScale{
 		Min:            yScaleNewValue(min, nonZeroDecimals, valueFormatter),
@@ -123,10 +138,46 @@ func NewYScale(min, max float64, graphHeight, nonZeroDecimals int, mode YScaleMo
 		Step:           step,
 		GraphHeight:    graphHeight,
 		brailleHeight:  brailleHeight,
+		mode:           mode,
 		valueFormatter: valueFormatter,
 	}, nil
 }
 
+// LogBounds returns the bounds of a logarithmic Y axis that displays values in
+// the range from min to max. The axis spans whole decades, i.e. it starts at
+// the largest power of ten that is less than or equal to min and ends at the
+// smallest power of ten that is greater than or equal to max.
+//
+// Only positive values can be displayed on a logarithmic scale. If min isn't
+// positive, the axis starts one decade below the end. If max isn't positive
+// either, the axis displays values from one to ten.
+func LogBounds(min, max float64) (float64, float64) {
+	if max <= 0 {
+		return 1, 10
+	}
+	if min <= 0 || min > max {
+		min = max
+	}
+
+	lo := math.Pow(10, math.Floor(log10(min)))
+	hi := math.Pow(10, math.Ceil(log10(max)))
+	if lo == hi {
+		lo /= 10
+	}
+	return lo, hi
+}
+
+// log10 returns the decimal logarithm of the value. Results within a rounding
+// error of a whole number are rounded, so that powers of ten have exact
+// exponents.
+func log10(v float64) float64 {
+	l := math.Log10(v)
+	if r := math.Round(l); math.Abs(l-r) < 1e-9 {
+		return r
+	}
+	return l
+}
+
 // PixelToValue given a Y coordinate of the pixel, returns its value according
 // to the scale. The coordinate must be within bounds of the graph height
 // provided to NewYScale. Y coordinates grow down.
@@ -141,6 +192,8 @@ func (ys *YScale) PixelToValue(y int) (float64, error) {
 		return ys.Min.Rounded, nil
 	case pos == ys.brailleHeight-1:
 		return ys.Max.Rounded, nil
+	case ys.mode == YScaleModeLog:
+		return math.Pow(10, log10(ys.Min.Value)+float64(pos)*ys.Step.Value), nil
 	default:
 
 		v := float64(pos) * ys.Step.Rounded
@@ -160,6 +213,14 @@ func (ys *YScale) PixelToValue(y int) (float64, error) {
 // The value must be within the bounds provided to NewYScale. Y coordinates
 // grow down.
 func (ys *YScale) ValueToPixel(v float64) (int, error) {
+	if ys.mode == YScaleModeLog {
+		if v <= 0 {
+			return 0, fmt.Errorf("value %v cannot be displayed on a logarithmic scale, must be positive", v)
+		}
+		pos := int(math.Round((log10(v) - log10(ys.Min.Value)) / ys.Step.Value))
+		return positionToY(pos, ys.brailleHeight)
+	}
+
 	if ys.Step.Rounded == 0 {
 		return 0, nil
 	}
@@ -287,9 +348,14 @@ func (xs *XScale) PixelToValue(x int) (float64, error) {
 // The value must be within the bounds provided to NewXScale. X coordinates
 // grow right.
 func (xs *XScale) ValueToPixel(v int) (int, error) {
-	fv := float64(v)
+	return xs.FloatValueToPixel(float64(v))
+}
+
+// FloatValueToPixel is like ValueToPixel, but accepts values that fall
+// between the integer values on the X axis.
+func (xs *XScale) FloatValueToPixel(fv float64) (int, error) {
 	if min, max := xs.Min.Value, xs.Max.Rounded; fv < min || fv > max {
-		return 0, fmt.Errorf("invalid value %v, must be in range %v <= v <= %v", v, min, max)
+		return 0, fmt.Errorf("invalid value %v, must be in range %v <= v <= %v", fv, min, max)
 	}
 	if xs.Step.Rounded == 0 {
 		return 0, nil
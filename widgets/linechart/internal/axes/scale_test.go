@@ -763,6 +763,28 @@ func TestYScale(t *testing.T) {
 				{0, NewValue(140, 2), false},
 			},
 		},
+		{
+			desc:            "logarithmic scale spans whole decades",
+			min:             0.5,
+			max:             50,
+			graphHeight:     4,
+			nonZeroDecimals: 2,
+			mode:            YScaleModeLog,
+			pixelToValueTests: []pixelToValueTest{
+				{15, 0.1, false},
+				{10, 1, false},
+				{0, 100, false},
+			},
+			valueToPixelTests: []valueToPixelTest{
+				{0.1, 15, false},
+				{1, 10, false},
+				{10, 5, false},
+				{100, 0, false},
+				{0, 0, true},
+				{-1, 0, true},
+				{1000, 0, true},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -822,6 +844,68 @@ func TestYScale(t *testing.T) {
 	}
 }
 
+func TestLogBounds(t *testing.T) {
+	tests := []struct {
+		desc    string
+		min     float64
+		max     float64
+		wantMin float64
+		wantMax float64
+	}{
+		{
+			desc:    "bounds are powers of ten",
+			min:     1,
+			max:     1000,
+			wantMin: 1,
+			wantMax: 1000,
+		},
+		{
+			desc:    "bounds are extended to whole decades",
+			min:     0.5,
+			max:     2500,
+			wantMin: 0.1,
+			wantMax: 10000,
+		},
+		{
+			desc:    "spans at least one decade when min equals max",
+			min:     5,
+			max:     5,
+			wantMin: 1,
+			wantMax: 10,
+		},
+		{
+			desc:    "spans at least one decade when min equals max at a power of ten",
+			min:     100,
+			max:     100,
+			wantMin: 10,
+			wantMax: 100,
+		},
+		{
+			desc:    "min that isn't positive starts one decade below the max",
+			min:     0,
+			max:     50,
+			wantMin: 10,
+			wantMax: 100,
+		},
+		{
+			desc:    "no positive values",
+			min:     -5,
+			max:     -1,
+			wantMin: 1,
+			wantMax: 10,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			gotMin, gotMax := LogBounds(tc.min, tc.max)
+			if gotMin != tc.wantMin || gotMax != tc.wantMax {
+				t.Errorf("LogBounds(%v, %v) => %v, %v, want %v, %v", tc.min, tc.max, gotMin, gotMax, tc.wantMin, tc.wantMax)
+			}
+		})
+	}
+}
+
 func TestXScale(t *testing.T) {
 	tests := []struct {
 		desc              string
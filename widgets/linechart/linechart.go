@@ -43,6 +43,11 @@ type seriesValues struct {
 	min float64
 	// max is the largest value, zero if values is empty.
 	max float64
+	// minPositive is the smallest positive value, zero if there is none.
+	minPositive float64
+	// rightYAxis indicates that the series is plotted against the right Y
+	// axis.
+	rightYAxis bool
 
 	seriesCellOpts []cell.Option
 	// The custom labels provided on a call to Series and a bool indicating if
@@ -58,10 +63,18 @@ func newSeriesValues(values []float64) *seriesValues {
 	copy(v, values)
 
 	min, max := minMax(v)
+	var positive []float64
+	for _, val := range v {
+		if val > 0 {
+			positive = append(positive, val)
+		}
+	}
+	minPositive, _ := minMax(positive)
 	return &seriesValues{
-		values: v,
-		min:    min,
-		max:    max,
+		values:      v,
+		min:         min,
+		max:         max,
+		minPositive: minPositive,
 	}
 }
 
@@ -91,6 +104,8 @@ type LineChart struct {
 
 	// yMin are the min and max values for the Y axis.
 	yMin, yMax float64
+	// rightYMin are the min and max values for the right Y axis.
+	rightYMin, rightYMax float64
 
 	// capacity is the last observed value capacity in pixels when Draw was
 	// called.
@@ -160,20 +175,56 @@ func SeriesXLabels(labels map[int]string) SeriesOption {
 	})
 }
 
-// yMinMax determines the min and max values for the Y axis.
-func (lc *LineChart) yMinMax() (float64, float64) {
+// SeriesRightYAxis plots the series against a secondary Y axis on the right
+// side of the line chart. The right Y axis is scaled independently according
+// to the values of the series bound to it, which is useful when plotting
+// series with different units. The right Y axis is only drawn if at least one
+// series is bound to it. The YAxisCustomScale option only applies to the left
+// Y axis.
+func SeriesRightYAxis() SeriesOption {
+	return seriesOption(func(opts *seriesValues) {
+		opts.rightYAxis = true
+	})
+}
+
+// hasRightYAxis asserts whether any of the series is plotted against the
+// right Y axis.
+// lc.mu must be held when calling this method.
+func (lc *LineChart) hasRightYAxis() bool {
+	for _, sv := range lc.series {
+		if sv.rightYAxis {
+			return true
+		}
+	}
+	return false
+}
+
+// yMinMax determines the min and max values for the left or the right Y axis.
+func (lc *LineChart) yMinMax(right bool) (float64, float64) {
 	var (
 		minimums []float64
 		maximums []float64
 	)
+	logScale := lc.opts.yAxisMode == axes.YScaleModeLog
 	for _, sv := range lc.series {
-		minimums = append(minimums, sv.min)
+		if sv.rightYAxis != right {
+			continue
+		}
+		switch {
+		case !logScale:
+			minimums = append(minimums, sv.min)
+		case sv.minPositive > 0:
+			// A logarithmic scale only displays the positive values.
+			minimums = append(minimums, sv.minPositive)
+		}
 		maximums = append(maximums, sv.max)
 	}
 
-	if lc.opts.yAxisCustomScale != nil {
-		minimums = append(minimums, lc.opts.yAxisCustomScale.min)
-		maximums = append(maximums, lc.opts.yAxisCustomScale.max)
+	if cs := lc.opts.yAxisCustomScale; cs != nil && !right {
+		if !logScale || cs.min > 0 {
+			minimums = append(minimums, cs.min)
+		}
+		maximums = append(maximums, cs.max)
 	}
 
 	min, _ := minMax(minimums)
@@ -226,22 +277,31 @@ func (lc *LineChart) Series(label string, values []float64, opts ...SeriesOption
 	}
 
 	lc.series[label] = series
-	yMin, yMax := lc.yMinMax()
-	lc.yMin = yMin
-	lc.yMax = yMax
+	lc.yMin, lc.yMax = lc.yMinMax(false)
+	lc.rightYMin, lc.rightYMax = lc.yMinMax(true)
 	return nil
 }
 
+// Reset removes all the series from the line chart and resets the zoom.
+// Implements widgetapi.Resettable.
 func (lc *LineChart) Reset() {
 	if lc == nil {
 		return
 	}
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
 	lc.series = make(map[string]*seriesValues)
+	lc.xLabels = nil
+	lc.zoom = nil
+	lc.yMin, lc.yMax = lc.yMinMax(false)
+	lc.rightYMin, lc.rightYMax = lc.yMinMax(true)
 }
 
 // xDetails returns the details for the X axis given the specified minimum and
-// maximum value to display.
-func (lc *LineChart) xDetails(cvs *canvas.Canvas, reqYWidth, min, max int) (*axes.XDetails, error) {
+// maximum value to display. The chartAr is the area of the canvas to the left
+// of the right Y axis, if any.
+func (lc *LineChart) xDetails(chartAr image.Rectangle, reqYWidth, min, max int) (*axes.XDetails, error) {
 	xp := &axes.XProperties{
 		Min:          min,
 		Max:          max,
@@ -249,7 +309,7 @@ func (lc *LineChart) xDetails(cvs *canvas.Canvas, reqYWidth, min, max int) (*axe
 		CustomLabels: lc.xLabels,
 		LO:           lc.opts.xLabelOrientation,
 	}
-	xd, err := axes.NewXDetails(cvs.Area(), xp)
+	xd, err := axes.NewXDetails(chartAr, xp)
 	if err != nil {
 		return nil, fmt.Errorf("NewXDetails => %v", err)
 	}
@@ -271,16 +331,39 @@ func (lc *LineChart) xDetailsForCap(cvs *canvas.Canvas, bc *braille.Canvas, xd *
 	diff := values - lc.capacity
 	xMin := int(xd.Scale.Min.Value) + diff
 	xMax := int(xd.Scale.Max.Value)
-	unscaledXD, err := lc.xDetails(cvs, yd.Start.X, xMin, xMax)
+	unscaledXD, err := lc.xDetails(lc.chartAr(cvs, xd), yd.Start.X, xMin, xMax)
 	if err != nil {
 		return nil, err
 	}
 	return unscaledXD, nil
 }
 
-// axesDetails determines the details about the X and Y axes.
-func (lc *LineChart) axesDetails(cvs *canvas.Canvas) (*axes.XDetails, *axes.YDetails, error) {
+// axesDetails determines the details about the X and Y axes. The details
+// about the right Y axis are nil if no series is plotted against it.
+func (lc *LineChart) axesDetails(cvs *canvas.Canvas) (*axes.XDetails, *axes.YDetails, *axes.YDetails, error) {
 	reqXHeight := axes.RequiredHeight(lc.maxXValue(), lc.xLabels, lc.opts.xLabelOrientation)
+	chartAr := cvs.Area()
+	var ryd *axes.YDetails
+	if lc.hasRightYAxis() {
+		ryp := &axes.YProperties{
+			Min:            lc.rightYMin,
+			Max:            lc.rightYMax,
+			ReqXHeight:     reqXHeight,
+			ScaleMode:      lc.opts.yAxisMode,
+			ValueFormatter: lc.opts.yAxisValueFormatter,
+		}
+		// Leave enough space for the left Y axis.
+		rightAr := cvs.Area()
+		rightAr.Min.X += lc.requiredYWidth(lc.yMin, lc.yMax)
+		d, err := axes.NewRightYDetails(rightAr, ryp)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("NewRightYDetails => %v", err)
+		}
+		ryd = d
+		// The rest of the chart is drawn to the left of the right Y axis.
+		chartAr.Max.X = ryd.Start.X
+	}
+
 	yp := &axes.YProperties{
 		Min:            lc.yMin,
 		Max:            lc.yMax,
@@ -288,18 +371,18 @@ func (lc *LineChart) axesDetails(cvs *canvas.Canvas) (*axes.XDetails, *axes.YDet
 		ScaleMode:      lc.opts.yAxisMode,
 		ValueFormatter: lc.opts.yAxisValueFormatter,
 	}
-	yd, err := axes.NewYDetails(cvs.Area(), yp)
+	yd, err := axes.NewYDetails(chartAr, yp)
 	if err != nil {
-		return nil, nil, fmt.Errorf("NewYDetails => %v", err)
+		return nil, nil, nil, fmt.Errorf("NewYDetails => %v", err)
 	}
 
 	const xMin = 0
 	xMax := lc.maxXValue()
-	xd, err := lc.xDetails(cvs, yd.Start.X, xMin, xMax)
+	xd, err := lc.xDetails(chartAr, yd.Start.X, xMin, xMax)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
-	return xd, yd, nil
+	return xd, yd, ryd, nil
 }
 
 // Draw draws the values as line charts.
@@ -316,24 +399,30 @@ func (lc *LineChart) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 		return draw.ResizeNeeded(cvs)
 	}
 
-	xd, yd, err := lc.axesDetails(cvs)
+	xd, yd, ryd, err := lc.axesDetails(cvs)
 	if err != nil {
 		return err
 	}
 
-	adjXD, err := lc.drawSeries(cvs, xd, yd)
+	adjXD, err := lc.drawSeries(cvs, xd, yd, ryd)
 	if err != nil {
 		return err
 	}
-	return lc.drawAxes(cvs, adjXD, yd)
+	return lc.drawAxes(cvs, adjXD, yd, ryd)
 }
 
 // drawAxes draws the X,Y axes and their labels.
-func (lc *LineChart) drawAxes(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.YDetails) error {
+// The ryd are the details of the right Y axis, nil if it isn't drawn.
+func (lc *LineChart) drawAxes(cvs *canvas.Canvas, xd *axes.XDetails, yd, ryd *axes.YDetails) error {
 	lines := []draw.HVLine{
 		{Start: yd.Start, End: yd.End},
 		{Start: xd.Start, End: xd.End},
 	}
+	if ryd != nil {
+		lines = append(lines, draw.HVLine{Start: ryd.Start, End: ryd.End})
+		// Connect the X axis with the right Y axis.
+		lines[1].End = image.Point{ryd.End.X, xd.End.Y}
+	}
 	if err := draw.HVLines(cvs, lines, draw.HVLineCellOpts(lc.opts.axesCellOpts...)); err != nil {
 		return fmt.Errorf("failed to draw the axes: %v", err)
 	}
@@ -347,6 +436,16 @@ func (lc *LineChart) drawAxes(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.YD
 			return fmt.Errorf("failed to draw the Y labels: %v", err)
 		}
 	}
+	if ryd != nil {
+		for _, l := range ryd.Labels {
+			if err := draw.Text(cvs, l.Value.Text(), l.Pos,
+				draw.TextOverrunMode(draw.OverrunModeThreeDot),
+				draw.TextCellOpts(lc.opts.yLabelCellOpts...),
+			); err != nil {
+				return fmt.Errorf("failed to draw the right Y labels: %v", err)
+			}
+		}
+	}
 
 	for _, l := range xd.Labels {
 		switch lc.opts.xLabelOrientation {
@@ -370,14 +469,23 @@ func (lc *LineChart) drawAxes(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.YD
 // graphAr returns the area available for the graph itself sized so that it
 // fits between the axes and the canvas borders.
 func (lc *LineChart) graphAr(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.YDetails) image.Rectangle {
-	return image.Rect(yd.Start.X+1, yd.Start.Y, cvs.Area().Max.X, xd.End.Y)
+	return image.Rect(yd.Start.X+1, yd.Start.Y, lc.chartAr(cvs, xd).Max.X, xd.End.Y)
+}
+
+// chartAr returns the area of the canvas to the left of the right Y axis,
+// i.e. the entire canvas if there is no right Y axis.
+func (lc *LineChart) chartAr(cvs *canvas.Canvas, xd *axes.XDetails) image.Rectangle {
+	ar := cvs.Area()
+	ar.Max.X = xd.End.X + 1
+	return ar
 }
 
 // drawSeries draws the graph representing the stored series.
 // Returns XDetails that might be adjusted to not start at zero value if some
 // of the series didn't fit the graphs and XAxisUnscaled was provided.
 // If the series has NaN values they will be ignored and not draw on the graph.
-func (lc *LineChart) drawSeries(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.YDetails) (*axes.XDetails, error) {
+// Series bound to the right Y axis are scaled according to the ryd.
+func (lc *LineChart) drawSeries(cvs *canvas.Canvas, xd *axes.XDetails, yd, ryd *axes.YDetails) (*axes.XDetails, error) {
 	graphAr := lc.graphAr(cvs, xd, yd)
 	bc, err := braille.New(graphAr)
 	if err != nil {
@@ -416,6 +524,16 @@ func (lc *LineChart) drawSeries(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.
 		if got := len(sv.values); got <= 1 {
 			continue
 		}
+		scale := yd.Scale
+		if sv.rightYAxis {
+			scale = ryd.Scale
+		}
+		lineOpts := []draw.BrailleLineOption{
+			draw.BrailleLineCellOpts(sv.seriesCellOpts...),
+		}
+		if lc.opts.antiAliased {
+			lineOpts = append(lineOpts, draw.BrailleLineAntiAlias())
+		}
 
 		var prev float64
 		for i := 1; i < len(sv.values); i++ {
@@ -426,6 +544,10 @@ func (lc *LineChart) drawSeries(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.
 			if math.IsNaN(v) || math.IsNaN(prev) {
 				continue
 			}
+			// Skip the values that cannot be displayed on a logarithmic scale.
+			if lc.opts.yAxisMode == axes.YScaleModeLog && (v <= 0 || prev <= 0) {
+				continue
+			}
 
 			if i < int(xdZoomed.Scale.Min.Value)+1 || i > int(xdZoomed.Scale.Max.Value) {
 				// Don't draw lines for values that aren't supposed to be visible.
@@ -445,26 +567,30 @@ func (lc *LineChart) drawSeries(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.
 				return nil, fmt.Errorf("failure for series %v[%d] on scale %v, xdZoomed.Scale.ValueToPixel(%v) => %v", name, i, xdZoomed.Scale, i, err)
 			}
 
-			startY, err := yd.Scale.ValueToPixel(prev)
+			startY, err := scale.ValueToPixel(prev)
 			if err != nil {
-				return nil, fmt.Errorf("failure for series %v[%d] on scale %v, yd.Scale.ValueToPixel(%v) => %v", name, i-1, yd.Scale, prev, err)
+				return nil, fmt.Errorf("failure for series %v[%d] on scale %v, scale.ValueToPixel(%v) => %v", name, i-1, scale, prev, err)
 			}
 
-			endY, err := yd.Scale.ValueToPixel(v)
+			endY, err := scale.ValueToPixel(v)
 			if err != nil {
-				return nil, fmt.Errorf("failure for series %v[%d] on scale %v, yd.Scale.ValueToPixel(%v) => %v", name, i, yd.Scale, v, err)
+				return nil, fmt.Errorf("failure for series %v[%d] on scale %v, scale.ValueToPixel(%v) => %v", name, i, scale, v, err)
 			}
 
 			if err := draw.BrailleLine(bc,
 				image.Point{startX, startY},
 				image.Point{endX, endY},
-				draw.BrailleLineCellOpts(sv.seriesCellOpts...),
+				lineOpts...,
 			); err != nil {
 				return nil, fmt.Errorf("draw.BrailleLine => %v", err)
 			}
 		}
 	}
 
+	if err := lc.drawMarkerLines(bc, xdZoomed, yd, ryd); err != nil {
+		return nil, err
+	}
+
 	if highlight, hRange := lc.zoom.Highlight(); highlight {
 		if err := lc.highlightRange(bc, hRange); err != nil {
 			return nil, err
@@ -474,6 +600,9 @@ func (lc *LineChart) drawSeries(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.
 	if err := bc.CopyTo(cvs); err != nil {
 		return nil, fmt.Errorf("bc.Apply => %v", err)
 	}
+	if err := lc.drawMarkerLabels(cvs, graphAr, xdZoomed, yd, ryd); err != nil {
+		return nil, err
+	}
 	return xdZoomed, nil
 }
 
@@ -505,7 +634,11 @@ func (lc *LineChart) minSize() image.Point {
 	// At the very least we need:
 	// - n cells width for the Y axis and its labels as reported by it.
 	// - at least 1 cell width for the graph.
-	reqWidth := axes.RequiredWidth(lc.yMin, lc.yMax) + 1
+	reqWidth := lc.requiredYWidth(lc.yMin, lc.yMax) + 1
+	if lc.hasRightYAxis() {
+		// - n cells width for the right Y axis and its labels.
+		reqWidth += lc.requiredYWidth(lc.rightYMin, lc.rightYMax)
+	}
 
 	// And for the height:
 	// - n cells width for the X axis and its labels as reported by it.
@@ -514,6 +647,15 @@ func (lc *LineChart) minSize() image.Point {
 	return image.Point{reqWidth, reqHeight}
 }
 
+// requiredYWidth returns the width required for a Y axis and its labels
+// when displaying values with the minimum and maximum.
+func (lc *LineChart) requiredYWidth(yMin, yMax float64) int {
+	if lc.opts.yAxisMode == axes.YScaleModeLog {
+		yMin, yMax = axes.LogBounds(yMin, yMax)
+	}
+	return axes.RequiredWidth(yMin, yMax)
+}
+
 // Options implements widgetapi.Widget.Options.
 func (lc *LineChart) Options() widgetapi.Options {
 	lc.mu.RLock()
@@ -153,6 +153,36 @@ func TestLineChartDraws(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:   "empty after reset",
+			canvas: image.Rect(0, 0, 3, 4),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{1, 2}, SeriesXLabels(map[int]string{0: "a"})); err != nil {
+					return err
+				}
+				lc.Reset()
+				return nil
+			},
+			wantCapacity: 2,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{1, 0}, End: image.Point{1, 2}},
+					{Start: image.Point{1, 2}, End: image.Point{2, 2}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Zero value labels.
+				testdraw.MustText(c, "0", image.Point{0, 1})
+				testdraw.MustText(c, "0", image.Point{2, 3})
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc:   "empty with just one point",
 			canvas: image.Rect(0, 0, 3, 4),
@@ -276,6 +306,43 @@ func TestLineChartDraws(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:   "draws anti-aliased series",
+			canvas: image.Rect(0, 0, 20, 10),
+			opts: []Option{
+				AntiAliased(),
+			},
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, 100})
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
+					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 7})
+				testdraw.MustText(c, "51.68", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{6, 9})
+				testdraw.MustText(c, "1", image.Point{19, 9})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{26, 0}, draw.BrailleLineAntiAlias())
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc: "custom Y scale, zero based positive, values fit",
 			opts: []Option{
@@ -532,6 +599,89 @@ func TestLineChartDraws(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc: "draws logarithmic Y axis, skips values that aren't positive",
+			opts: []Option{
+				YAxisLog(),
+			},
+			canvas: image.Rect(0, 0, 20, 10),
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{1, 1000, 0, 10, 100})
+			},
+			wantCapacity: 30,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
+					{Start: image.Point{4, 8}, End: image.Point{19, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "1", image.Point{3, 7})
+				testdraw.MustText(c, "10", image.Point{2, 5})
+				testdraw.MustText(c, "100", image.Point{1, 2})
+				testdraw.MustText(c, "1000", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{5, 9})
+				testdraw.MustText(c, "1", image.Point{9, 9})
+				testdraw.MustText(c, "2", image.Point{13, 9})
+				testdraw.MustText(c, "3", image.Point{17, 9})
+
+				// Braille lines.
+				graphAr := image.Rect(5, 0, 20, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{7, 0})
+				testdraw.MustBrailleLine(bc, image.Point{21, 21}, image.Point{29, 10})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "draws series bound to the right Y axis on an independent scale",
+			canvas: image.Rect(0, 0, 20, 10),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 10}); err != nil {
+					return err
+				}
+				return lc.Series("second", []float64{2000, 0}, SeriesRightYAxis())
+			},
+			wantCapacity: 14,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y, X and the right Y axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
+					{Start: image.Point{4, 8}, End: image.Point{12, 8}},
+					{Start: image.Point{12, 0}, End: image.Point{12, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{3, 7})
+				testdraw.MustText(c, "5.28", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{13, 7})
+				testdraw.MustText(c, "1032.32", image.Point{13, 3})
+				testdraw.MustText(c, "0", image.Point{5, 9})
+				testdraw.MustText(c, "1", image.Point{11, 9})
+
+				// Braille lines.
+				graphAr := image.Rect(5, 0, 12, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{13, 1})
+				testdraw.MustBrailleLine(bc, image.Point{0, 0}, image.Point{13, 31})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc:   "custom X labels, horizontal by default",
 			canvas: image.Rect(0, 0, 20, 10),
@@ -1941,6 +2091,19 @@ func TestOptions(t *testing.T) {
 				WantMouse:   widgetapi.MouseScopeGlobal,
 			},
 		},
+		{
+			desc: "reserves space for the right Y axis",
+			addSeries: func(lc *LineChart) error {
+				if err := lc.Series("left", []float64{0, 100}); err != nil {
+					return err
+				}
+				return lc.Series("right", []float64{0, 1000}, SeriesRightYAxis())
+			},
+			want: widgetapi.Options{
+				MinimumSize: image.Point{10, 4},
+				WantMouse:   widgetapi.MouseScopeGlobal,
+			},
+		},
 		{
 			desc: "reserves space for negative Y labels",
 			addSeries: func(lc *LineChart) error {
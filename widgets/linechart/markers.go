@@ -0,0 +1,241 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linechart
+
+// markers.go contains code that draws the reference lines.
+
+import (
+	"fmt"
+	"image"
+	"math"
+
+	"github.com/woodliu/termdash/cell"
+	"github.com/woodliu/termdash/private/canvas"
+	"github.com/woodliu/termdash/private/canvas/braille"
+	"github.com/woodliu/termdash/private/draw"
+	"github.com/woodliu/termdash/private/runewidth"
+	"github.com/woodliu/termdash/widgets/linechart/internal/axes"
+)
+
+// marker is a vertical or horizontal reference line.
+type marker struct {
+	// vertical indicates a vertical line at an X value, otherwise the line is
+	// horizontal at an Y value.
+	vertical bool
+	// value is the value on the X or Y axis where the line is drawn.
+	value float64
+
+	label         string
+	cellOpts      []cell.Option
+	labelCellOpts []cell.Option
+	rightYAxis    bool
+}
+
+// validate validates the marker.
+func (m *marker) validate() error {
+	if math.IsNaN(m.value) || math.IsInf(m.value, 0) {
+		return fmt.Errorf("invalid reference line value %v, must be a valid number", m.value)
+	}
+	if m.vertical && m.value < 0 {
+		return fmt.Errorf("invalid VLine value %v, must be zero or positive", m.value)
+	}
+	return nil
+}
+
+// MarkerOption is used to provide options to VLine and HLine.
+type MarkerOption interface {
+	// set sets the provided option.
+	set(*marker)
+}
+
+// markerOption implements MarkerOption.
+type markerOption func(*marker)
+
+// set implements MarkerOption.set.
+func (mo markerOption) set(m *marker) {
+	mo(m)
+}
+
+// MarkerLabel sets a label that is displayed next to the reference line.
+// Labels of vertical lines are displayed at the top of the graph, labels of
+// horizontal lines on the right side of the graph.
+func MarkerLabel(text string) MarkerOption {
+	return markerOption(func(m *marker) {
+		m.label = text
+	})
+}
+
+// MarkerCellOpts sets the cell options for the cells that contain the
+// reference line.
+func MarkerCellOpts(co ...cell.Option) MarkerOption {
+	return markerOption(func(m *marker) {
+		m.cellOpts = co
+	})
+}
+
+// MarkerLabelCellOpts sets the cell options for the label of the reference
+// line.
+func MarkerLabelCellOpts(co ...cell.Option) MarkerOption {
+	return markerOption(func(m *marker) {
+		m.labelCellOpts = co
+	})
+}
+
+// MarkerRightYAxis indicates that the value of a horizontal reference line is
+// on the scale of the right Y axis. The line isn't drawn if no series is
+// plotted against the right Y axis. Has no effect on vertical lines.
+func MarkerRightYAxis() MarkerOption {
+	return markerOption(func(m *marker) {
+		m.rightYAxis = true
+	})
+}
+
+// VLine draws a vertical reference line at the value on the X axis, e.g. to
+// mark an event on a time series. The X values are the indexes of the values
+// in the series, the value can fall between two of them.
+// The line is mapped through the same scale and zoom as the series and isn't
+// drawn while the value is outside of the displayed range.
+// Can be provided multiple times to draw multiple lines.
+func VLine(x float64, opts ...MarkerOption) Option {
+	return option(func(o *options) {
+		m := &marker{
+			vertical: true,
+			value:    x,
+		}
+		for _, opt := range opts {
+			opt.set(m)
+		}
+		o.markers = append(o.markers, m)
+	})
+}
+
+// HLine draws a horizontal reference line at the value on the Y axis, e.g. to
+// mark a threshold. The line is mapped through the same scale as the series
+// and isn't drawn if the value is outside of the displayed range. Use
+// YAxisCustomScale to make sure the value is displayed.
+// Can be provided multiple times to draw multiple lines.
+func HLine(y float64, opts ...MarkerOption) Option {
+	return option(func(o *options) {
+		m := &marker{
+			value: y,
+		}
+		for _, opt := range opts {
+			opt.set(m)
+		}
+		o.markers = append(o.markers, m)
+	})
+}
+
+// markerPixel returns the X coordinate of the pixel of a vertical reference
+// line or the Y coordinate of the pixel of a horizontal one. Returns false if
+// the line isn't displayed.
+// The ryd are the details of the right Y axis, nil if it isn't drawn.
+func markerPixel(m *marker, xd *axes.XDetails, yd, ryd *axes.YDetails) (int, bool, error) {
+	if m.vertical {
+		if m.value < xd.Scale.Min.Value || m.value > xd.Scale.Max.Value {
+			return 0, false, nil
+		}
+		x, err := xd.Scale.FloatValueToPixel(m.value)
+		if err != nil {
+			return 0, false, err
+		}
+		return x, true, nil
+	}
+
+	if m.rightYAxis {
+		if ryd == nil {
+			return 0, false, nil
+		}
+		yd = ryd
+	}
+	if m.value < yd.Scale.Min.Value || m.value > yd.Scale.Max.Value {
+		return 0, false, nil
+	}
+	y, err := yd.Scale.ValueToPixel(m.value)
+	if err != nil {
+		return 0, false, err
+	}
+	return y, true, nil
+}
+
+// drawMarkerLines draws the reference lines onto the braille canvas.
+func (lc *LineChart) drawMarkerLines(bc *braille.Canvas, xd *axes.XDetails, yd, ryd *axes.YDetails) error {
+	ar := bc.Area()
+	for _, m := range lc.opts.markers {
+		p, ok, err := markerPixel(m, xd, yd, ryd)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		start, end := image.Point{0, p}, image.Point{ar.Max.X - 1, p}
+		if m.vertical {
+			start, end = image.Point{p, 0}, image.Point{p, ar.Max.Y - 1}
+		}
+		if err := draw.BrailleLine(bc, start, end, draw.BrailleLineCellOpts(m.cellOpts...)); err != nil {
+			return fmt.Errorf("failed to draw the reference line: %v", err)
+		}
+	}
+	return nil
+}
+
+// drawMarkerLabels draws the labels of the reference lines inside the graph
+// area, so they don't overlap the labels of the axes.
+func (lc *LineChart) drawMarkerLabels(cvs *canvas.Canvas, graphAr image.Rectangle, xd *axes.XDetails, yd, ryd *axes.YDetails) error {
+	for _, m := range lc.opts.markers {
+		if m.label == "" {
+			continue
+		}
+		p, ok, err := markerPixel(m, xd, yd, ryd)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		var start image.Point
+		var maxCells int
+		if m.vertical {
+			// At the top of the graph, preferably to the right of the line.
+			col := graphAr.Min.X + p/braille.ColMult
+			start = image.Point{col + 1, graphAr.Min.Y}
+			maxCells = graphAr.Max.X - start.X
+			if left := col - graphAr.Min.X; maxCells < runewidth.StringWidth(m.label) && left > maxCells {
+				maxCells = left
+				start.X = col - min(left, runewidth.StringWidth(m.label))
+			}
+		} else {
+			// On the right side of the graph, on the row of the line.
+			row := graphAr.Min.Y + p/braille.RowMult
+			maxCells = graphAr.Dx()
+			start = image.Point{graphAr.Max.X - min(maxCells, runewidth.StringWidth(m.label)), row}
+		}
+		if maxCells < 1 {
+			continue
+		}
+
+		text, err := draw.TrimText(m.label, maxCells, draw.OverrunModeThreeDot)
+		if err != nil {
+			return err
+		}
+		if err := draw.Text(cvs, text, start, draw.TextCellOpts(m.labelCellOpts...)); err != nil {
+			return fmt.Errorf("failed to draw the reference line label: %v", err)
+		}
+	}
+	return nil
+}
@@ -43,6 +43,8 @@ type options struct {
 	yAxisValueFormatter ValueFormatter
 	zoomHightlightColor cell.Color
 	zoomStepPercent     int
+	antiAliased         bool
+	markers             []*marker
 }
 
 // validate validates the provided options.
@@ -58,6 +60,11 @@ func (o *options) validate() error {
 	if got, min, max := o.zoomStepPercent, 1, 100; got < min || got > max {
 		return fmt.Errorf("invalid ZoomStepPercent %d, must be in range %d <= value <= %d", got, min, max)
 	}
+	for _, m := range o.markers {
+		if err := m.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -132,6 +139,18 @@ func YAxisAdaptive() Option {
 	})
 }
 
+// YAxisLog makes the Y axis logarithmic, which is useful for values that span
+// several orders of magnitude. The axis spans whole decades and its labels are
+// placed at the powers of ten.
+// Only positive values can be displayed on a logarithmic axis. Values that
+// are zero or negative are skipped the same way as math.NaN values, i.e. no
+// line is drawn to or from them.
+func YAxisLog() Option {
+	return option(func(opts *options) {
+		opts.yAxisMode = axes.YScaleModeLog
+	})
+}
+
 // customScale is the custom scale provided via the YAxisCustomScale option.
 type customScale struct {
 	min, max float64
@@ -149,14 +168,16 @@ type customScale struct {
 // Both the minimum and the maximum must be valid numbers and the minimum must
 // be smaller than the maximum.
 //
-// Providing this option also sets YAxisAdaptive.
+// Providing this option also sets YAxisAdaptive, unless YAxisLog is provided.
 func YAxisCustomScale(min, max float64) Option {
 	return option(func(opts *options) {
 		opts.yAxisCustomScale = &customScale{
 			min: min,
 			max: max,
 		}
-		opts.yAxisMode = axes.YScaleModeAdaptive
+		if opts.yAxisMode != axes.YScaleModeLog {
+			opts.yAxisMode = axes.YScaleModeAdaptive
+		}
 	})
 }
 
@@ -196,6 +217,15 @@ func ZoomStepPercent(perc int) Option {
 	})
 }
 
+// AntiAliased makes the linechart soften the diagonal lines of the series.
+// The lines appear slightly thicker in exchange for less visible steps.
+// Defaults to crisp lines.
+func AntiAliased() Option {
+	return option(func(opts *options) {
+		opts.antiAliased = true
+	})
+}
+
 // YAxisFormattedValues sets a value formatter for the Y axis values.
 // If a formatter is set, it will format the values with the desired
 // ValueFormatter and will use the retuning string from the formatter
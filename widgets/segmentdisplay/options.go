@@ -16,6 +16,7 @@ package segmentdisplay
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/woodliu/termdash/align"
 )
@@ -42,6 +43,9 @@ type options struct {
 	vAlign          align.Vertical
 	maximizeSegSize bool
 	gapPercent      int
+	segThickPercent int
+	segSpacePercent int
+	scrollSpeed     time.Duration
 }
 
 // validate validates the provided options.
@@ -49,15 +53,26 @@ func (o *options) validate() error {
 	if min, max := 0, 100; o.gapPercent < min || o.gapPercent > max {
 		return fmt.Errorf("invalid GapPercent %d, must be %d <= value <= %d", o.gapPercent, min, max)
 	}
+	if min, max := 1, 200; o.segThickPercent < min || o.segThickPercent > max {
+		return fmt.Errorf("invalid SegmentThickness %d, must be %d <= value <= %d", o.segThickPercent, min, max)
+	}
+	if min, max := 0, 200; o.segSpacePercent < min || o.segSpacePercent > max {
+		return fmt.Errorf("invalid SegmentSpacing %d, must be %d <= value <= %d", o.segSpacePercent, min, max)
+	}
+	if got, min := o.scrollSpeed, time.Duration(0); got < min {
+		return fmt.Errorf("invalid Scroll %v, must be %v <= Scroll", got, min)
+	}
 	return nil
 }
 
 // newOptions returns options with the default values set.
 func newOptions() *options {
 	return &options{
-		hAlign:     align.HorizontalCenter,
-		vAlign:     align.VerticalMiddle,
-		gapPercent: DefaultGapPercent,
+		hAlign:          align.HorizontalCenter,
+		vAlign:          align.VerticalMiddle,
+		gapPercent:      DefaultGapPercent,
+		segThickPercent: DefaultSegmentThickness,
+		segSpacePercent: DefaultSegmentSpacing,
 	}
 }
 
@@ -110,3 +125,46 @@ func GapPercent(perc int) Option {
 		opts.gapPercent = perc
 	})
 }
+
+// DefaultSegmentThickness is the default value for the SegmentThickness option.
+const DefaultSegmentThickness = 100
+
+// SegmentThickness scales the thickness of the individual segments that form
+// each character. Expressed as a percentage of the default thickness, i.e.
+// values above DefaultSegmentThickness make the display bolder and values
+// below it make the display thinner.
+// Must be in range 1 <= perc <= 200.
+func SegmentThickness(perc int) Option {
+	return option(func(opts *options) {
+		opts.segThickPercent = perc
+	})
+}
+
+// DefaultSegmentSpacing is the default value for the SegmentSpacing option.
+const DefaultSegmentSpacing = 100
+
+// SegmentSpacing scales the size of the gaps between the individual segments
+// that form each character. Expressed as a percentage of the default gap size.
+// The segments are always separated by at least one pixel.
+// Must be in range 0 <= perc <= 200.
+func SegmentSpacing(perc int) Option {
+	return option(func(opts *options) {
+		opts.segSpacePercent = perc
+	})
+}
+
+// Scroll makes the text scroll from right to left like a ticker when it is
+// longer than the number of segments that fit the canvas. The text advances by
+// one character each speed and the scrolling restarts when different text is
+// written. A space separates the end of the text from its start.
+// While scrolling, the segments keep their maximum height as if the
+// MaximizeSegmentHeight option was provided and the widget asks termdash to
+// redraw it at least once each speed.
+// Text that fits the canvas doesn't scroll and doesn't need the redraws.
+// Must be a positive or zero duration, zero disables the scrolling.
+// Defaults to zero.
+func Scroll(speed time.Duration) Option {
+	return option(func(opts *options) {
+		opts.scrollSpeed = speed
+	})
+}
@@ -22,14 +22,20 @@ import (
 	"image"
 
 	"github.com/woodliu/termdash/private/segdisp"
+	"github.com/woodliu/termdash/private/segdisp/dotseg"
 )
 
 // segArea contains information about the area that will contain the segments.
 type segArea struct {
 	// segment is the area for one segment.
 	segment image.Rectangle
+	// narrow are the columns of the segment area that contain the dots of the
+	// narrow characters, which are cropped to these columns.
+	narrow image.Rectangle
 	// canFit is the number of segments we can fit on the canvas.
 	canFit int
+	// width is the width of the segments we can fit and any gaps.
+	width int
 	// gapPixels is the size of gaps between segments in pixels.
 	gapPixels int
 	// gaps is the number of gaps that will be drawn.
@@ -42,67 +48,99 @@ func (sa *segArea) needArea() image.Rectangle {
 	return image.Rect(
 		0,
 		0,
-		sa.segment.Dx()*sa.canFit+sa.gaps*sa.gapPixels,
+		sa.width,
 		sa.segment.Dy(),
 	)
 }
 
+// charWidth returns the width of the segment that displays the character.
+func (sa *segArea) charWidth(c rune) int {
+	if isNarrow(c) {
+		return sa.narrow.Dx()
+	}
+	return sa.segment.Dx()
+}
+
+// dotChars are characters that are drawn using the dot segment.
+// All other characters are drawn using the 16-segment display.
+var dotChars = func() map[rune]bool {
+	res := map[rune]bool{}
+	for _, r := range dotseg.SupportedChars() {
+		res[r] = true
+	}
+	return res
+}()
+
+// isNarrow asserts whether the character is displayed in a narrow column,
+// i.e. only the dots of the dot segment display are drawn.
+func isNarrow(c rune) bool {
+	return dotChars[c]
+}
+
 // newSegArea calculates the area for segments given available canvas area,
-// length of the text to be displayed and the size of gap between segments
-func newSegArea(cvsAr image.Rectangle, textLen, gapPercent int) (*segArea, error) {
+// the text to be displayed, the size of gap between segments and the scale of
+// the segment thickness.
+func newSegArea(cvsAr image.Rectangle, text string, gapPercent, segThickPercent int) (*segArea, error) {
 	segAr, err := segdisp.Required(cvsAr)
 	if err != nil {
 		return nil, fmt.Errorf("sixteen.Required => %v", err)
 	}
-	gapPixels := segAr.Dy() * gapPercent / 100
+	narrow, err := dotseg.Columns(segAr.Size(), segThickPercent)
+	if err != nil {
+		return nil, fmt.Errorf("dotseg.Columns => %v", err)
+	}
+	sa := &segArea{
+		segment:   segAr,
+		narrow:    narrow,
+		gapPixels: segAr.Dy() * gapPercent / 100,
+	}
 
-	var (
-		gaps   int
-		canFit int
-		taken  int
-	)
-	for i := 0; ; {
-		taken += segAr.Dx()
+	// charWidth returns the width of the i-th segment.
+	// Segments beyond the text are as wide as a full character.
+	chars := []rune(text)
+	charWidth := func(i int) int {
+		if i < len(chars) {
+			return sa.charWidth(chars[i])
+		}
+		return segAr.Dx()
+	}
 
-		if taken > cvsAr.Dx() {
+	for i := 0; ; i++ {
+		if sa.width+charWidth(i) > cvsAr.Dx() {
 			break
 		}
-		canFit++
+		sa.width += charWidth(i)
+		sa.canFit++
 
 		// Don't insert gaps after the last segment in the text or the last
 		// segment we can fit.
-		if gapPixels == 0 || i == textLen-1 {
+		if sa.gapPixels == 0 || (len(chars) > 0 && i >= len(chars)-1) {
 			continue
 		}
 
-		remaining := cvsAr.Dx() - taken
+		remaining := cvsAr.Dx() - sa.width
 		// Only insert gaps if we can still fit one more segment with the gap.
-		if remaining >= gapPixels+segAr.Dx() {
-			taken += gapPixels
-			gaps++
+		if remaining >= sa.gapPixels+charWidth(i+1) {
+			sa.width += sa.gapPixels
+			sa.gaps++
 		} else {
 			// Gap is needed but doesn't fit together with the next segment.
 			// So insert neither.
 			break
 		}
-		i++
 	}
-	return &segArea{
-		segment:   segAr,
-		canFit:    canFit,
-		gapPixels: gapPixels,
-		gaps:      gaps,
-	}, nil
+	return sa, nil
 }
 
 // maximizeFit finds the largest individual segment size that enables us to fit
 // the most characters onto a canvas with the provided area. Returns the area
 // required for a single segment and the number of segments we can fit.
-func maximizeFit(cvsAr image.Rectangle, textLen, gapPercent int) (*segArea, error) {
+func maximizeFit(cvsAr image.Rectangle, text string, gapPercent, segThickPercent int) (*segArea, error) {
+	textLen := len(text) // We're guaranteed by Write to only have ASCII characters.
 	var bestSegAr *segArea
 	for height := cvsAr.Dy(); height >= segdisp.MinRows; height-- {
 		cvsAr := image.Rect(cvsAr.Min.X, cvsAr.Min.Y, cvsAr.Max.X, cvsAr.Min.Y+height)
-		segAr, err := newSegArea(cvsAr, textLen, gapPercent)
+		segAr, err := newSegArea(cvsAr, text, gapPercent, segThickPercent)
 		if err != nil {
 			return nil, err
 		}
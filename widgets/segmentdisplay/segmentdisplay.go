@@ -22,6 +22,7 @@ import (
 	"image"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/woodliu/termdash/private/alignfor"
 	"github.com/woodliu/termdash/private/attrrange"
@@ -42,6 +43,9 @@ import (
 // Segment displays support only a subset of ASCII characters, provided options
 // determine the behavior when an unsupported character is encountered.
 //
+// The colon and the decimal point are displayed as dots in columns narrower
+// than the other characters, e.g. to display clocks.
+//
 // Implements widgetapi.Widget. This object is thread-safe.
 type SegmentDisplay struct {
 	// buff contains the text to be displayed.
@@ -56,9 +60,9 @@ type SegmentDisplay struct {
 	// time Draw was called.
 	lastCanFit int
 
-	// dotChars are characters that are drawn using the dot segment.
-	// All other characters are draws using the 16-segment display.
-	dotChars map[rune]bool
+	// scrollStart is the time when the currently displayed text was written,
+	// the text scrolls relative to it when the Scroll option is set.
+	scrollStart time.Time
 
 	// mu protects the widget.
 	mu sync.Mutex
@@ -77,14 +81,9 @@ func New(opts ...Option) (*SegmentDisplay, error) {
 		return nil, err
 	}
 
-	dotChars := map[rune]bool{}
-	for _, r := range dotseg.SupportedChars() {
-		dotChars[r] = true
-	}
 	return &SegmentDisplay{
 		wOptsTracker: attrrange.NewTracker(),
 		opts:         opt,
-		dotChars:     dotChars,
 	}, nil
 }
 
@@ -128,6 +127,7 @@ func (sd *SegmentDisplay) Write(chunks []*TextChunk, opts ...Option) error {
 	if len(chunks) == 0 {
 		return errors.New("at least one text chunk must be specified")
 	}
+	prev := sd.buff.String()
 	sd.reset()
 
 	for i, tc := range chunks {
@@ -147,6 +147,9 @@ func (sd *SegmentDisplay) Write(chunks []*TextChunk, opts ...Option) error {
 		}
 		sd.buff.WriteString(text)
 	}
+	if sd.buff.String() != prev {
+		sd.scrollStart = time.Now()
+	}
 	return nil
 }
 
@@ -165,6 +168,7 @@ func (sd *SegmentDisplay) Capacity() int {
 }
 
 // Reset resets the widget back to empty content.
+// Implements widgetapi.Resettable.
 func (sd *SegmentDisplay) Reset() {
 	sd.mu.Lock()
 	defer sd.mu.Unlock()
@@ -184,24 +188,43 @@ func (sd *SegmentDisplay) reset() {
 // Returns the area required for a single segment, the text that we can fit and
 // size of gaps between segments in cells.
 func (sd *SegmentDisplay) preprocess(cvsAr image.Rectangle) (*segArea, error) {
-	textLen := sd.buff.Len() // We're guaranteed by Write to only have ASCII characters.
-	segAr, err := newSegArea(cvsAr, textLen, sd.opts.gapPercent)
+	text := sd.buff.String()
+	segAr, err := newSegArea(cvsAr, text, sd.opts.gapPercent, sd.opts.segThickPercent)
 	if err != nil {
 		return nil, err
 	}
 
 	need := sd.buff.Len()
-	if (need > 0 && need <= segAr.canFit) || sd.opts.maximizeSegSize {
+	if (need > 0 && need <= segAr.canFit) || sd.opts.maximizeSegSize || sd.opts.scrollSpeed > 0 {
 		return segAr, nil
 	}
 
-	bestAr, err := maximizeFit(cvsAr, textLen, sd.opts.gapPercent)
+	bestAr, err := maximizeFit(cvsAr, text, sd.opts.gapPercent, sd.opts.segThickPercent)
 	if err != nil {
 		return nil, err
 	}
 	return bestAr, nil
 }
 
+// timeSince is a function that calculates duration since some time.
+// Replaced from tests.
+var timeSince = time.Since
+
+// scrolled returns the text rotated to the current scroll position and the
+// position of its first character in the written text. Returns the written
+// text unchanged if it fits the segment area or if it doesn't scroll.
+func (sd *SegmentDisplay) scrolled(segAr *segArea) (string, int) {
+	text := sd.buff.String()
+	if sd.opts.scrollSpeed == 0 || len(text) <= segAr.canFit {
+		return text, 0
+	}
+
+	// The space separates the end of the text from its start.
+	loop := text + " "
+	offset := int(timeSince(sd.scrollStart)/sd.opts.scrollSpeed) % len(loop)
+	return loop[offset:] + loop[:offset], offset
+}
+
 // Draw draws the SegmentDisplay widget onto the canvas.
 // Implements widgetapi.Widget.Draw.
 func (sd *SegmentDisplay) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
@@ -218,7 +241,14 @@ func (sd *SegmentDisplay) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 		return nil
 	}
 
-	text := sd.buff.String()
+	text, offset := sd.scrolled(segAr)
+	if offset > 0 {
+		// The narrow characters moved, recalculate what fits.
+		segAr, err = newSegArea(cvs.Area(), text, sd.opts.gapPercent, sd.opts.segThickPercent)
+		if err != nil {
+			return err
+		}
+	}
 	aligned, err := alignfor.Rectangle(cvs.Area(), segAr.needArea(), sd.opts.hAlign, sd.opts.vAlign)
 	if err != nil {
 		return fmt.Errorf("alignfor.Rectangle => %v", err)
@@ -236,7 +266,7 @@ func (sd *SegmentDisplay) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 			break
 		}
 
-		endX := startX + segAr.segment.Dx()
+		endX := startX + segAr.charWidth(c)
 		ar := image.Rect(startX, aligned.Min.Y, endX, aligned.Max.Y)
 		startX = endX
 		if gaps > 0 {
@@ -249,15 +279,25 @@ func (sd *SegmentDisplay) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 			return fmt.Errorf("canvas.New => %v", err)
 		}
 
-		if i >= optRange.High { // Get the next write options.
-			or, err := sd.wOptsTracker.ForPosition(i)
+		// The position of the character in the written text, the separator
+		// of scrolled text uses the options of the last character.
+		pos := (i + offset) % (sd.buff.Len() + 1)
+		if pos == sd.buff.Len() {
+			pos--
+		}
+		if pos < optRange.Low || pos >= optRange.High { // Get the next write options.
+			or, err := sd.wOptsTracker.ForPosition(pos)
 			if err != nil {
 				return err
 			}
 			optRange = or
 		}
 		wOpts := sd.givenWOpts[optRange.AttrIdx]
-		if err := sd.drawChar(dCvs, c, wOpts); err != nil {
+		if isNarrow(c) {
+			if err := sd.drawNarrowChar(dCvs, segAr, c, wOpts); err != nil {
+				return err
+			}
+		} else if err := sd.drawChar(dCvs, c, wOpts); err != nil {
 			return err
 		}
 
@@ -268,14 +308,32 @@ func (sd *SegmentDisplay) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	return nil
 }
 
+// drawNarrowChar draws a single character that is displayed in a narrow column
+// onto the provided canvas. The character is drawn onto a canvas of the size of
+// a full segment and cropped to the columns that contain its dots.
+func (sd *SegmentDisplay) drawNarrowChar(dCvs *canvas.Canvas, segAr *segArea, c rune, wOpts *writeOptions) error {
+	full, err := canvas.New(image.Rect(0, 0, segAr.segment.Dx(), dCvs.Area().Dy()))
+	if err != nil {
+		return fmt.Errorf("canvas.New => %v", err)
+	}
+	if err := sd.drawChar(full, c, wOpts); err != nil {
+		return err
+	}
+	return full.CopyRegionTo(segAr.narrow, dCvs)
+}
+
 // drawChar draws a single character onto the provided canvas.
 func (sd *SegmentDisplay) drawChar(dCvs *canvas.Canvas, c rune, wOpts *writeOptions) error {
-	if sd.dotChars[c] {
+	if dotChars[c] {
 		disp := dotseg.New()
 		if err := disp.SetCharacter(c); err != nil {
 			return fmt.Errorf("dotseg.Display.SetCharacter => %v", err)
 		}
-		if err := disp.Draw(dCvs, dotseg.CellOpts(wOpts.cellOpts...)); err != nil {
+		if err := disp.Draw(dCvs,
+			dotseg.CellOpts(wOpts.cellOpts...),
+			dotseg.SegmentScale(sd.opts.segThickPercent),
+			dotseg.GapScale(sd.opts.segSpacePercent),
+		); err != nil {
 			return fmt.Errorf("dotseg.Display..Draw => %v", err)
 		}
 		return nil
@@ -285,7 +343,11 @@ func (sd *SegmentDisplay) drawChar(dCvs *canvas.Canvas, c rune, wOpts *writeOpti
 	if err := disp.SetCharacter(c); err != nil {
 		return fmt.Errorf("sixteen.Display.SetCharacter => %v", err)
 	}
-	if err := disp.Draw(dCvs, sixteen.CellOpts(wOpts.cellOpts...)); err != nil {
+	if err := disp.Draw(dCvs,
+		sixteen.CellOpts(wOpts.cellOpts...),
+		sixteen.SegmentScale(sd.opts.segThickPercent),
+		sixteen.GapScale(sd.opts.segSpacePercent),
+	); err != nil {
 		return fmt.Errorf("sixteen.Display.Draw => %v", err)
 	}
 	return nil
@@ -303,10 +365,20 @@ func (*SegmentDisplay) Mouse(m *terminalapi.Mouse, meta *widgetapi.EventMeta) er
 
 // Options implements widgetapi.Widget.Options.
 func (sd *SegmentDisplay) Options() widgetapi.Options {
+	sd.mu.Lock()
+	defer sd.mu.Unlock()
+
+	var redraw time.Duration
+	if sd.buff.Len() > sd.lastCanFit {
+		// Only scrolling text needs to be redrawn periodically. The text is
+		// assumed to scroll until the first Draw determines what fits.
+		redraw = sd.opts.scrollSpeed
+	}
 	return widgetapi.Options{
 		// The smallest supported size of a display segment.
-		MinimumSize:  image.Point{segdisp.MinCols, segdisp.MinRows},
-		WantKeyboard: widgetapi.KeyScopeNone,
-		WantMouse:    widgetapi.MouseScopeNone,
+		MinimumSize:    image.Point{segdisp.MinCols, segdisp.MinRows},
+		WantKeyboard:   widgetapi.KeyScopeNone,
+		WantMouse:      widgetapi.MouseScopeNone,
+		RedrawInterval: redraw,
 	}
 }
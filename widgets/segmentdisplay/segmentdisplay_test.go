@@ -15,8 +15,10 @@
 package segmentdisplay
 
 import (
+	"fmt"
 	"image"
 	"testing"
+	"time"
 
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/woodliu/termdash/align"
@@ -51,6 +53,25 @@ func mustDrawChar(cvs *canvas.Canvas, char rune, ar image.Rectangle, cOpts ...ce
 	testcanvas.MustCopyTo(c, cvs)
 }
 
+// mustDrawNarrowChar draws the provided dot character as it would be drawn in
+// the segment area with the provided segment and gap scales, but cropped to
+// the columns that contain its dots and placed at the start of the segment
+// area. Returns the width of the drawn character.
+func mustDrawNarrowChar(cvs *canvas.Canvas, char rune, segAr image.Rectangle, segScale, gapScale int) int {
+	c := testcanvas.MustNew(image.Rect(0, 0, segAr.Dx(), segAr.Dy()))
+	d := dotseg.New(dotseg.SegmentScale(segScale), dotseg.GapScale(gapScale))
+	testdotseg.MustSetCharacter(d, char)
+	testdotseg.MustDraw(d, c)
+
+	cols := testdotseg.MustColumns(segAr.Size(), segScale)
+	narrow := testcanvas.MustNew(image.Rect(segAr.Min.X, segAr.Min.Y, segAr.Min.X+cols.Dx(), segAr.Max.Y))
+	if err := c.CopyRegionTo(cols, narrow); err != nil {
+		panic(fmt.Sprintf("CopyRegionTo => unexpected error: %v", err))
+	}
+	testcanvas.MustCopyTo(narrow, cvs)
+	return cols.Dx()
+}
+
 func TestSegmentDisplay(t *testing.T) {
 	tests := []struct {
 		desc          string
@@ -102,6 +123,46 @@ func TestSegmentDisplay(t *testing.T) {
 			},
 			wantUpdateErr: true,
 		},
+		{
+			desc: "New fails on invalid SegmentThickness (too low)",
+			opts: []Option{
+				SegmentThickness(0),
+			},
+			canvas:     image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows),
+			wantNewErr: true,
+		},
+		{
+			desc: "New fails on invalid SegmentThickness (too high)",
+			opts: []Option{
+				SegmentThickness(201),
+			},
+			canvas:     image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows),
+			wantNewErr: true,
+		},
+		{
+			desc: "New fails on invalid SegmentSpacing (too low)",
+			opts: []Option{
+				SegmentSpacing(-1),
+			},
+			canvas:     image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows),
+			wantNewErr: true,
+		},
+		{
+			desc: "New fails on invalid SegmentSpacing (too high)",
+			opts: []Option{
+				SegmentSpacing(201),
+			},
+			canvas:     image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows),
+			wantNewErr: true,
+		},
+		{
+			desc: "New fails on negative Scroll",
+			opts: []Option{
+				Scroll(-1),
+			},
+			canvas:     image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows),
+			wantNewErr: true,
+		},
 		{
 			desc:   "fails on area too small for a segment",
 			canvas: image.Rect(0, 0, segdisp.MinCols-1, segdisp.MinRows),
@@ -168,6 +229,34 @@ func TestSegmentDisplay(t *testing.T) {
 			},
 			wantCapacity: 3,
 		},
+		{
+			desc: "draws segments with custom thickness and spacing",
+			opts: []Option{
+				GapPercent(0),
+				SegmentThickness(150),
+				SegmentSpacing(50),
+			},
+			canvas: image.Rect(0, 0, segdisp.MinCols*4, segdisp.MinRows*2),
+			update: func(sd *SegmentDisplay) error {
+				return sd.Write([]*TextChunk{NewChunk("8.")}, AlignHorizontal(align.HorizontalLeft))
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				c := testcanvas.MustNew(image.Rect(0, 0, segdisp.MinCols*2, segdisp.MinRows*2))
+				d := sixteen.New(sixteen.SegmentScale(150), sixteen.GapScale(50))
+				testsixteen.MustSetCharacter(d, '8')
+				testsixteen.MustDraw(d, c)
+				testcanvas.MustCopyTo(c, cvs)
+
+				mustDrawNarrowChar(cvs, '.', image.Rect(segdisp.MinCols*2, 0, segdisp.MinCols*4, segdisp.MinRows*2), 150, 50)
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCapacity: 2,
+		},
 		{
 			desc: "uses the dot segment for a colon",
 			opts: []Option{
@@ -175,22 +264,15 @@ func TestSegmentDisplay(t *testing.T) {
 			},
 			canvas: image.Rect(0, 0, segdisp.MinCols*3, segdisp.MinRows),
 			update: func(sd *SegmentDisplay) error {
-				return sd.Write([]*TextChunk{NewChunk("1:3")})
+				return sd.Write([]*TextChunk{NewChunk("1:3")}, AlignHorizontal(align.HorizontalLeft))
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				cvs := testcanvas.MustNew(ft.Area())
 
-				for _, tc := range []struct {
-					char rune
-					area image.Rectangle
-				}{
-					{'1', image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows)},
-					{':', image.Rect(segdisp.MinCols, 0, segdisp.MinCols*2, segdisp.MinRows)},
-					{'3', image.Rect(segdisp.MinCols*2, 0, segdisp.MinCols*3, segdisp.MinRows)},
-				} {
-					mustDrawChar(cvs, tc.char, tc.area)
-				}
+				mustDrawChar(cvs, '1', image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows))
+				w := mustDrawNarrowChar(cvs, ':', image.Rect(segdisp.MinCols, 0, segdisp.MinCols*2, segdisp.MinRows), segdisp.DefaultScalePercent, segdisp.DefaultScalePercent)
+				mustDrawChar(cvs, '3', image.Rect(segdisp.MinCols+w, 0, segdisp.MinCols*2+w, segdisp.MinRows))
 
 				testcanvas.MustApply(cvs, ft)
 				return ft
@@ -204,28 +286,45 @@ func TestSegmentDisplay(t *testing.T) {
 			},
 			canvas: image.Rect(0, 0, segdisp.MinCols*3, segdisp.MinRows),
 			update: func(sd *SegmentDisplay) error {
-				return sd.Write([]*TextChunk{NewChunk("1.3")})
+				return sd.Write([]*TextChunk{NewChunk("1.3")}, AlignHorizontal(align.HorizontalLeft))
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				cvs := testcanvas.MustNew(ft.Area())
 
-				for _, tc := range []struct {
-					char rune
-					area image.Rectangle
-				}{
-					{'1', image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows)},
-					{'.', image.Rect(segdisp.MinCols, 0, segdisp.MinCols*2, segdisp.MinRows)},
-					{'3', image.Rect(segdisp.MinCols*2, 0, segdisp.MinCols*3, segdisp.MinRows)},
-				} {
-					mustDrawChar(cvs, tc.char, tc.area)
-				}
+				mustDrawChar(cvs, '1', image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows))
+				w := mustDrawNarrowChar(cvs, '.', image.Rect(segdisp.MinCols, 0, segdisp.MinCols*2, segdisp.MinRows), segdisp.DefaultScalePercent, segdisp.DefaultScalePercent)
+				mustDrawChar(cvs, '3', image.Rect(segdisp.MinCols+w, 0, segdisp.MinCols*2+w, segdisp.MinRows))
 
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 			wantCapacity: 3,
 		},
+		{
+			desc: "the colon of a clock takes a narrow column",
+			opts: []Option{
+				GapPercent(0),
+			},
+			canvas: image.Rect(0, 0, segdisp.MinCols*4+2, segdisp.MinRows),
+			update: func(sd *SegmentDisplay) error {
+				return sd.Write([]*TextChunk{NewChunk("12:34")})
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				mustDrawChar(cvs, '1', image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows))
+				mustDrawChar(cvs, '2', image.Rect(segdisp.MinCols, 0, segdisp.MinCols*2, segdisp.MinRows))
+				w := mustDrawNarrowChar(cvs, ':', image.Rect(segdisp.MinCols*2, 0, segdisp.MinCols*3, segdisp.MinRows), segdisp.DefaultScalePercent, segdisp.DefaultScalePercent)
+				mustDrawChar(cvs, '3', image.Rect(segdisp.MinCols*2+w, 0, segdisp.MinCols*3+w, segdisp.MinRows))
+				mustDrawChar(cvs, '4', image.Rect(segdisp.MinCols*3+w, 0, segdisp.MinCols*4+w, segdisp.MinRows))
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCapacity: 5,
+		},
 		{
 			desc: "write sanitizes text by default",
 			opts: []Option{
@@ -977,6 +1076,101 @@ func TestSegmentDisplay(t *testing.T) {
 	}
 }
 
+func TestScroll(t *testing.T) {
+	const speed = time.Second
+	tests := []struct {
+		desc    string
+		text    string
+		elapsed time.Duration
+		// want are the drawn characters, spaces aren't drawn.
+		want         string
+		wantCapacity int
+	}{
+		{
+			desc:         "starts at the beginning of the text",
+			text:         "123",
+			want:         "12",
+			wantCapacity: 2,
+		},
+		{
+			desc:         "advances by one character each speed",
+			text:         "123",
+			elapsed:      speed + speed/2,
+			want:         "23",
+			wantCapacity: 2,
+		},
+		{
+			desc:         "the end is separated from the start by a space",
+			text:         "123",
+			elapsed:      3 * speed,
+			want:         " 1",
+			wantCapacity: 2,
+		},
+		{
+			desc:         "wraps around",
+			text:         "123",
+			elapsed:      5 * speed,
+			want:         "23",
+			wantCapacity: 2,
+		},
+		{
+			desc:         "doesn't scroll text that fits",
+			text:         "12",
+			elapsed:      speed,
+			want:         "12",
+			wantCapacity: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			sd, err := New(Scroll(speed), GapPercent(0))
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if err := sd.Write([]*TextChunk{NewChunk(tc.text)}); err != nil {
+				t.Fatalf("Write => unexpected error: %v", err)
+			}
+
+			oldTimeSince := timeSince
+			defer func() { timeSince = oldTimeSince }()
+			timeSince = func(time.Time) time.Duration { return tc.elapsed }
+
+			c, err := canvas.New(image.Rect(0, 0, segdisp.MinCols*2, segdisp.MinRows))
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+			if err := sd.Draw(c, nil); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+			got, err := faketerm.New(c.Size())
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			if err := c.Apply(got); err != nil {
+				t.Fatalf("Apply => unexpected error: %v", err)
+			}
+
+			want := faketerm.MustNew(c.Size())
+			cvs := testcanvas.MustNew(want.Area())
+			for i, char := range tc.want {
+				if char == ' ' {
+					continue
+				}
+				mustDrawChar(cvs, char, image.Rect(i*segdisp.MinCols, 0, (i+1)*segdisp.MinCols, segdisp.MinRows))
+			}
+			testcanvas.MustApply(cvs, want)
+			if diff := faketerm.Diff(want, got); diff != "" {
+				t.Errorf("Draw => %v", diff)
+			}
+
+			if gotCapacity := sd.Capacity(); gotCapacity != tc.wantCapacity {
+				t.Errorf("Capacity => %d, want %d", gotCapacity, tc.wantCapacity)
+			}
+		})
+	}
+}
+
 func TestKeyboard(t *testing.T) {
 	sd, err := New()
 	if err != nil {
@@ -1012,4 +1206,30 @@ func TestOptions(t *testing.T) {
 		t.Errorf("Options => unexpected diff (-want, +got):\n%s", diff)
 	}
 
+	scrolling, err := New(Scroll(time.Second))
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+	if got := scrolling.Options().RedrawInterval; got != 0 {
+		t.Errorf("Options without text => RedrawInterval %v, want 0", got)
+	}
+
+	if err := scrolling.Write([]*TextChunk{NewChunk("1234")}); err != nil {
+		t.Fatalf("Write => unexpected error: %v", err)
+	}
+	fits := testcanvas.MustNew(image.Rect(0, 0, 6*segdisp.MinCols, segdisp.MinRows))
+	if err := scrolling.Draw(fits, &widgetapi.Meta{}); err != nil {
+		t.Fatalf("Draw => unexpected error: %v", err)
+	}
+	if got := scrolling.Options().RedrawInterval; got != 0 {
+		t.Errorf("Options when the text fits => RedrawInterval %v, want 0", got)
+	}
+
+	tooSmall := testcanvas.MustNew(image.Rect(0, 0, 2*segdisp.MinCols, segdisp.MinRows))
+	if err := scrolling.Draw(tooSmall, &widgetapi.Meta{}); err != nil {
+		t.Fatalf("Draw => unexpected error: %v", err)
+	}
+	if got, want := scrolling.Options().RedrawInterval, time.Second; got != want {
+		t.Errorf("Options when the text scrolls => RedrawInterval %v, want %v", got, want)
+	}
 }
@@ -18,6 +18,7 @@ package sparkline
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/woodliu/termdash/cell"
 )
@@ -42,6 +43,9 @@ type options struct {
 	labelCellOpts []cell.Option
 	height        int
 	color         cell.Color
+	sharedScale   bool
+	valueFormat   string
+	valueCellOpts []cell.Option
 }
 
 // newOptions returns options with the default values set.
@@ -56,6 +60,9 @@ func (o *options) validate() error {
 	if got, min := o.height, 0; got < min {
 		return fmt.Errorf("invalid Height %d, must be %d <= Height", got, min)
 	}
+	if o.valueFormat != "" && strings.Contains(fmt.Sprintf(o.valueFormat, 0), "%!") {
+		return fmt.Errorf("invalid ShowCurrentValue format %q, must contain a single integer verb", o.valueFormat)
+	}
 	return nil
 }
 
@@ -86,3 +93,27 @@ func Color(c cell.Color) Option {
 		opts.color = c
 	})
 }
+
+// SharedScale makes all the series added via AddSeries scale their bars
+// according to the largest visible data point among all of them, so that the
+// bars of the series are comparable.
+// Defaults to each series using its own largest visible data point.
+func SharedScale() Option {
+	return option(func(opts *options) {
+		opts.sharedScale = true
+	})
+}
+
+// ShowCurrentValue displays the latest data point of each series next to its
+// right edge, formatted using the format string, e.g. "%d ms". The format
+// must contain a single verb for an integer value.
+// The columns needed for the widest of the values are reserved and the bars
+// are drawn in the remaining width.
+// The value is drawn in the color of the series by default, the provided cell
+// options override it.
+func ShowCurrentValue(format string, cOpts ...cell.Option) Option {
+	return option(func(opts *options) {
+		opts.valueFormat = format
+		opts.valueCellOpts = cOpts
+	})
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/woodliu/termdash/private/area"
 	"github.com/woodliu/termdash/private/canvas"
 	"github.com/woodliu/termdash/private/draw"
+	"github.com/woodliu/termdash/private/runewidth"
 	"github.com/woodliu/termdash/terminal/terminalapi"
 	"github.com/woodliu/termdash/widgetapi"
 )
@@ -34,11 +35,18 @@ import (
 // Bars can have sub-cell height. The graphs scale adjusts dynamically based on
 // the largest visible value.
 //
+// Multiple series added with AddSeries are stacked vertically, each in its
+// own part of the SparkLine's height.
+//
 // Implements widgetapi.Widget. This object is thread-safe.
 type SparkLine struct {
 	// data are the data points the SparkLine displays.
 	data []int
 
+	// series are the named series added via AddSeries in the order in which
+	// they were first added.
+	series []*series
+
 	// lastWidth is the width of the canvas as of the last time when Draw was called.
 	lastWidth int
 
@@ -70,7 +78,9 @@ func (sl *SparkLine) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	sl.mu.Lock()
 	defer sl.mu.Unlock()
 
-	sl.lastWidth = cvs.Area().Dx()
+	drawn := sl.drawnSeries()
+	values, valueCols := sl.valueLabels(drawn)
+	sl.lastWidth = max(cvs.Area().Dx()-valueCols, 0)
 	needAr, err := area.FromSize(sl.minSize())
 	if err != nil {
 		return err
@@ -80,7 +90,61 @@ func (sl *SparkLine) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	}
 
 	ar := sl.area(cvs)
-	visible, max := visibleMax(sl.data, ar.Dx())
+	barsAr := ar
+	barsAr.Max.X -= valueCols
+	var sharedMax int
+	if sl.opts.sharedScale {
+		sharedMax = sharedVisibleMax(drawn, barsAr.Dx())
+	}
+
+	// Partition the height among the series, the first series get any
+	// remaining rows.
+	curY := ar.Min.Y
+	for i, s := range drawn {
+		height := ar.Dy() / len(drawn)
+		if i < ar.Dy()%len(drawn) {
+			height++
+		}
+		seriesAr := image.Rect(barsAr.Min.X, curY, barsAr.Max.X, curY+height)
+		color := sl.opts.color
+		if s.color != nil {
+			color = *s.color
+		}
+		if err := drawBars(cvs, seriesAr, s.data, sharedMax, color); err != nil {
+			return err
+		}
+		if v := values[i]; v != "" {
+			// Right-aligned on the bottom line of the series.
+			vStart := image.Point{ar.Max.X - runewidth.StringWidth(v), seriesAr.Max.Y - 1}
+			cOpts := append([]cell.Option{cell.FgColor(color)}, sl.opts.valueCellOpts...)
+			if err := draw.Text(cvs, v, vStart, draw.TextCellOpts(cOpts...)); err != nil {
+				return err
+			}
+		}
+		curY += height
+	}
+
+	if sl.opts.label != "" {
+		// Label is placed immediately above the SparkLine.
+		lStart := image.Point{ar.Min.X, ar.Min.Y - 1}
+		if err := draw.Text(cvs, sl.opts.label, lStart,
+			draw.TextCellOpts(sl.opts.labelCellOpts...),
+			draw.TextOverrunMode(draw.OverrunModeThreeDot),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drawBars draws the visible data points as bars in the area. The bars are
+// scaled according to the largest visible data point, unless a positive
+// sharedMax is provided.
+func drawBars(cvs *canvas.Canvas, ar image.Rectangle, data []int, sharedMax int, color cell.Color) error {
+	visible, max := visibleMax(data, ar.Dx())
+	if sharedMax > 0 {
+		max = sharedMax
+	}
 	var curX int
 	if len(visible) < ar.Dx() {
 		curX = ar.Max.X - len(visible)
@@ -90,24 +154,20 @@ func (sl *SparkLine) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 
 	for _, v := range visible {
 		blocks := toBlocks(v, max, ar.Dy())
-		curY := ar.Max.Y - 1
-		for i := 0; i < blocks.full; i++ {
-			if _, err := cvs.SetCell(
-				image.Point{curX, curY},
-				sparks[len(sparks)-1], // Last spark represents full cell.
-				cell.FgColor(sl.opts.color),
-			); err != nil {
-				return err
-			}
-
-			curY--
+		curY := ar.Max.Y - 1 - blocks.full
+		if err := cvs.SetAreaCells(
+			image.Rect(curX, curY+1, curX+1, ar.Max.Y),
+			sparks[len(sparks)-1], // Last spark represents full cell.
+			cell.FgColor(color),
+		); err != nil {
+			return err
 		}
 
 		if blocks.partSpark != 0 {
 			if _, err := cvs.SetCell(
 				image.Point{curX, curY},
 				blocks.partSpark,
-				cell.FgColor(sl.opts.color),
+				cell.FgColor(color),
 			); err != nil {
 				return err
 			}
@@ -115,23 +175,39 @@ func (sl *SparkLine) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 
 		curX++
 	}
+	return nil
+}
 
-	if sl.opts.label != "" {
-		// Label is placed immediately above the SparkLine.
-		lStart := image.Point{ar.Min.X, ar.Min.Y - 1}
-		if err := draw.Text(cvs, sl.opts.label, lStart,
-			draw.TextCellOpts(sl.opts.labelCellOpts...),
-			draw.TextOverrunMode(draw.OverrunModeThreeDot),
-		); err != nil {
-			return err
+// valueLabels returns the formatted current values of the series and the
+// number of columns reserved for them, including one column that separates
+// them from the bars. The value is empty for series without data points.
+// Returns no columns unless the ShowCurrentValue option was provided.
+func (sl *SparkLine) valueLabels(drawn []*series) ([]string, int) {
+	values := make([]string, len(drawn))
+	if sl.opts.valueFormat == "" {
+		return values, 0
+	}
+
+	var widest int
+	for i, s := range drawn {
+		if len(s.data) == 0 {
+			continue
+		}
+		values[i] = fmt.Sprintf(sl.opts.valueFormat, s.data[len(s.data)-1])
+		if w := runewidth.StringWidth(values[i]); w > widest {
+			widest = w
 		}
 	}
-	return nil
+	if widest == 0 {
+		return values, 0
+	}
+	return values, widest + 1
 }
 
 // ValueCapacity returns the number of values that can fit into the canvas.
 // This is essentially the number of available cells on the canvas as observed
-// on the last call to draw. Returns zero if draw wasn't called.
+// on the last call to draw, excluding the columns reserved for the current
+// values. Returns zero if draw wasn't called.
 //
 // Note that this capacity changes each time the terminal resizes, so there is
 // no guarantee this remains the same next time Draw is called.
@@ -142,6 +218,49 @@ func (sl *SparkLine) ValueCapacity() int {
 	return sl.lastWidth
 }
 
+// Scale returns the range of values the SparkLine currently uses when
+// rendering the bars. The minimum is always zero. If the SharedScale option
+// is provided, the maximum is the largest data point visible in any of the
+// drawn series. Otherwise the maximum is the largest visible data point of
+// the topmost drawn series, i.e. of the data points added via Add or of the
+// first series added via AddSeries if there are none. Use SeriesScale for the
+// other series. The visible data points are determined as observed on the
+// last call to Draw, returns zero for both if Draw wasn't called.
+//
+// Note that the maximum changes as data points are added and each time the
+// terminal resizes. Should be used as a hint only.
+func (sl *SparkLine) Scale() (min, max int) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	drawn := sl.drawnSeries()
+	if sl.opts.sharedScale {
+		return 0, sharedVisibleMax(drawn, sl.lastWidth)
+	}
+	_, max = visibleMax(drawn[0].data, sl.lastWidth)
+	return 0, max
+}
+
+// SeriesScale is like Scale, but returns the range of values used when
+// rendering the bars of the named series added via AddSeries.
+// Returns an error if no such series exists.
+func (sl *SparkLine) SeriesScale(name string) (min, max int, err error) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	for _, s := range sl.series {
+		if s.name != name {
+			continue
+		}
+		if sl.opts.sharedScale {
+			return 0, sharedVisibleMax(sl.drawnSeries(), sl.lastWidth), nil
+		}
+		_, max = visibleMax(s.data, sl.lastWidth)
+		return 0, max, nil
+	}
+	return 0, 0, fmt.Errorf("no series named %q", name)
+}
+
 // Add adds data points to the SparkLine.
 // Each data point is represented by one bar on the SparkLine. Zero value data
 // points are valid and are represented by an empty space on the SparkLine
@@ -173,13 +292,115 @@ func (sl *SparkLine) Add(data []int, opts ...Option) error {
 	return nil
 }
 
-// Clear removes all the data points in the SparkLine, effectively returning to
-// an empty graph.
+// series is a named series of data points added via AddSeries.
+type series struct {
+	// name identifies the series.
+	name string
+	// data are the data points of the series.
+	data []int
+	// color is the color of the series, nil to use the Color option.
+	color *cell.Color
+}
+
+// SeriesOption is used to provide options to AddSeries.
+type SeriesOption interface {
+	// set sets the provided option.
+	set(*series)
+}
+
+// seriesOption implements SeriesOption.
+type seriesOption func(*series)
+
+// set implements SeriesOption.set.
+func (so seriesOption) set(s *series) {
+	so(s)
+}
+
+// SeriesColor sets the color of the series.
+// Defaults to the color set by the Color option.
+func SeriesColor(c cell.Color) SeriesOption {
+	return seriesOption(func(s *series) {
+		s.color = &c
+	})
+}
+
+// AddSeries is like Add, but adds the data points to the named series. Each
+// series is displayed as a separate SparkLine stacked vertically within the
+// widget's height, in the order in which the series were first added.
+// The data points added via Add form the topmost series, which is only
+// displayed if it has any data points.
+//
+// Each series scales its bars according to its own largest visible data
+// point, unless the SharedScale option is provided.
+//
+// Provided options override values set on previous calls for the same series.
+func (sl *SparkLine) AddSeries(name string, data []int, opts ...SeriesOption) error {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	if name == "" {
+		return errors.New("the series name cannot be empty")
+	}
+	for i, d := range data {
+		if d < 0 {
+			return fmt.Errorf("data point[%d]: %v must be a positive integer", i, d)
+		}
+	}
+
+	var s *series
+	for _, existing := range sl.series {
+		if existing.name == name {
+			s = existing
+			break
+		}
+	}
+	if s == nil {
+		s = &series{name: name}
+		sl.series = append(sl.series, s)
+	}
+	for _, opt := range opts {
+		opt.set(s)
+	}
+	s.data = append(s.data, data...)
+	return nil
+}
+
+// sharedVisibleMax returns the largest data point visible in any of the
+// series given the canvas width.
+func sharedVisibleMax(drawn []*series, width int) int {
+	var res int
+	for _, s := range drawn {
+		if _, max := visibleMax(s.data, width); max > res {
+			res = max
+		}
+	}
+	return res
+}
+
+// drawnSeries returns the series that should be drawn in the top to bottom
+// order.
+func (sl *SparkLine) drawnSeries() []*series {
+	var res []*series
+	if len(sl.data) > 0 || len(sl.series) == 0 {
+		res = append(res, &series{data: sl.data})
+	}
+	return append(res, sl.series...)
+}
+
+// Clear removes all the data points and series in the SparkLine, effectively
+// returning to an empty graph.
 func (sl *SparkLine) Clear() {
 	sl.mu.Lock()
 	defer sl.mu.Unlock()
 
 	sl.data = nil
+	sl.series = nil
+}
+
+// Reset is equivalent to Clear.
+// Implements widgetapi.Resettable.
+func (sl *SparkLine) Reset() {
+	sl.Clear()
 }
 
 // Keyboard input isn't supported on the SparkLine widget.
@@ -200,7 +421,11 @@ func (sl *SparkLine) area(cvs *canvas.Canvas) image.Rectangle {
 	// Height is determined based on options (fixed height / label).
 	var minY int
 	if sl.opts.height > 0 {
-		minY = maxY - sl.opts.height
+		height := sl.opts.height
+		if n := len(sl.drawnSeries()); height < n {
+			height = n // At least one line of characters for each series.
+		}
+		minY = maxY - height
 	} else {
 		minY = cvsAr.Min.Y
 
@@ -218,7 +443,9 @@ func (sl *SparkLine) area(cvs *canvas.Canvas) image.Rectangle {
 
 // minSize returns the minimum canvas size for the SparkLine based on the options.
 func (sl *SparkLine) minSize() image.Point {
-	const minWidth = 1 // At least one data point.
+	// At least one data point and the current values.
+	_, valueCols := sl.valueLabels(sl.drawnSeries())
+	minWidth := 1 + valueCols
 
 	var minHeight int
 	if sl.opts.height > 0 {
@@ -226,6 +453,9 @@ func (sl *SparkLine) minSize() image.Point {
 	} else {
 		minHeight = 1 // At least one line of characters.
 	}
+	if n := len(sl.drawnSeries()); minHeight < n {
+		minHeight = n // At least one line of characters for each series.
+	}
 
 	if sl.opts.label != "" {
 		minHeight++ // One line for the text label.
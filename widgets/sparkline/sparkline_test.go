@@ -95,6 +95,102 @@ func TestSparkLine(t *testing.T) {
 			},
 			wantCapacity: 9,
 		},
+		{
+			desc: "fails on invalid current value format",
+			opts: []Option{
+				ShowCurrentValue("%d %d"),
+			},
+			update: func(sl *SparkLine) error {
+				return nil
+			},
+			canvas: image.Rect(0, 0, 1, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "draws the current value next to the sparkline",
+			opts: []Option{
+				ShowCurrentValue("%dms"),
+			},
+			update: func(sl *SparkLine) error {
+				return sl.Add([]int{0, 1, 2, 3, 4, 5, 6, 7, 8})
+			},
+			canvas: image.Rect(0, 0, 13, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "▁▂▃▄▅▆▇█", image.Point{1, 0}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "8ms", image.Point{10, 0}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 9,
+		},
+		{
+			desc: "current values of multiple series use their colors and are aligned",
+			opts: []Option{
+				ShowCurrentValue("%d"),
+			},
+			update: func(sl *SparkLine) error {
+				if err := sl.Add([]int{0, 8}); err != nil {
+					return err
+				}
+				return sl.AddSeries("foo", []int{8, 10}, SeriesColor(cell.ColorRed))
+			},
+			canvas: image.Rect(0, 0, 5, 2),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "█", image.Point{1, 0}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "8", image.Point{4, 0}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "▆█", image.Point{0, 1}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorRed),
+				))
+				testdraw.MustText(c, "10", image.Point{3, 1}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorRed),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 2,
+		},
+		{
+			desc: "sets cell options of the current value",
+			opts: []Option{
+				ShowCurrentValue("%d", cell.FgColor(cell.ColorBlue), cell.Bold()),
+			},
+			update: func(sl *SparkLine) error {
+				return sl.Add([]int{8})
+			},
+			canvas: image.Rect(0, 0, 3, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "█", image.Point{0, 0}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "8", image.Point{2, 0}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorBlue),
+					cell.Bold(),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 1,
+		},
 		{
 			desc: "sparkline can be cleared",
 			update: func(sl *SparkLine) error {
@@ -110,6 +206,21 @@ func TestSparkLine(t *testing.T) {
 			},
 			wantCapacity: 9,
 		},
+		{
+			desc: "sparkline can be reset",
+			update: func(sl *SparkLine) error {
+				if err := sl.Add([]int{0, 1, 2, 3, 4, 5, 6, 7, 8}); err != nil {
+					return err
+				}
+				sl.Reset()
+				return nil
+			},
+			canvas: image.Rect(0, 0, 9, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantCapacity: 9,
+		},
 		{
 			desc: "sets sparkline color",
 			opts: []Option{
@@ -451,6 +562,142 @@ func TestSparkLine(t *testing.T) {
 			},
 			wantCapacity: 9,
 		},
+		{
+			desc: "fails on series without a name",
+			update: func(sl *SparkLine) error {
+				return sl.AddSeries("", []int{1})
+			},
+			canvas: image.Rect(0, 0, 1, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantUpdateErr: true,
+		},
+		{
+			desc: "fails on negative data points in a series",
+			update: func(sl *SparkLine) error {
+				return sl.AddSeries("foo", []int{0, -1})
+			},
+			canvas: image.Rect(0, 0, 1, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantUpdateErr: true,
+		},
+		{
+			desc: "series use the whole height when no data were added via Add",
+			update: func(sl *SparkLine) error {
+				return sl.AddSeries("foo", []int{4, 8}, SeriesColor(cell.ColorBlue))
+			},
+			canvas: image.Rect(0, 0, 2, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "▄█", image.Point{0, 0}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorBlue),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 2,
+		},
+		{
+			desc: "stacks multiple series each scaled to its own max",
+			update: func(sl *SparkLine) error {
+				if err := sl.Add([]int{1, 2}); err != nil {
+					return err
+				}
+				if err := sl.AddSeries("foo", []int{4}); err != nil {
+					return err
+				}
+				return sl.AddSeries("foo", []int{8}, SeriesColor(cell.ColorBlue))
+			},
+			canvas: image.Rect(0, 0, 2, 2),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "▄█", image.Point{0, 0}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "▄█", image.Point{0, 1}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorBlue),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 2,
+		},
+		{
+			desc: "stacks multiple series with a shared scale",
+			opts: []Option{
+				SharedScale(),
+			},
+			update: func(sl *SparkLine) error {
+				if err := sl.Add([]int{1, 2}); err != nil {
+					return err
+				}
+				return sl.AddSeries("foo", []int{4, 8}, SeriesColor(cell.ColorBlue))
+			},
+			canvas: image.Rect(0, 0, 2, 2),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "▁▂", image.Point{0, 0}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "▄█", image.Point{0, 1}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorBlue),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 2,
+		},
+		{
+			desc: "remaining rows are given to the top series",
+			update: func(sl *SparkLine) error {
+				if err := sl.Add([]int{2}); err != nil {
+					return err
+				}
+				return sl.AddSeries("foo", []int{8}, SeriesColor(cell.ColorBlue))
+			},
+			canvas: image.Rect(0, 0, 1, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "█", image.Point{0, 0}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "█", image.Point{0, 1}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "█", image.Point{0, 2}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorBlue),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 1,
+		},
+		{
+			desc: "clear removes the series",
+			update: func(sl *SparkLine) error {
+				if err := sl.AddSeries("foo", []int{4, 8}); err != nil {
+					return err
+				}
+				sl.Clear()
+				return nil
+			},
+			canvas: image.Rect(0, 0, 2, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantCapacity: 2,
+		},
 	}
 
 	for _, tc := range tests {
@@ -504,11 +751,165 @@ func TestSparkLine(t *testing.T) {
 	}
 }
 
+func TestScale(t *testing.T) {
+	tests := []struct {
+		desc    string
+		data    []int
+		canvas  image.Rectangle // canvas is empty if Draw shouldn't be called.
+		wantMin int
+		wantMax int
+	}{
+		{
+			desc: "zero before Draw is called",
+			data: []int{1, 2, 3},
+		},
+		{
+			desc:    "zero when there is no data",
+			canvas:  image.Rect(0, 0, 3, 1),
+			wantMax: 0,
+		},
+		{
+			desc:    "maximum of all the data points",
+			data:    []int{1, 5, 3},
+			canvas:  image.Rect(0, 0, 3, 1),
+			wantMax: 5,
+		},
+		{
+			desc:    "maximum of only the visible data points",
+			data:    []int{10, 5, 3},
+			canvas:  image.Rect(0, 0, 2, 1),
+			wantMax: 5,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			sp, err := New()
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if len(tc.data) > 0 {
+				if err := sp.Add(tc.data); err != nil {
+					t.Fatalf("Add => unexpected error: %v", err)
+				}
+			}
+
+			if !tc.canvas.Empty() {
+				c, err := canvas.New(tc.canvas)
+				if err != nil {
+					t.Fatalf("canvas.New => unexpected error: %v", err)
+				}
+				if err := sp.Draw(c, &widgetapi.Meta{}); err != nil {
+					t.Fatalf("Draw => unexpected error: %v", err)
+				}
+			}
+
+			gotMin, gotMax := sp.Scale()
+			if gotMin != tc.wantMin || gotMax != tc.wantMax {
+				t.Errorf("Scale => (%d, %d), want (%d, %d)", gotMin, gotMax, tc.wantMin, tc.wantMax)
+			}
+		})
+	}
+}
+
+func TestScaleWithSeries(t *testing.T) {
+	tests := []struct {
+		desc    string
+		opts    []Option
+		data    []int // Added via Add if not empty.
+		wantMax int
+		// wantSeriesMax are the expected maximums returned by SeriesScale.
+		wantSeriesMax map[string]int
+	}{
+		{
+			desc:    "topmost series when only AddSeries is used",
+			wantMax: 5,
+			wantSeriesMax: map[string]int{
+				"first":  5,
+				"second": 9,
+			},
+		},
+		{
+			desc:    "data points added via Add form the topmost series",
+			data:    []int{1, 2},
+			wantMax: 2,
+			wantSeriesMax: map[string]int{
+				"first":  5,
+				"second": 9,
+			},
+		},
+		{
+			desc:    "shared maximum with SharedScale",
+			opts:    []Option{SharedScale()},
+			wantMax: 9,
+			wantSeriesMax: map[string]int{
+				"first":  9,
+				"second": 9,
+			},
+		},
+		{
+			desc:    "shared maximum includes data points added via Add",
+			opts:    []Option{SharedScale()},
+			data:    []int{20},
+			wantMax: 20,
+			wantSeriesMax: map[string]int{
+				"first":  20,
+				"second": 20,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			sp, err := New(tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if len(tc.data) > 0 {
+				if err := sp.Add(tc.data); err != nil {
+					t.Fatalf("Add => unexpected error: %v", err)
+				}
+			}
+			if err := sp.AddSeries("first", []int{5, 1, 3}); err != nil {
+				t.Fatalf("AddSeries => unexpected error: %v", err)
+			}
+			if err := sp.AddSeries("second", []int{2, 9}); err != nil {
+				t.Fatalf("AddSeries => unexpected error: %v", err)
+			}
+
+			c, err := canvas.New(image.Rect(0, 0, 3, 3))
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+			if err := sp.Draw(c, &widgetapi.Meta{}); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			if gotMin, gotMax := sp.Scale(); gotMin != 0 || gotMax != tc.wantMax {
+				t.Errorf("Scale => (%d, %d), want (0, %d)", gotMin, gotMax, tc.wantMax)
+			}
+			for name, want := range tc.wantSeriesMax {
+				gotMin, gotMax, err := sp.SeriesScale(name)
+				if err != nil {
+					t.Fatalf("SeriesScale(%q) => unexpected error: %v", name, err)
+				}
+				if gotMin != 0 || gotMax != want {
+					t.Errorf("SeriesScale(%q) => (%d, %d), want (0, %d)", name, gotMin, gotMax, want)
+				}
+			}
+			if _, _, err := sp.SeriesScale("unknown"); err == nil {
+				t.Errorf("SeriesScale(unknown) => got nil error, want an error")
+			}
+		})
+	}
+}
+
 func TestOptions(t *testing.T) {
 	tests := []struct {
-		desc string
-		opts []Option
-		want widgetapi.Options
+		desc   string
+		opts   []Option
+		update func(*SparkLine) error // update gets called before getting the options.
+		want   widgetapi.Options
 	}{
 		{
 			desc: "no label and no fixed height",
@@ -554,6 +955,37 @@ func TestOptions(t *testing.T) {
 				WantMouse:    widgetapi.MouseScopeNone,
 			},
 		},
+		{
+			desc: "reserves columns for the current value",
+			opts: []Option{
+				ShowCurrentValue("%d"),
+			},
+			update: func(sl *SparkLine) error {
+				return sl.Add([]int{1, 100})
+			},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{5, 1},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeNone,
+			},
+		},
+		{
+			desc: "at least one line for each series",
+			update: func(sl *SparkLine) error {
+				if err := sl.Add([]int{1}); err != nil {
+					return err
+				}
+				if err := sl.AddSeries("foo", []int{1}); err != nil {
+					return err
+				}
+				return sl.AddSeries("bar", []int{1})
+			},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{1, 3},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeNone,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -562,6 +994,11 @@ func TestOptions(t *testing.T) {
 			if err != nil {
 				t.Fatalf("New => unexpected error: %v", err)
 			}
+			if tc.update != nil {
+				if err := tc.update(sp); err != nil {
+					t.Fatalf("update => unexpected error: %v", err)
+				}
+			}
 			got := sp.Options()
 			if diff := pretty.Compare(tc.want, got); diff != "" {
 				t.Errorf("Options => unexpected diff (-want, +got):\n%s", diff)
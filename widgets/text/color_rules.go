@@ -0,0 +1,124 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+// color_rules.go contains code that colors spans of the content matched by
+// regular expressions.
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/woodliu/termdash/cell"
+	"github.com/woodliu/termdash/private/canvas/buffer"
+)
+
+// ColorRule applies cell options to all the spans of text matched by the
+// regular expression. The expression is matched against each line of the
+// content separately.
+type ColorRule struct {
+	// Regexp matches the spans of text the rule applies to.
+	Regexp *regexp.Regexp
+	// CellOpts are the cell options applied to the matched spans on top of the
+	// options the text was written with.
+	CellOpts []cell.Option
+}
+
+// colorTracker tracks the cell options the color rules apply to the content.
+// The lines are matched once as they are written, only the last line, which
+// can still grow with the next write, is matched again.
+type colorTracker struct {
+	// rules are the color rules to apply.
+	rules []ColorRule
+
+	// colored maps content cells matched by any of the rules to the cell
+	// options of the matching rules.
+	colored map[*buffer.Cell][]cell.Option
+
+	// from is the index of the first content cell of the last line, which
+	// will be matched again when more content is written.
+	from int
+}
+
+// newColorTracker returns a new color tracker for the rules.
+func newColorTracker(rules []ColorRule) *colorTracker {
+	return &colorTracker{
+		rules:   rules,
+		colored: map[*buffer.Cell][]cell.Option{},
+	}
+}
+
+// dropped informs the tracker that the cells were removed from the beginning
+// of the content.
+func (ct *colorTracker) dropped(cells []*buffer.Cell) {
+	for _, c := range cells {
+		delete(ct.colored, c)
+	}
+	ct.from -= len(cells)
+	if ct.from < 0 {
+		ct.from = 0
+	}
+}
+
+// update matches the rules against the lines of the content that changed
+// since the last update.
+func (ct *colorTracker) update(content []*buffer.Cell) {
+	if len(ct.rules) == 0 {
+		return
+	}
+
+	start := ct.from
+	for start < len(content) {
+		end := start
+		for end < len(content) && content[end].Rune != '\n' {
+			end++
+		}
+		ct.colorLine(content[start:end])
+		if end == len(content) {
+			break // The last line can still grow.
+		}
+		start = end + 1
+	}
+	ct.from = start
+}
+
+// colorLine matches the rules against a single line of content.
+func (ct *colorTracker) colorLine(line []*buffer.Cell) {
+	var (
+		b strings.Builder
+		// byteToIdx maps byte offsets in the line's text to indexes of cells.
+		byteToIdx = map[int]int{}
+	)
+	for i, c := range line {
+		delete(ct.colored, c)
+		byteToIdx[b.Len()] = i
+		b.WriteRune(c.Rune)
+	}
+	byteToIdx[b.Len()] = len(line)
+
+	text := b.String()
+	for _, r := range ct.rules {
+		for _, m := range r.Regexp.FindAllStringIndex(text, -1) {
+			for _, c := range line[byteToIdx[m[0]]:byteToIdx[m[1]]] {
+				ct.colored[c] = append(ct.colored[c], r.CellOpts...)
+			}
+		}
+	}
+}
+
+// opts returns the cell options the rules apply to the content cell.
+func (ct *colorTracker) opts(c *buffer.Cell) []cell.Option {
+	return ct.colored[c]
+}
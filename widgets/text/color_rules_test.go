@@ -0,0 +1,105 @@
+// Copyright 2024 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/woodliu/termdash/cell"
+	"github.com/woodliu/termdash/private/canvas/buffer"
+)
+
+func TestColorTracker(t *testing.T) {
+	tests := []struct {
+		desc   string
+		writes []string
+		// drop is the number of cells dropped from the beginning of the
+		// content after the writes.
+		drop int
+		// wantColored are the indexes of the colored content cells.
+		wantColored []int
+		// wantFrom is the index of the first cell of the line that will be
+		// matched again.
+		wantFrom int
+	}{
+		{
+			desc:     "no content",
+			wantFrom: 0,
+		},
+		{
+			desc:        "last line is matched again on the next update",
+			writes:      []string{"a1", "2b"},
+			wantColored: []int{1, 2},
+			wantFrom:    0,
+		},
+		{
+			desc:        "complete lines aren't matched again",
+			writes:      []string{"1\na", "a\n22"},
+			wantColored: []int{0, 5, 6},
+			wantFrom:    5,
+		},
+		{
+			desc:        "content ending with a newline starts a new line",
+			writes:      []string{"1\n"},
+			wantColored: []int{0},
+			wantFrom:    2,
+		},
+		{
+			desc:        "dropped cells are forgotten",
+			writes:      []string{"1\n2\n3"},
+			drop:        2,
+			wantColored: []int{0, 2},
+			wantFrom:    2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ct := newColorTracker([]ColorRule{
+				{Regexp: regexp.MustCompile(`\d+`), CellOpts: []cell.Option{cell.FgColor(cell.ColorRed)}},
+			})
+
+			var content []*buffer.Cell
+			for _, w := range tc.writes {
+				content = append(content, buffer.NewCells(w)...)
+				ct.update(content)
+			}
+			ct.dropped(content[:tc.drop])
+			content = content[tc.drop:]
+
+			var gotColored []int
+			for i, c := range content {
+				if len(ct.opts(c)) > 0 {
+					gotColored = append(gotColored, i)
+				}
+			}
+			if len(ct.colored) != len(gotColored) {
+				t.Errorf("colorTracker tracks %d cells, want %d", len(ct.colored), len(gotColored))
+			}
+			if got, want := len(gotColored), len(tc.wantColored); got != want {
+				t.Fatalf("colored cells %v, want %v", gotColored, tc.wantColored)
+			}
+			for i := range gotColored {
+				if gotColored[i] != tc.wantColored[i] {
+					t.Fatalf("colored cells %v, want %v", gotColored, tc.wantColored)
+				}
+			}
+			if ct.from != tc.wantFrom {
+				t.Errorf("from => %d, want %d", ct.from, tc.wantFrom)
+			}
+		})
+	}
+}
@@ -44,6 +44,7 @@ type options struct {
 	keyDown          keyboard.Key
 	keyPgUp          keyboard.Key
 	keyPgDown        keyboard.Key
+	colorRules       []ColorRule
 }
 
 // newOptions returns a new options instance.
@@ -82,6 +83,11 @@ func (o *options) validate() error {
 	if o.maxTextCells < 0 {
 		return fmt.Errorf("invalid MaxTextCells(%d), must be zero or a positive integer", o.maxTextCells)
 	}
+	for i, r := range o.colorRules {
+		if r.Regexp == nil {
+			return fmt.Errorf("invalid ColorRules, rule[%d] has a nil Regexp", i)
+		}
+	}
 	return nil
 }
 
@@ -199,3 +205,13 @@ func MaxTextCells(max int) Option {
 		opts.maxTextCells = max
 	})
 }
+
+// ColorRules applies the cell options of the rules to the spans of text their
+// regular expressions match on each line of the content. The rules apply in the
+// order provided, so where matches overlap the options of the later rule take
+// precedence. Lines are matched once as they are written, not on every redraw.
+func ColorRules(rules []ColorRule) Option {
+	return option(func(opts *options) {
+		opts.colorRules = rules
+	})
+}
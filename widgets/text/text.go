@@ -21,6 +21,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/woodliu/termdash/cell"
 	"github.com/woodliu/termdash/private/canvas"
 	"github.com/woodliu/termdash/private/canvas/buffer"
 	"github.com/woodliu/termdash/private/runewidth"
@@ -49,6 +50,9 @@ type Text struct {
 	// scroll tracks scrolling the position.
 	scroll *scrollTracker
 
+	// colors tracks the cell options the color rules apply to the content.
+	colors *colorTracker
+
 	// lastWidth stores the width of the last canvas the widget drew on.
 	// Used to determine if the previous line wrapping was invalidated.
 	lastWidth int
@@ -57,6 +61,9 @@ type Text struct {
 	// invalidated.
 	contentChanged bool
 
+	// highlights are the substrings highlighted in the content.
+	highlights []*highlight
+
 	// mu protects the Text widget.
 	mu sync.Mutex
 
@@ -72,11 +79,13 @@ func New(opts ...Option) (*Text, error) {
 	}
 	return &Text{
 		scroll: newScrollTracker(opt),
+		colors: newColorTracker(opt.colorRules),
 		opts:   opt,
 	}, nil
 }
 
 // Reset resets the widget back to empty content.
+// Implements widgetapi.Resettable.
 func (t *Text) Reset() {
 	t.mu.Lock()
 	defer t.mu.Unlock()
@@ -88,8 +97,12 @@ func (t *Text) reset() {
 	t.content = nil
 	t.wrapped = nil
 	t.scroll = newScrollTracker(t.opts)
+	t.colors = newColorTracker(t.opts.colorRules)
 	t.lastWidth = 0
 	t.contentChanged = true
+	for _, h := range t.highlights {
+		h.reset()
+	}
 }
 
 // contentCells calculates the number of cells the content takes to display on
@@ -129,6 +142,10 @@ func (t *Text) Write(text string, wOpts ...WriteOption) error {
 	// If MaxTextCells has been set, limit the content if needed.
 	if t.opts.maxTextCells > 0 && contentCells+textCells > t.opts.maxTextCells {
 		diff := contentCells + textCells - t.opts.maxTextCells
+		t.colors.dropped(t.content[:diff])
+		for _, h := range t.highlights {
+			h.dropped(t.content[:diff])
+		}
 		t.content = t.content[diff:]
 	}
 
@@ -136,9 +153,121 @@ func (t *Text) Write(text string, wOpts ...WriteOption) error {
 		t.content = append(t.content, buffer.NewCell(r, opts.cellOpts))
 	}
 	t.contentChanged = true
+	t.colors.update(t.content)
+	for _, h := range t.highlights {
+		t.findHighlight(h)
+	}
 	return nil
 }
 
+// highlight is a substring highlighted in the content.
+// The content is scanned once as it is written, only the cells that can still
+// be a prefix of an occurrence completed by the next write are scanned again.
+type highlight struct {
+	substr   []rune
+	cellOpts []cell.Option
+
+	// cells are the content cells that belong to an occurrence of the
+	// substring.
+	cells map[*buffer.Cell]bool
+
+	// next is the index of the content cell the next scan starts at.
+	next int
+}
+
+// newHighlight returns a new highlight of the substring.
+func newHighlight(substr string, opts []cell.Option) *highlight {
+	return &highlight{
+		substr:   []rune(substr),
+		cellOpts: opts,
+		cells:    map[*buffer.Cell]bool{},
+	}
+}
+
+// reset forgets all the occurrences found so far.
+func (h *highlight) reset() {
+	h.cells = map[*buffer.Cell]bool{}
+	h.next = 0
+}
+
+// dropped informs the highlight that the cells were removed from the
+// beginning of the content.
+func (h *highlight) dropped(cells []*buffer.Cell) {
+	for _, c := range cells {
+		delete(h.cells, c)
+	}
+	h.next -= len(cells)
+	if h.next < 0 {
+		h.next = 0
+	}
+}
+
+// Highlight marks all the occurrences of the substring in the content with the
+// provided cell options, which apply on top of the options the text was
+// written with. The matching is literal and also applies to text written
+// later, until ClearHighlights is called. If multiple highlighted substrings
+// overlap, the options of the one highlighted last take precedence.
+// An empty substring is ignored.
+func (t *Text) Highlight(substr string, opts ...cell.Option) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if substr == "" {
+		return
+	}
+	h := newHighlight(substr, opts)
+	t.highlights = append(t.highlights, h)
+	t.findHighlight(h)
+}
+
+// ClearHighlights removes all the highlights set by Highlight.
+func (t *Text) ClearHighlights() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.highlights = nil
+}
+
+// findHighlight scans the content that wasn't scanned yet for occurrences of
+// the highlighted substring. Caller must hold t.mu.
+func (t *Text) findHighlight(h *highlight) {
+	i := h.next
+	for i+len(h.substr) <= len(t.content) {
+		if !t.matchesAt(i, h.substr) {
+			i++
+			continue
+		}
+		for _, c := range t.content[i : i+len(h.substr)] {
+			h.cells[c] = true
+		}
+		i += len(h.substr)
+	}
+	h.next = i
+}
+
+// drawOpts returns the cell options used to draw the content cell, including
+// any color rules and highlights. Caller must hold t.mu.
+func (t *Text) drawOpts(c *buffer.Cell) []cell.Option {
+	opts := append([]cell.Option{c.Opts}, t.colors.opts(c)...)
+	for _, h := range t.highlights {
+		if h.cells[c] {
+			opts = append(opts, h.cellOpts...)
+		}
+	}
+	return opts
+}
+
+// matchesAt asserts whether the content starting at the index matches the
+// substring. Caller must hold t.mu.
+func (t *Text) matchesAt(idx int, substr []rune) bool {
+	for i, r := range substr {
+		if t.content[idx+i].Rune != r {
+			return false
+		}
+	}
+	return true
+}
+
 // minLinesForMarkers are the minimum amount of lines required on the canvas in
 // order to draw the scroll markers ('⇧' and '⇩').
 const minLinesForMarkers = 3
@@ -217,7 +346,7 @@ func (t *Text) draw(cvs *canvas.Canvas) error {
 				break // Skip over any characters trimmed on the current line.
 			}
 
-			cells, err := cvs.SetCell(cur, cell.Rune, cell.Opts)
+			cells, err := cvs.SetCell(cur, cell.Rune, t.drawOpts(cell)...)
 			if err != nil {
 				return err
 			}
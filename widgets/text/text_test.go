@@ -16,6 +16,7 @@ package text
 
 import (
 	"image"
+	"regexp"
 	"testing"
 
 	"github.com/kylelemons/godebug/pretty"
@@ -65,6 +66,17 @@ func TestTextDraws(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			desc: "fails when a color rule has no regular expression",
+			opts: []Option{
+				ColorRules([]ColorRule{{CellOpts: []cell.Option{cell.FgColor(cell.ColorRed)}}}),
+			},
+			canvas: image.Rect(0, 0, 1, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
 		{
 			desc: "fails when scroll mouse buttons aren't unique",
 			opts: []Option{
@@ -224,6 +236,133 @@ func TestTextDraws(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:   "highlights all occurrences of a substring including in text written later",
+			canvas: image.Rect(0, 0, 10, 2),
+			writes: func(widget *Text) error {
+				widget.Highlight("ERROR", cell.FgColor(cell.ColorRed))
+				if err := widget.Write("ERROR a\nok ERR"); err != nil {
+					return err
+				}
+				return widget.Write("OR x", WriteCellOpts(cell.Bold()))
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "ERROR", image.Point{0, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustText(c, " a", image.Point{5, 0})
+				testdraw.MustText(c, "ok ", image.Point{0, 1})
+				testdraw.MustText(c, "ERR", image.Point{3, 1}, draw.TextCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustText(c, "OR", image.Point{6, 1}, draw.TextCellOpts(cell.FgColor(cell.ColorRed), cell.Bold()))
+				testdraw.MustText(c, " x", image.Point{8, 1}, draw.TextCellOpts(cell.Bold()))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "clearing highlights draws the content with the write options",
+			canvas: image.Rect(0, 0, 10, 1),
+			writes: func(widget *Text) error {
+				widget.Highlight("hello", cell.FgColor(cell.ColorRed))
+				if err := widget.Write("hello", WriteCellOpts(cell.FgColor(cell.ColorBlue))); err != nil {
+					return err
+				}
+				widget.ClearHighlights()
+				return nil
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "hello", image.Point{0, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorBlue)))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "colors spans matched by the color rules, including spans across writes",
+			opts: []Option{
+				ColorRules([]ColorRule{
+					{Regexp: regexp.MustCompile(`\d+`), CellOpts: []cell.Option{cell.FgColor(cell.ColorBlue)}},
+					{Regexp: regexp.MustCompile(`ERROR`), CellOpts: []cell.Option{cell.FgColor(cell.ColorRed)}},
+				}),
+			},
+			canvas: image.Rect(0, 0, 10, 2),
+			writes: func(widget *Text) error {
+				if err := widget.Write("12 ERROR\n"); err != nil {
+					return err
+				}
+				if err := widget.Write("ok 3"); err != nil {
+					return err
+				}
+				return widget.Write("4 x")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "12", image.Point{0, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorBlue)))
+				testdraw.MustText(c, " ", image.Point{2, 0})
+				testdraw.MustText(c, "ERROR", image.Point{3, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustText(c, "ok ", image.Point{0, 1})
+				testdraw.MustText(c, "34", image.Point{3, 1}, draw.TextCellOpts(cell.FgColor(cell.ColorBlue)))
+				testdraw.MustText(c, " x", image.Point{5, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "color rules apply to content limited by MaxTextCells",
+			opts: []Option{
+				MaxTextCells(5),
+				ColorRules([]ColorRule{
+					{Regexp: regexp.MustCompile(`b+`), CellOpts: []cell.Option{cell.FgColor(cell.ColorBlue)}},
+				}),
+			},
+			canvas: image.Rect(0, 0, 5, 1),
+			writes: func(widget *Text) error {
+				if err := widget.Write("aab"); err != nil {
+					return err
+				}
+				return widget.Write("bba")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "a", image.Point{0, 0})
+				testdraw.MustText(c, "bbb", image.Point{1, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorBlue)))
+				testdraw.MustText(c, "a", image.Point{4, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "highlights apply to content limited by MaxTextCells",
+			opts: []Option{
+				MaxTextCells(6),
+			},
+			canvas: image.Rect(0, 0, 6, 1),
+			writes: func(widget *Text) error {
+				widget.Highlight("ab", cell.FgColor(cell.ColorRed))
+				if err := widget.Write("abxa"); err != nil {
+					return err
+				}
+				return widget.Write("bxab")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "x", image.Point{0, 0})
+				testdraw.MustText(c, "ab", image.Point{1, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustText(c, "x", image.Point{3, 0})
+				testdraw.MustText(c, "ab", image.Point{4, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorRed)))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc:   "trims long lines",
 			canvas: image.Rect(0, 0, 10, 4),
@@ -563,6 +702,49 @@ func TestTextDraws(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:   "rolls content upwards by the lines wrapped at word boundaries",
+			canvas: image.Rect(0, 0, 10, 2),
+			opts: []Option{
+				RollContent(),
+				WrapAtWords(),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("hello world and more")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "world and", image.Point{0, 0})
+				testdraw.MustText(c, "more", image.Point{0, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "scrolls down through the lines wrapped at word boundaries",
+			canvas: image.Rect(0, 0, 10, 1),
+			opts: []Option{
+				WrapAtWords(),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("hello world and more")
+			},
+			events: func(widget *Text) {
+				widget.Mouse(&terminalapi.Mouse{
+					Button: mouse.ButtonWheelDown,
+				}, &widgetapi.EventMeta{})
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "world and", image.Point{0, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc:   "rolls content upwards and trims lines",
 			canvas: image.Rect(0, 0, 10, 2),
@@ -19,6 +19,7 @@ package textinput
 import (
 	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/woodliu/termdash/private/numbers"
 	"github.com/woodliu/termdash/private/runewidth"
@@ -49,9 +50,51 @@ func (fd *fieldData) deleteAt(idx int) {
 	*fd = append((*fd)[:idx], (*fd)[idx+1:]...)
 }
 
+// deleteRange deletes the runes in the range start <= idx < end.
+func (fd *fieldData) deleteRange(start, end int) {
+	*fd = append((*fd)[:start], (*fd)[end:]...)
+}
+
+// wordStartBefore returns the index of the first rune of the word before the
+// idx. Words are runs of non-space runes. Spaces between idx and the word are
+// skipped.
+func (fd *fieldData) wordStartBefore(idx int) int {
+	i := idx
+	for i > 0 && unicode.IsSpace((*fd)[i-1]) {
+		i--
+	}
+	for i > 0 && !unicode.IsSpace((*fd)[i-1]) {
+		i--
+	}
+	return i
+}
+
+// wordEndAfter returns the index right after the last rune of the word after
+// the idx. Words are runs of non-space runes. Spaces between idx and the word
+// are skipped.
+func (fd *fieldData) wordEndAfter(idx int) int {
+	i := idx
+	for i < len(*fd) && unicode.IsSpace((*fd)[i]) {
+		i++
+	}
+	for i < len(*fd) && !unicode.IsSpace((*fd)[i]) {
+		i++
+	}
+	return i
+}
+
+// runeCells returns the number of cells the rune takes when displayed in the
+// text input field. Tabs are expanded to tabWidth cells.
+func runeCells(r rune, tabWidth int) int {
+	if r == '\t' {
+		return tabWidth
+	}
+	return runewidth.RuneWidth(r)
+}
+
 // cellsBefore given an endIdx calculates startIdx that results in range that
 // will take at most the provided number of cells to print on the screen.
-func (fd *fieldData) cellsBefore(cells, endIdx int) int {
+func (fd *fieldData) cellsBefore(cells, endIdx, tabWidth int) int {
 	if endIdx == 0 {
 		return 0
 	}
@@ -59,7 +102,7 @@ func (fd *fieldData) cellsBefore(cells, endIdx int) int {
 	usedCells := 0
 	for i := endIdx; i > 0; i-- {
 		prev := (*fd)[i-1]
-		width := runewidth.RuneWidth(prev)
+		width := runeCells(prev, tabWidth)
 
 		if usedCells+width > cells {
 			return i
@@ -71,16 +114,16 @@ func (fd *fieldData) cellsBefore(cells, endIdx int) int {
 
 // cellsAfter given a startIdx calculates endIdx that results in range that
 // will take at most the provided number of cells to print on the screen.
-func (fd *fieldData) cellsAfter(cells, startIdx int) int {
+func (fd *fieldData) cellsAfter(cells, startIdx, tabWidth int) int {
 	if startIdx >= len(*fd) || cells == 0 {
 		return startIdx
 	}
 
 	first := (*fd)[startIdx]
-	usedCells := runewidth.RuneWidth(first)
+	usedCells := runeCells(first, tabWidth)
 	for i := startIdx + 1; i < len(*fd); i++ {
 		r := (*fd)[i]
-		width := runewidth.RuneWidth(r)
+		width := runeCells(r, tabWidth)
 		if usedCells+width > cells {
 			return i
 		}
@@ -126,7 +169,7 @@ func curMaxIdx(start, end, cells, runeCount int) int {
 // cursor.
 // The visible range includes all fieldData indexes
 // in range start <= idx < end.
-func (fd *fieldData) shiftLeft(start, cells, curDataPos int) (int, int) {
+func (fd *fieldData) shiftLeft(start, cells, curDataPos, tabWidth int) (int, int) {
 	var startIdx int
 	switch {
 	case curDataPos == 0 || cells < minForArrows:
@@ -136,7 +179,7 @@ func (fd *fieldData) shiftLeft(start, cells, curDataPos int) (int, int) {
 		startIdx = curDataPos - 1
 	}
 	forRunes := cells - 1
-	endIdx := fd.cellsAfter(forRunes, startIdx)
+	endIdx := fd.cellsAfter(forRunes, startIdx, tabWidth)
 	endIdx++ // Space for the cursor.
 
 	return startIdx, endIdx
@@ -146,7 +189,7 @@ func (fd *fieldData) shiftLeft(start, cells, curDataPos int) (int, int) {
 // cursor.
 // The visible range includes all fieldData indexes
 // in range start <= idx < end.
-func (fd *fieldData) shiftRight(start, cells, curDataPos int) (int, int) {
+func (fd *fieldData) shiftRight(start, cells, curDataPos, tabWidth int) (int, int) {
 	var endIdx int
 	switch dataLen := len(*fd); {
 	case curDataPos == dataLen:
@@ -161,13 +204,13 @@ func (fd *fieldData) shiftRight(start, cells, curDataPos int) (int, int) {
 	}
 
 	forRunes := cells - 1
-	startIdx := fd.cellsBefore(forRunes, endIdx)
+	startIdx := fd.cellsBefore(forRunes, endIdx, tabWidth)
 
 	// Invariant, if counting form the back ends in the middle of a full-width
 	// rune, cellsAfter doesn't include the full-width rune. This means that we
 	// might have recovered space for one half-with rune at the end if there is
 	// one.
-	endIdx = fd.cellsAfter(forRunes, startIdx)
+	endIdx = fd.cellsAfter(forRunes, startIdx, tabWidth)
 	endIdx++ // Space for the cursor.
 
 	return startIdx, endIdx
@@ -200,20 +243,22 @@ func (fd *fieldData) runesIn(start, end int) []rune {
 // position used for appending new runes.
 // This might return smaller number of runes than the size of the range,
 // depending on the width of the individual runes.
+// Tabs are expanded to tabWidth spaces.
+// Hidden text is indicated by the leftArrow and rightArrow runes.
 // Returns the text and the start and end positions within the data.
-func (fd *fieldData) fitRunes(firstRune, curPos, cells int) (string, int, int) {
+func (fd *fieldData) fitRunes(firstRune, curPos, cells, tabWidth int, leftArrow, rightArrow rune) (string, int, int) {
 	forRunes := cells - 1 // One cell reserved for the cursor when appending.
 
 	// Determine how many runes fit from the start.
 	start := firstRune
-	end := fd.cellsAfter(forRunes, start)
+	end := fd.cellsAfter(forRunes, start, tabWidth)
 	end++
 
 	if start > 0 && fd.lastVisible(end) {
 		// Start is in the middle, end is visible.
 		// Fit runes from the end.
 		end = len(*fd)
-		start = fd.cellsBefore(forRunes, end)
+		start = fd.cellsBefore(forRunes, end, tabWidth)
 		end++ // Space for the cursor within the visible range.
 	}
 
@@ -222,9 +267,9 @@ func (fd *fieldData) fitRunes(firstRune, curPos, cells int) (string, int, int) {
 	// to begin with (due to cursorLeft() or cursorRight() calls).
 	// Shift the range so the cursor is again inside.
 	if curPos < curMinIdx(start, cells) {
-		start, end = fd.shiftLeft(start, cells, curPos)
+		start, end = fd.shiftLeft(start, cells, curPos, tabWidth)
 	} else if curPos > curMaxIdx(start, end, cells, len(*fd)) {
-		start, end = fd.shiftRight(start, cells, curPos)
+		start, end = fd.shiftRight(start, cells, curPos, tabWidth)
 	}
 
 	runes := fd.runesIn(start, end)
@@ -235,12 +280,13 @@ func (fd *fieldData) fitRunes(firstRune, curPos, cells int) (string, int, int) {
 		case useArrows && i == 0 && start > 0:
 			// Indicate that start is hidden by replacing the first visible
 			// rune with an arrow.
-			b.WriteRune('⇦')
-			if rw := runewidth.RuneWidth(r); rw == 2 {
-				// If the replaced rune was a full-width rune, place two arrows
-				// to keep the same space allocation as pre-calculated.
-				b.WriteRune('⇦')
-			}
+			// If the replaced rune was a full-width rune or a tab, place
+			// multiple arrows to keep the same space allocation as
+			// pre-calculated.
+			b.WriteString(strings.Repeat(string(leftArrow), runeCells(r, tabWidth)))
+
+		case r == '\t':
+			b.WriteString(strings.Repeat(" ", tabWidth))
 
 		default:
 			b.WriteRune(r)
@@ -251,7 +297,7 @@ func (fd *fieldData) fitRunes(firstRune, curPos, cells int) (string, int, int) {
 		// Indicate that end is hidden by placing an arrow at the end.
 		// THis has no impact on space allocation, since the last cell is
 		// always reserved for the cursor or the arrow.
-		b.WriteRune('⇨')
+		b.WriteRune(rightArrow)
 	}
 	return b.String(), start, end
 }
@@ -277,11 +323,37 @@ type fieldEditor struct {
 
 	// onChange if provided is the handler called when fieldData changes
 	onChange ChangeFn
+
+	// tabWidth is the number of cells a tab takes when displayed.
+	// Zero if tabs aren't allowed.
+	tabWidth int
+
+	// maxLength is the maximum number of runes in the data.
+	// Zero if the length isn't limited.
+	maxLength int
+
+	// leftArrow and rightArrow are the runes that indicate text hidden on the
+	// left and on the right of the visible range.
+	leftArrow  rune
+	rightArrow rune
+
+	// selAnchor is the position within the data where the selection starts.
+	// The selection spans from the anchor to the cursor.
+	// Set to noSelection when no text is selected.
+	selAnchor int
 }
 
+// noSelection is the value of selAnchor when no text is selected.
+const noSelection = -1
+
 // newFieldEditor returns a new fieldEditor instance.
 func newFieldEditor(onChange ChangeFn) *fieldEditor {
-	return &fieldEditor{onChange: onChange}
+	return &fieldEditor{
+		onChange:   onChange,
+		leftArrow:  DefaultScrollLeftRune,
+		rightArrow: DefaultScrollRightRune,
+		selAnchor:  noSelection,
+	}
 }
 
 // minFieldWidth is the minimum supported width of the text input field.
@@ -289,11 +361,18 @@ const minFieldWidth = 4
 
 // curCell returns the index of the cell the cursor is in within the text input field.
 func (fe *fieldEditor) curCell(width int) int {
+	return fe.cellOf(fe.curDataPos, width)
+}
+
+// cellOf returns the index of the cell within the text input field where the
+// rune at the data position idx starts. Positions before the visible range
+// map to the first cell.
+func (fe *fieldEditor) cellOf(idx, width int) int {
 	if width == 0 {
 		return 0
 	}
-	// The index of rune within the visible range the cursor is at.
-	runeNum := fe.curDataPos - fe.firstRune
+	// The index of rune within the visible range.
+	runeNum := idx - fe.firstRune
 
 	cellNum := 0
 	rn := 0
@@ -305,7 +384,7 @@ func (fe *fieldEditor) curCell(width int) int {
 			break
 		}
 		rn++
-		cellNum += runewidth.RuneWidth(r)
+		cellNum += runeCells(r, fe.tabCells(width))
 	}
 	return cellNum
 }
@@ -316,12 +395,85 @@ func (fe *fieldEditor) viewFor(width int) (string, int, error) {
 	if min := minFieldWidth; width < min { // One for left arrow, two for one full-width rune and one for the cursor.
 		return "", -1, fmt.Errorf("width %d is too small, the minimum is %d", width, min)
 	}
-	runes, start, _ := fe.data.fitRunes(fe.firstRune, fe.curDataPos, width)
+	runes, start, _ := fe.data.fitRunes(fe.firstRune, fe.curDataPos, width, fe.tabCells(width), fe.leftArrow, fe.rightArrow)
 	fe.firstRune = start
 	fe.width = width
 	return runes, fe.curCell(width), nil
 }
 
+// tabCells returns the number of cells a tab takes when displayed in a text
+// field with the specified width. Tabs wider than a full-width rune on the
+// narrowest field are shrunk so they always fit next to the arrow and the
+// cursor.
+func (fe *fieldEditor) tabCells(width int) int {
+	if max := width - 2; fe.tabWidth > max {
+		return max
+	}
+	return fe.tabWidth
+}
+
+// selection returns the start and the end of the selected range within the
+// data, the start is inclusive and the end exclusive. Start equals end if
+// nothing is selected.
+func (fe *fieldEditor) selection() (int, int) {
+	if fe.selAnchor == noSelection {
+		return fe.curDataPos, fe.curDataPos
+	}
+	if fe.selAnchor > fe.curDataPos {
+		return fe.curDataPos, fe.selAnchor
+	}
+	return fe.selAnchor, fe.curDataPos
+}
+
+// selectedCells returns the range of cells within a text input field of the
+// specified width that display the selected text. The start is inclusive and
+// the end exclusive. Start equals end if no visible text is selected.
+// Only valid after a call to viewFor with the same width.
+func (fe *fieldEditor) selectedCells(width int) (int, int) {
+	start, end := fe.selection()
+	if start == end || end <= fe.firstRune {
+		return 0, 0
+	}
+	startCell := fe.cellOf(start, width)
+	endCell := fe.cellOf(end, width)
+	if max := width - 1; endCell > max {
+		// The last cell is reserved for the cursor or the arrow.
+		endCell = max
+	}
+	return startCell, endCell
+}
+
+// selected returns the selected text.
+func (fe *fieldEditor) selected() string {
+	start, end := fe.selection()
+	return string(fe.data[start:end])
+}
+
+// selectAll selects all the data and moves the cursor to the end.
+func (fe *fieldEditor) selectAll() {
+	fe.selAnchor = 0
+	fe.curDataPos = len(fe.data)
+}
+
+// clearSelection deselects the selected text, if any.
+func (fe *fieldEditor) clearSelection() {
+	fe.selAnchor = noSelection
+}
+
+// cutSelection deletes the selected text and moves the cursor to where the
+// selection started. Doesn't call the onChange handler.
+// Returns true if any text was deleted.
+func (fe *fieldEditor) cutSelection() bool {
+	start, end := fe.selection()
+	fe.clearSelection()
+	if start == end {
+		return false
+	}
+	fe.data.deleteRange(start, end)
+	fe.curDataPos = start
+	return true
+}
+
 // content returns the string content in the field editor.
 func (fe *fieldEditor) content() string {
 	return string(fe.data)
@@ -329,37 +481,53 @@ func (fe *fieldEditor) content() string {
 
 // reset resets the content back to zero.
 func (fe *fieldEditor) reset() {
+	tabWidth, maxLength := fe.tabWidth, fe.maxLength
+	leftArrow, rightArrow := fe.leftArrow, fe.rightArrow
 	*fe = *newFieldEditor(fe.onChange)
+	fe.tabWidth, fe.maxLength = tabWidth, maxLength
+	fe.leftArrow, fe.rightArrow = leftArrow, rightArrow
 }
 
 // insert inserts the rune at the current position of the cursor.
+// Does nothing if the data already reached the maximum length.
+// Replaces the selected text if any.
 func (fe *fieldEditor) insert(r rune) {
-	rw := runewidth.RuneWidth(r)
+	rw := runeCells(r, fe.tabWidth)
 	if rw == 0 {
-		// Don't insert invisible runes.
+		// Don't insert invisible runes or tabs when they aren't allowed.
+		return
+	}
+	fe.cutSelection()
+	if fe.maxLength > 0 && len(fe.data) >= fe.maxLength {
 		return
 	}
 	fe.data.insertAt(fe.curDataPos, r)
 	fe.curDataPos++
-	if fe.onChange != nil {
-		fe.onChange(string(fe.data))
-	}
+	fe.changed()
 }
 
-// delete deletes the rune at the current position of the cursor.
+// delete deletes the rune at the current position of the cursor or the
+// selected text if any.
 func (fe *fieldEditor) delete() {
+	if fe.cutSelection() {
+		fe.changed()
+		return
+	}
 	if fe.curDataPos >= len(fe.data) {
 		// Cursor not on a rune, nothing to do.
 		return
 	}
 	fe.data.deleteAt(fe.curDataPos)
-	if fe.onChange != nil {
-		fe.onChange(string(fe.data))
-	}
+	fe.changed()
 }
 
-// deleteBefore deletes the rune that is immediately to the left of the cursor.
+// deleteBefore deletes the rune that is immediately to the left of the cursor
+// or the selected text if any.
 func (fe *fieldEditor) deleteBefore() {
+	if fe.cutSelection() {
+		fe.changed()
+		return
+	}
 	if fe.curDataPos == 0 {
 		// Cursor at the beginning, nothing to do.
 		return
@@ -368,23 +536,75 @@ func (fe *fieldEditor) deleteBefore() {
 	fe.delete()
 }
 
+// deleteWordBefore deletes the word before the cursor and any spaces between
+// the word and the cursor or the selected text if any.
+func (fe *fieldEditor) deleteWordBefore() {
+	if fe.cutSelection() {
+		fe.changed()
+		return
+	}
+	start := fe.data.wordStartBefore(fe.curDataPos)
+	if start == fe.curDataPos {
+		// Cursor at the beginning, nothing to do.
+		return
+	}
+	fe.data.deleteRange(start, fe.curDataPos)
+	fe.curDataPos = start
+	fe.changed()
+}
+
+// deleteAll deletes all the data.
+func (fe *fieldEditor) deleteAll() {
+	fe.clearSelection()
+	if len(fe.data) == 0 {
+		return
+	}
+	fe.data = nil
+	fe.curDataPos = 0
+	fe.firstRune = 0
+	fe.changed()
+}
+
+// changed calls the onChange handler if provided.
+func (fe *fieldEditor) changed() {
+	if fe.onChange != nil {
+		fe.onChange(string(fe.data))
+	}
+}
+
 // cursorRight moves the cursor one position to the right.
 func (fe *fieldEditor) cursorRight() {
+	fe.clearSelection()
 	fe.curDataPos, _ = numbers.MinMaxInts([]int{fe.curDataPos + 1, len(fe.data)})
 }
 
 // cursorLeft moves the cursor one position to the left.
 func (fe *fieldEditor) cursorLeft() {
+	fe.clearSelection()
 	_, fe.curDataPos = numbers.MinMaxInts([]int{fe.curDataPos - 1, 0})
 }
 
+// cursorWordLeft moves the cursor to the start of the word before the cursor.
+func (fe *fieldEditor) cursorWordLeft() {
+	fe.clearSelection()
+	fe.curDataPos = fe.data.wordStartBefore(fe.curDataPos)
+}
+
+// cursorWordRight moves the cursor to the end of the word after the cursor.
+func (fe *fieldEditor) cursorWordRight() {
+	fe.clearSelection()
+	fe.curDataPos = fe.data.wordEndAfter(fe.curDataPos)
+}
+
 // cursorStart moves the cursor to the beginning of the data.
 func (fe *fieldEditor) cursorStart() {
+	fe.clearSelection()
 	fe.curDataPos = 0
 }
 
 // cursorEnd moves the cursor to the end of the data.
 func (fe *fieldEditor) cursorEnd() {
+	fe.clearSelection()
 	fe.curDataPos = len(fe.data)
 }
 
@@ -395,16 +615,19 @@ func (fe *fieldEditor) cursorEnd() {
 // If the pos falls after the end of data, the cursor is moved onto the last
 // visible position.
 func (fe *fieldEditor) cursorRelCell(cellIdx int) {
-	runes, start, end := fe.data.fitRunes(fe.firstRune, fe.curDataPos, fe.width)
+	fe.clearSelection()
+	tabWidth := fe.tabCells(fe.width)
+	_, start, end := fe.data.fitRunes(fe.firstRune, fe.curDataPos, fe.width, tabWidth, fe.leftArrow, fe.rightArrow)
 	minDataIdx := curMinIdx(start, fe.width)
 	maxDataIdx := curMaxIdx(start, end, fe.width, len(fe.data))
 
 	// Index of the rune we should move the cursor to relative to the visible
-	// range.
+	// range. Iterates over the data rather than the displayed text, since a
+	// tab is displayed as multiple runes.
 	var relRuneIdx int
 	var cell int
-	for _, r := range runes {
-		cell += runewidth.RuneWidth(r)
+	for _, r := range fe.data.runesIn(start, end) {
+		cell += runeCells(r, tabWidth)
 		if cell > cellIdx {
 			break
 		}
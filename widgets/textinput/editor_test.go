@@ -168,7 +168,7 @@ func TestCellsBefore(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
-			got := tc.data.cellsBefore(tc.cells, tc.endIdx)
+			got := tc.data.cellsBefore(tc.cells, tc.endIdx, 0)
 			if got != tc.want {
 				t.Errorf("cellsBefore => %d, want %d", got, tc.want)
 			}
@@ -249,7 +249,7 @@ func TestCellsAfter(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
-			got := tc.data.cellsAfter(tc.cells, tc.startIdx)
+			got := tc.data.cellsAfter(tc.cells, tc.startIdx, 0)
 			if got != tc.want {
 				t.Errorf("cellsAfter => %d, want %d", got, tc.want)
 			}
@@ -344,6 +344,49 @@ func TestFieldEditor(t *testing.T) {
 			wantContent: "",
 			wantCurIdx:  0,
 		},
+		{
+			desc:  "tabs aren't inserted unless allowed",
+			width: 4,
+			ops: func(fe *fieldEditor) error {
+				fe.insert('a')
+				fe.insert('\t')
+				return nil
+			},
+			wantView:          "a",
+			wantContent:       "a",
+			wantCurIdx:        1,
+			wantOnChangeCalls: 1,
+		},
+		{
+			desc:  "hidden tab at the start is replaced with arrows of the same width",
+			width: 6,
+			ops: func(fe *fieldEditor) error {
+				fe.tabWidth = 3
+				fe.insert('x')
+				fe.insert('y')
+				fe.insert('\t')
+				fe.insert('b')
+				fe.insert('c')
+				return nil
+			},
+			wantView:          "⇦⇦⇦bc",
+			wantContent:       "xy\tbc",
+			wantCurIdx:        5,
+			wantOnChangeCalls: 5,
+		},
+		{
+			desc:  "tab is narrowed to fit the field",
+			width: 4,
+			ops: func(fe *fieldEditor) error {
+				fe.tabWidth = 8
+				fe.insert('\t')
+				return nil
+			},
+			wantView:          "  ",
+			wantContent:       "\t",
+			wantCurIdx:        2,
+			wantOnChangeCalls: 1,
+		},
 		{
 			desc:  "data and cursor fit exactly",
 			width: 4,
@@ -745,6 +788,150 @@ func TestFieldEditor(t *testing.T) {
 			wantCurIdx:        0,
 			wantOnChangeCalls: 5,
 		},
+		{
+			desc:  "cursorWordLeft moves to the start of the previous word",
+			width: 10,
+			ops: func(fe *fieldEditor) error {
+				for _, r := range "ab cd  ef" {
+					fe.insert(r)
+				}
+				fe.cursorWordLeft()
+				return nil
+			},
+			wantView:          "ab cd  ef",
+			wantContent:       "ab cd  ef",
+			wantCurIdx:        7,
+			wantOnChangeCalls: 9,
+		},
+		{
+			desc:  "cursorWordLeft skips spaces before the previous word",
+			width: 10,
+			ops: func(fe *fieldEditor) error {
+				for _, r := range "ab cd  ef" {
+					fe.insert(r)
+				}
+				fe.cursorWordLeft()
+				fe.cursorWordLeft()
+				return nil
+			},
+			wantView:          "ab cd  ef",
+			wantContent:       "ab cd  ef",
+			wantCurIdx:        3,
+			wantOnChangeCalls: 9,
+		},
+		{
+			desc:  "cursorWordLeft stays at the start",
+			width: 10,
+			ops: func(fe *fieldEditor) error {
+				for _, r := range "ab cd  ef" {
+					fe.insert(r)
+				}
+				fe.cursorStart()
+				fe.cursorWordLeft()
+				return nil
+			},
+			wantView:          "ab cd  ef",
+			wantContent:       "ab cd  ef",
+			wantCurIdx:        0,
+			wantOnChangeCalls: 9,
+		},
+		{
+			desc:  "cursorWordRight moves to the end of the next word",
+			width: 10,
+			ops: func(fe *fieldEditor) error {
+				for _, r := range "ab cd  ef" {
+					fe.insert(r)
+				}
+				fe.cursorStart()
+				fe.cursorWordRight()
+				return nil
+			},
+			wantView:          "ab cd  ef",
+			wantContent:       "ab cd  ef",
+			wantCurIdx:        2,
+			wantOnChangeCalls: 9,
+		},
+		{
+			desc:  "cursorWordRight skips spaces before the next word",
+			width: 10,
+			ops: func(fe *fieldEditor) error {
+				for _, r := range "ab cd  ef" {
+					fe.insert(r)
+				}
+				fe.cursorStart()
+				fe.cursorWordRight()
+				fe.cursorWordRight()
+				fe.cursorWordRight()
+				return nil
+			},
+			wantView:          "ab cd  ef",
+			wantContent:       "ab cd  ef",
+			wantCurIdx:        9,
+			wantOnChangeCalls: 9,
+		},
+		{
+			desc:  "deleteWordBefore deletes the previous word",
+			width: 10,
+			ops: func(fe *fieldEditor) error {
+				for _, r := range "ab cd  ef" {
+					fe.insert(r)
+				}
+				fe.deleteWordBefore()
+				return nil
+			},
+			wantView:          "ab cd  ",
+			wantContent:       "ab cd  ",
+			wantCurIdx:        7,
+			wantOnChangeCalls: 10,
+		},
+		{
+			desc:  "deleteWordBefore deletes the spaces before the previous word",
+			width: 10,
+			ops: func(fe *fieldEditor) error {
+				for _, r := range "ab cd  ef" {
+					fe.insert(r)
+				}
+				fe.deleteWordBefore()
+				fe.deleteWordBefore()
+				return nil
+			},
+			wantView:          "ab ",
+			wantContent:       "ab ",
+			wantCurIdx:        3,
+			wantOnChangeCalls: 11,
+		},
+		{
+			desc:  "deleteWordBefore in the middle of a word deletes its start",
+			width: 10,
+			ops: func(fe *fieldEditor) error {
+				for _, r := range "ab cd  ef" {
+					fe.insert(r)
+				}
+				fe.cursorLeft()
+				fe.deleteWordBefore()
+				return nil
+			},
+			wantView:          "ab cd  f",
+			wantContent:       "ab cd  f",
+			wantCurIdx:        7,
+			wantOnChangeCalls: 10,
+		},
+		{
+			desc:  "deleteWordBefore does nothing when cursor at the start",
+			width: 10,
+			ops: func(fe *fieldEditor) error {
+				for _, r := range "ab cd  ef" {
+					fe.insert(r)
+				}
+				fe.cursorStart()
+				fe.deleteWordBefore()
+				return nil
+			},
+			wantView:          "ab cd  ef",
+			wantContent:       "ab cd  ef",
+			wantCurIdx:        0,
+			wantOnChangeCalls: 9,
+		},
 		{
 			desc:  "delete does nothing when cursor at the end",
 			width: 4,
@@ -1887,3 +2074,64 @@ func TestFieldEditor(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectedCells(t *testing.T) {
+	tests := []struct {
+		desc      string
+		data      string
+		width     int
+		ops       func(*fieldEditor)
+		wantStart int
+		wantEnd   int
+	}{
+		{
+			desc:  "nothing selected",
+			data:  "abc",
+			width: 10,
+		},
+		{
+			desc:      "all text selected",
+			data:      "abc",
+			width:     10,
+			ops:       func(fe *fieldEditor) { fe.selectAll() },
+			wantStart: 0,
+			wantEnd:   3,
+		},
+		{
+			desc:      "full-width runes take two cells",
+			data:      "a世",
+			width:     10,
+			ops:       func(fe *fieldEditor) { fe.selectAll() },
+			wantStart: 0,
+			wantEnd:   3,
+		},
+		{
+			desc:      "selection clipped to the visible cells",
+			data:      "abcde",
+			width:     4,
+			ops:       func(fe *fieldEditor) { fe.selectAll() },
+			wantStart: 0,
+			wantEnd:   3,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			fe := newFieldEditor(nil)
+			for _, r := range tc.data {
+				fe.insert(r)
+			}
+			if tc.ops != nil {
+				tc.ops(fe)
+			}
+			if _, _, err := fe.viewFor(tc.width); err != nil {
+				t.Fatalf("viewFor => unexpected error: %v", err)
+			}
+
+			gotStart, gotEnd := fe.selectedCells(tc.width)
+			if gotStart != tc.wantStart || gotEnd != tc.wantEnd {
+				t.Errorf("selectedCells => (%d, %d), want (%d, %d)", gotStart, gotEnd, tc.wantStart, tc.wantEnd)
+			}
+		})
+	}
+}
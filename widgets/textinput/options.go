@@ -19,6 +19,7 @@ package textinput
 import (
 	"errors"
 	"fmt"
+	"unicode/utf8"
 
 	"github.com/woodliu/termdash/align"
 	"github.com/woodliu/termdash/cell"
@@ -48,24 +49,34 @@ type options struct {
 	placeHolderColor cell.Color
 	highlightedColor cell.Color
 	cursorColor      cell.Color
+	terminalCursor   bool
 	border           linestyle.LineStyle
 	borderColor      cell.Color
 
 	widthPerc     *int
 	maxWidthCells *int
+	maxLength     *int
 	label         string
 	labelCellOpts []cell.Option
 	labelAlign    align.Horizontal
 
-	placeHolder  string
-	hideTextWith rune
-	defaultText  string
+	placeHolder             string
+	placeHolderCellOpts     []cell.Option
+	placeHolderWhileFocused bool
+	hideTextWith            rune
+	defaultText             string
+
+	scrollLeftRune  rune
+	scrollRightRune rune
 
 	filter                   FilterFn
 	onSubmit                 SubmitFn
 	onChange                 ChangeFn
 	clearOnSubmit            bool
 	exclusiveKeyboardOnFocus bool
+	rightToLeft              bool
+	allowTab                 bool
+	tabWidth                 int
 }
 
 // validate validates the provided options.
@@ -76,6 +87,9 @@ func (o *options) validate() error {
 	if min, cells := 4, o.maxWidthCells; cells != nil && *cells < min {
 		return fmt.Errorf("invalid MaxWidthCells(%d), must be value in range %d <= value", *cells, min)
 	}
+	if min, l := 1, o.maxLength; l != nil && *l < min {
+		return fmt.Errorf("invalid MaxLength(%d), must be value in range %d <= value", *l, min)
+	}
 	if r := o.hideTextWith; r != 0 {
 		if err := wrap.ValidText(string(r)); err != nil {
 			return fmt.Errorf("invalid HideTextWidth rune %c(%d): %v", r, r, err)
@@ -84,6 +98,17 @@ func (o *options) validate() error {
 			return fmt.Errorf("invalid HideTextWidth rune %c(%d), has rune width of %d cells, only runes with width of %d are accepted", r, r, got, want)
 		}
 	}
+	for _, r := range []rune{o.scrollLeftRune, o.scrollRightRune} {
+		if err := wrap.ValidText(string(r)); err != nil {
+			return fmt.Errorf("invalid ScrollIndicators rune %c(%d): %v", r, r, err)
+		}
+		if got, want := runewidth.RuneWidth(r), 1; got != want {
+			return fmt.Errorf("invalid ScrollIndicators rune %c(%d), has rune width of %d cells, only runes with width of %d are accepted", r, r, got, want)
+		}
+	}
+	if min := 1; o.tabWidth < min {
+		return fmt.Errorf("invalid TabWidth(%d), must be value in range %d <= value", o.tabWidth, min)
+	}
 	if o.defaultText != "" {
 		if err := wrap.ValidText(o.defaultText); err != nil {
 			return fmt.Errorf("invalid DefaultText: %v", err)
@@ -93,6 +118,9 @@ func (o *options) validate() error {
 				return errors.New("invalid DefaultText: newline characters aren't allowed")
 			}
 		}
+		if l := o.maxLength; l != nil && utf8.RuneCountInString(o.defaultText) > *l {
+			return fmt.Errorf("invalid DefaultText: has %d runes, more than the MaxLength(%d)", utf8.RuneCountInString(o.defaultText), *l)
+		}
 	}
 	return nil
 }
@@ -105,6 +133,9 @@ func newOptions() *options {
 		highlightedColor: cell.ColorNumber(DefaultHighlightedColorNumber),
 		cursorColor:      cell.ColorNumber(DefaultCursorColorNumber),
 		labelAlign:       DefaultLabelAlign,
+		tabWidth:         DefaultTabWidth,
+		scrollLeftRune:   DefaultScrollLeftRune,
+		scrollRightRune:  DefaultScrollRightRune,
 	}
 }
 
@@ -151,6 +182,16 @@ func CursorColor(c cell.Color) Option {
 	})
 }
 
+// TerminalCursor configures the text input field to display the terminal
+// cursor at the cursor position instead of drawing the cursor using the
+// CursorColor and HighlightedColor. Useful with terminals that support
+// configuring the cursor style, e.g. a blinking bar.
+func TerminalCursor() Option {
+	return option(func(opts *options) {
+		opts.terminalCursor = true
+	})
+}
+
 // Border adds a border around the text input field.
 func Border(ls linestyle.LineStyle) Option {
 	return option(func(opts *options) {
@@ -186,6 +227,17 @@ func MaxWidthCells(cells int) Option {
 	})
 }
 
+// MaxLength sets the maximum number of runes the user can input into the text
+// input field, regardless of the width of the field. Once reached, any further
+// runes are ignored. Each rune counts as one, even full-width runes that take
+// two cells. Must be a value in the range 1 <= n.
+// Defaults to no limit.
+func MaxLength(n int) Option {
+	return option(func(opts *options) {
+		opts.maxLength = &n
+	})
+}
+
 // Label adds a text label to the left of the input field.
 func Label(label string, cOpts ...cell.Option) Option {
 	return option(func(opts *options) {
@@ -208,7 +260,9 @@ func LabelAlign(la align.Horizontal) Option {
 }
 
 // PlaceHolder sets text to be displayed in the input field when it is empty.
-// This text disappears when the text input field becomes focused.
+// This text disappears when the text input field becomes focused, unless the
+// PlaceHolderWhileFocused option is set, and as soon as the user types.
+// The place holder is never returned by Read.
 func PlaceHolder(text string) Option {
 	return option(func(opts *options) {
 		opts.placeHolder = text
@@ -227,6 +281,23 @@ func PlaceHolderColor(c cell.Color) Option {
 	})
 }
 
+// PlaceHolderCellOpts sets additional cell options for the placeholder text,
+// e.g. cell.Dim() or cell.Italic(). These are applied on top of the color set
+// by PlaceHolderColor.
+func PlaceHolderCellOpts(cOpts ...cell.Option) Option {
+	return option(func(opts *options) {
+		opts.placeHolderCellOpts = cOpts
+	})
+}
+
+// PlaceHolderWhileFocused keeps the placeholder text visible while the text
+// input field is focused, as long as the field is empty.
+func PlaceHolderWhileFocused() Option {
+	return option(func(opts *options) {
+		opts.placeHolderWhileFocused = true
+	})
+}
+
 // HideTextWith sets the rune that should be displayed instead of displaying
 // the text. Useful for fields that accept sensitive information like
 // passwords.
@@ -243,7 +314,9 @@ func HideTextWith(r rune) Option {
 type FilterFn func(rune) bool
 
 // Filter sets a function that will be used to filter characters the user can
-// input.
+// input. Rejected runes are silently dropped and never inserted into the field.
+// The filter only applies to runes, keys that edit the content or move the
+// cursor like the arrow keys or Backspace work regardless of the filter.
 func Filter(fn FilterFn) Option {
 	return option(func(opts *options) {
 		opts.filter = fn
@@ -309,3 +382,65 @@ func DefaultText(text string) Option {
 		opts.defaultText = text
 	})
 }
+
+// RightToLeft configures the text input field for right-to-left scripts, e.g.
+// Arabic or Hebrew. The cursor starts at the right edge of the field and the
+// text flows leftward. The left and right arrow keys move the cursor in the
+// visual direction, i.e. the left arrow moves the cursor towards the end of
+// the text.
+func RightToLeft() Option {
+	return option(func(opts *options) {
+		opts.rightToLeft = true
+	})
+}
+
+// AllowTab configures the text input field to insert a tab character when the
+// user presses the Tab key. Otherwise the Tab key is ignored by the field.
+// Tabs are displayed as the number of cells configured by TabWidth.
+//
+// The container processes keyboard events before delivering them to widgets.
+// If the container is configured to move the keyboard focus on the Tab key,
+// e.g. via the container.KeyFocusNext option, the focus moves away from this
+// widget and the tab isn't inserted. Configure a different key for focus
+// navigation when using this option.
+func AllowTab() Option {
+	return option(func(opts *options) {
+		opts.allowTab = true
+	})
+}
+
+// DefaultTabWidth is the default value for the TabWidth option.
+const DefaultTabWidth = 4
+
+// TabWidth sets the number of cells a tab takes when displayed in the text
+// input field. Must be a value in the range 1 <= cells. On fields too narrow to
+// display a tab of this width, the tab is displayed narrower.
+// Only has effect when the AllowTab option is provided.
+// Defaults to DefaultTabWidth.
+func TabWidth(cells int) Option {
+	return option(func(opts *options) {
+		opts.tabWidth = cells
+	})
+}
+
+// DefaultScrollLeftRune is the default left rune for the ScrollIndicators
+// option.
+const DefaultScrollLeftRune = '⇦'
+
+// DefaultScrollRightRune is the default right rune for the ScrollIndicators
+// option.
+const DefaultScrollRightRune = '⇨'
+
+// ScrollIndicators sets the runes displayed at the edges of the text input
+// field when the text doesn't fit and is scrolled. The left rune indicates
+// text hidden before the visible range and the right rune text hidden after
+// it. The indicators are only displayed and never become part of the text
+// returned by Read.
+// Both runes must be printable runes with cell width of one.
+// Defaults to DefaultScrollLeftRune and DefaultScrollRightRune.
+func ScrollIndicators(left, right rune) Option {
+	return option(func(opts *options) {
+		opts.scrollLeftRune = left
+		opts.scrollRightRune = right
+	})
+}
@@ -42,7 +42,9 @@ import (
 //
 // The text can be submitted by pressing enter or read at any time by calling
 // Read. The text input field can be navigated using arrows, the Home and End
-// button and using mouse.
+// button and using mouse. Arrows pressed with Ctrl move the cursor by words and
+// Ctrl+W or Ctrl+Backspace delete the word before the cursor. Ctrl+A selects
+// all the text, see Selected, and Ctrl+U clears the field.
 //
 // Implements widgetapi.Widget. This object is thread-safe.
 type TextInput struct {
@@ -73,6 +75,14 @@ func New(opts ...Option) (*TextInput, error) {
 		editor: newFieldEditor(opt.onChange),
 		opts:   opt,
 	}
+	if opt.allowTab {
+		ti.editor.tabWidth = opt.tabWidth
+	}
+	if opt.maxLength != nil {
+		ti.editor.maxLength = *opt.maxLength
+	}
+	ti.editor.leftArrow = opt.scrollLeftRune
+	ti.editor.rightArrow = opt.scrollRightRune
 	for _, r := range ti.opts.defaultText {
 		ti.editor.insert(r)
 	}
@@ -98,6 +108,15 @@ func (ti *TextInput) Read() string {
 	return ti.editor.content()
 }
 
+// Selected returns the text currently selected in the text input field.
+// Returns an empty string if no text is selected.
+func (ti *TextInput) Selected() string {
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+
+	return ti.editor.selected()
+}
+
 // ReadAndClear reads the content of the text input field and clears it.
 func (ti *TextInput) ReadAndClear() string {
 	ti.mu.Lock()
@@ -129,11 +148,20 @@ func (ti *TextInput) drawField(cvs *canvas.Canvas, text string) error {
 	}
 
 	if ti.opts.hideTextWith != 0 {
-		text = hideText(text, ti.opts.hideTextWith)
+		text = hideText(text, ti.opts.hideTextWith, ti.opts.scrollLeftRune, ti.opts.scrollRightRune)
+	}
+
+	if text == "" {
+		return nil
 	}
 
+	start := ti.forField.Min
+	if ti.opts.rightToLeft {
+		text = mirrorText(text, ti.opts.scrollLeftRune, ti.opts.scrollRightRune)
+		start.X = ti.forField.Max.X - runewidth.StringWidth(text)
+	}
 	return draw.Text(
-		cvs, text, ti.forField.Min,
+		cvs, text, start,
 		draw.TextMaxX(ti.forField.Max.X),
 		draw.TextCellOpts(cell.FgColor(ti.opts.textColor)),
 	)
@@ -145,6 +173,9 @@ func (ti *TextInput) drawCursor(cvs *canvas.Canvas, curPos int) error {
 		curPos + ti.forField.Min.X,
 		ti.forField.Min.Y,
 	}
+	if ti.opts.terminalCursor {
+		return cvs.SetCursor(p)
+	}
 	if err := cvs.SetCellOpts(
 		p,
 		cell.FgColor(ti.opts.highlightedColor),
@@ -202,22 +233,49 @@ func (ti *TextInput) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 		return err
 	}
 
+	if text == "" && (!meta.Focused || ti.opts.placeHolderWhileFocused) {
+		if err := ti.drawPlaceHolder(cvs); err != nil {
+			return err
+		}
+	}
+
 	if meta.Focused {
-		if err := ti.drawCursor(cvs, curPos); err != nil {
+		if err := ti.drawSelection(cvs, text); err != nil {
 			return err
 		}
-	} else if ti.opts.placeHolder != "" && text == "" {
-		if err := draw.Text(
-			cvs, ti.opts.placeHolder, ti.forField.Min,
-			draw.TextMaxX(ti.forField.Max.X),
-			draw.TextCellOpts(cell.FgColor(ti.opts.placeHolderColor)),
-		); err != nil {
+		if ti.opts.rightToLeft {
+			curPos = mirrorCell(text, curPos, ti.forField.Dx())
+		}
+		if err := ti.drawCursor(cvs, curPos); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// drawPlaceHolder draws the place holder text into the empty text input
+// field.
+func (ti *TextInput) drawPlaceHolder(cvs *canvas.Canvas) error {
+	if ti.opts.placeHolder == "" {
+		return nil
+	}
+
+	start := ti.forField.Min
+	if ti.opts.rightToLeft {
+		trimmed, err := draw.TrimText(ti.opts.placeHolder, ti.forField.Dx(), draw.OverrunModeTrim)
+		if err != nil {
+			return err
+		}
+		start.X = ti.forField.Max.X - runewidth.StringWidth(trimmed)
+	}
+	cellOpts := append([]cell.Option{cell.FgColor(ti.opts.placeHolderColor)}, ti.opts.placeHolderCellOpts...)
+	return draw.Text(
+		cvs, ti.opts.placeHolder, start,
+		draw.TextMaxX(ti.forField.Max.X),
+		draw.TextCellOpts(cellOpts...),
+	)
+}
+
 // keyboard processes keyboard events.
 // Returns a bool indicating if the content was submitted and the text in the
 // field at submission time.
@@ -230,21 +288,61 @@ func (ti *TextInput) keyboard(k *terminalapi.Keyboard) (bool, string) {
 	case keyboard.KeyBackspace, keyboard.KeyBackspace2:
 		ti.editor.deleteBefore()
 
+	case keyboard.KeyCtrlBackspace, keyboard.KeyCtrlW:
+		ti.editor.deleteWordBefore()
+
 	case keyboard.KeyDelete:
 		ti.editor.delete()
 
 	case keyboard.KeyArrowLeft:
-		ti.editor.cursorLeft()
+		if ti.opts.rightToLeft {
+			ti.editor.cursorRight()
+		} else {
+			ti.editor.cursorLeft()
+		}
 
 	case keyboard.KeyArrowRight:
-		ti.editor.cursorRight()
+		if ti.opts.rightToLeft {
+			ti.editor.cursorLeft()
+		} else {
+			ti.editor.cursorRight()
+		}
 
-	case keyboard.KeyHome, keyboard.KeyCtrlA:
+	case keyboard.KeyCtrlArrowLeft:
+		if ti.opts.rightToLeft {
+			ti.editor.cursorWordRight()
+		} else {
+			ti.editor.cursorWordLeft()
+		}
+
+	case keyboard.KeyCtrlArrowRight:
+		if ti.opts.rightToLeft {
+			ti.editor.cursorWordLeft()
+		} else {
+			ti.editor.cursorWordRight()
+		}
+
+	case keyboard.KeyHome:
 		ti.editor.cursorStart()
 
+	case keyboard.KeyCtrlA:
+		ti.editor.selectAll()
+
+	case keyboard.KeyCtrlU:
+		ti.editor.deleteAll()
+
 	case keyboard.KeyEnd, keyboard.KeyCtrlE:
 		ti.editor.cursorEnd()
 
+	case keyboard.KeyTab:
+		if !ti.opts.allowTab {
+			return false, ""
+		}
+		if ti.opts.filter != nil && !ti.opts.filter('\t') {
+			return false, ""
+		}
+		ti.editor.insert('\t')
+
 	case keyboard.KeyEnter:
 		text := ti.editor.content()
 		if ti.opts.clearOnSubmit {
@@ -292,6 +390,9 @@ func (ti *TextInput) Mouse(m *terminalapi.Mouse, meta *widgetapi.EventMeta) erro
 	}
 
 	cellIdx := m.Position.X - ti.forField.Min.X
+	if ti.opts.rightToLeft {
+		cellIdx = ti.forField.Dx() - 1 - cellIdx
+	}
 	ti.editor.cursorRelCell(cellIdx)
 	return nil
 }
@@ -366,8 +467,40 @@ func split(cvsAr image.Rectangle, label string, widthPerc *int) (labelAr, textAr
 	}
 }
 
+// drawSelection inverts the colors of the cells that display the selected
+// text. The text is the visible text in the field as returned by the editor.
+func (ti *TextInput) drawSelection(cvs *canvas.Canvas, text string) error {
+	start, end := ti.editor.selectedCells(ti.forField.Dx())
+	if start == end {
+		return nil
+	}
+
+	if ti.opts.hideTextWith != 0 {
+		text = hideText(text, ti.opts.hideTextWith, ti.opts.scrollLeftRune, ti.opts.scrollRightRune)
+	}
+	x := ti.forField.Min.X
+	if ti.opts.rightToLeft {
+		w := runewidth.StringWidth(text)
+		start, end = w-end, w-start
+		text = mirrorText(text, ti.opts.scrollLeftRune, ti.opts.scrollRightRune)
+		x = ti.forField.Max.X - w
+	}
+
+	var c int
+	for _, r := range text {
+		if c >= start && c < end {
+			if err := cvs.SetCellOpts(image.Point{x + c, ti.forField.Min.Y}, cell.Inverse()); err != nil {
+				return err
+			}
+		}
+		c += runewidth.RuneWidth(r)
+	}
+	return nil
+}
+
 // hideText returns the text with all runes replaced with hr.
-func hideText(text string, hr rune) string {
+// The left and right runes indicating hidden text at the edges aren't replaced.
+func hideText(text string, hr, left, right rune) string {
 	var b strings.Builder
 
 	i := 0
@@ -375,10 +508,10 @@ func hideText(text string, hr rune) string {
 	for _, r := range text {
 		rw := runewidth.RuneWidth(r)
 		switch {
-		case i == 0 && r == '⇦':
+		case i == 0 && r == left:
 			b.WriteRune(r)
 
-		case i == sw-1 && r == '⇨':
+		case i == sw-1 && r == right:
 			b.WriteRune(r)
 
 		default:
@@ -388,3 +521,41 @@ func hideText(text string, hr rune) string {
 	}
 	return b.String()
 }
+
+// mirrorText returns the text with the order of its runes reversed so that it
+// can be drawn from left to right while reading right to left. The left and
+// right arrows indicating hidden text are swapped so they keep pointing
+// towards the hidden text.
+func mirrorText(text string, left, right rune) string {
+	runes := []rune(text)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	for i, r := range runes {
+		switch r {
+		case left:
+			runes[i] = right
+		case right:
+			runes[i] = left
+		}
+	}
+	return string(runes)
+}
+
+// mirrorCell given the cell index of the cursor within the left-to-right
+// text returns the cell index of the cursor once the text is mirrored and
+// aligned to the right edge of a field of the specified width.
+func mirrorCell(text string, curCell, width int) int {
+	// Width of the rune the cursor is on, the cursor is placed on its first
+	// cell. The cursor after the end of the text occupies a single cell.
+	rw := 1
+	var cell int
+	for _, r := range text {
+		if cell == curCell {
+			rw = runewidth.RuneWidth(r)
+			break
+		}
+		cell += runewidth.RuneWidth(r)
+	}
+	return width - curCell - rw
+}
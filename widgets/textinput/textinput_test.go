@@ -19,6 +19,7 @@ import (
 	"image"
 	"sync"
 	"testing"
+	"unicode"
 
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/woodliu/termdash/align"
@@ -81,6 +82,7 @@ func TestTextInput(t *testing.T) {
 		wantNewErr   bool
 		wantDrawErr  bool
 		wantEventErr bool
+		wantRead     string // if not empty, the test case also calls Read.
 	}{
 		{
 			desc: "fails on WidthPerc too low",
@@ -96,6 +98,13 @@ func TestTextInput(t *testing.T) {
 			},
 			wantNewErr: true,
 		},
+		{
+			desc: "fails on TabWidth too low",
+			opts: []Option{
+				TabWidth(0),
+			},
+			wantNewErr: true,
+		},
 		{
 			desc: "fails on MaxWidthCells too low",
 			opts: []Option{
@@ -103,6 +112,21 @@ func TestTextInput(t *testing.T) {
 			},
 			wantNewErr: true,
 		},
+		{
+			desc: "fails on MaxLength too low",
+			opts: []Option{
+				MaxLength(0),
+			},
+			wantNewErr: true,
+		},
+		{
+			desc: "fails on DefaultText longer than MaxLength",
+			opts: []Option{
+				MaxLength(2),
+				DefaultText("abc"),
+			},
+			wantNewErr: true,
+		},
 		{
 			desc: "fails on HideTextWith control rune",
 			opts: []Option{
@@ -117,6 +141,20 @@ func TestTextInput(t *testing.T) {
 			},
 			wantNewErr: true,
 		},
+		{
+			desc: "fails on ScrollIndicators control rune",
+			opts: []Option{
+				ScrollIndicators(0x007f, '>'),
+			},
+			wantNewErr: true,
+		},
+		{
+			desc: "fails on ScrollIndicators full-width rune",
+			opts: []Option{
+				ScrollIndicators('<', '世'),
+			},
+			wantNewErr: true,
+		},
 		{
 			desc: "fails on invalid DefaultText which has control characters",
 			opts: []Option{
@@ -243,13 +281,15 @@ func TestTextInput(t *testing.T) {
 			},
 		},
 		{
-			desc: "draws place holder text when empty and not focused",
-			opts: []Option{
-				PlaceHolder("holder"),
-			},
+			desc:   "inverts the colors of the selected text",
 			canvas: image.Rect(0, 0, 10, 1),
 			meta: &widgetapi.Meta{
-				Focused: false,
+				Focused: true,
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlA},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -263,23 +303,35 @@ func TestTextInput(t *testing.T) {
 				)
 				testdraw.MustText(
 					cvs,
-					"holder",
+					"ab",
 					image.Point{0, 0},
-					draw.TextCellOpts(cell.FgColor(cell.ColorNumber(DefaultPlaceHolderColorNumber))),
+				)
+				testcanvas.MustSetCell(cvs, image.Point{0, 0}, 'a', cell.Inverse())
+				testcanvas.MustSetCell(cvs, image.Point{1, 0}, 'b', cell.Inverse())
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{2, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
 				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc: "sets custom place holder text color",
+			desc: "inverts the colors of the selected text in right-to-left mode",
 			opts: []Option{
-				PlaceHolder("holder"),
-				PlaceHolderColor(cell.ColorRed),
+				RightToLeft(),
 			},
 			canvas: image.Rect(0, 0, 10, 1),
 			meta: &widgetapi.Meta{
-				Focused: false,
+				Focused: true,
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlA},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -293,23 +345,36 @@ func TestTextInput(t *testing.T) {
 				)
 				testdraw.MustText(
 					cvs,
-					"holder",
-					image.Point{0, 0},
-					draw.TextCellOpts(cell.FgColor(cell.ColorRed)),
+					"ba",
+					image.Point{8, 0},
+				)
+				testcanvas.MustSetCell(cvs, image.Point{8, 0}, 'b', cell.Inverse())
+				testcanvas.MustSetCell(cvs, image.Point{9, 0}, 'a', cell.Inverse())
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{7, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
 				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc: "sets custom cursor color",
+			desc: "inverts the colors of the selected hidden text",
 			opts: []Option{
-				CursorColor(cell.ColorRed),
+				HideTextWith('*'),
 			},
 			canvas: image.Rect(0, 0, 10, 1),
 			meta: &widgetapi.Meta{
 				Focused: true,
 			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlA},
+			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				cvs := testcanvas.MustNew(ft.Area())
@@ -320,11 +385,18 @@ func TestTextInput(t *testing.T) {
 					textFieldRune,
 					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
 				)
-				testcanvas.MustSetCell(
+				testdraw.MustText(
 					cvs,
+					"**",
 					image.Point{0, 0},
+				)
+				testcanvas.MustSetCell(cvs, image.Point{0, 0}, '*', cell.Inverse())
+				testcanvas.MustSetCell(cvs, image.Point{1, 0}, '*', cell.Inverse())
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{2, 0},
 					cursorRune,
-					cell.BgColor(cell.ColorRed),
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
 					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
 				)
 				testcanvas.MustApply(cvs, ft)
@@ -332,187 +404,455 @@ func TestTextInput(t *testing.T) {
 			},
 		},
 		{
-			desc: "sets width percentage, results in area too small",
-			opts: []Option{
-				WidthPerc(10),
-			},
+			desc:   "cursor movement clears the selection",
 			canvas: image.Rect(0, 0, 10, 1),
-			meta:   &widgetapi.Meta{},
-			want: func(size image.Point) *faketerm.Terminal {
-				ft := faketerm.MustNew(size)
-				cvs := testcanvas.MustNew(ft.Area())
-				testdraw.MustResizeNeeded(cvs)
-				testcanvas.MustApply(cvs, ft)
-				return ft
+			meta: &widgetapi.Meta{
+				Focused: true,
 			},
-		},
-		{
-			desc: "sets width percentage, field aligns right",
-			opts: []Option{
-				WidthPerc(50),
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlA},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowLeft},
 			},
-			canvas: image.Rect(0, 0, 10, 1),
-			meta:   &widgetapi.Meta{},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				cvs := testcanvas.MustNew(ft.Area())
 
 				testcanvas.MustSetAreaCells(
 					cvs,
-					image.Rect(5, 0, 10, 1),
+					cvs.Area(),
 					textFieldRune,
 					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
 				)
+				testdraw.MustText(
+					cvs,
+					"ab",
+					image.Point{0, 0},
+				)
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{1, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
+				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc: "automatically adjusts space for label, rest for text field",
+			desc: "draws place holder text when empty and not focused",
 			opts: []Option{
-				Label("hi:"),
+				PlaceHolder("holder"),
 			},
 			canvas: image.Rect(0, 0, 10, 1),
-			meta:   &widgetapi.Meta{},
+			meta: &widgetapi.Meta{
+				Focused: false,
+			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				cvs := testcanvas.MustNew(ft.Area())
 
-				testdraw.MustText(cvs, "hi:", image.Point{0, 0})
 				testcanvas.MustSetAreaCells(
 					cvs,
-					image.Rect(3, 0, 10, 1),
+					cvs.Area(),
 					textFieldRune,
 					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
 				)
+				testdraw.MustText(
+					cvs,
+					"holder",
+					image.Point{0, 0},
+					draw.TextCellOpts(cell.FgColor(cell.ColorNumber(DefaultPlaceHolderColorNumber))),
+				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc: "has label and border, not enough remaining height",
+			desc: "applies place holder cell options",
 			opts: []Option{
-				Label("hi:"),
-				Border(linestyle.Light),
-			},
-			canvas: image.Rect(0, 0, 10, 2),
-			meta:   &widgetapi.Meta{},
-			want: func(size image.Point) *faketerm.Terminal {
-				ft := faketerm.MustNew(size)
-				cvs := testcanvas.MustNew(ft.Area())
-				testdraw.MustResizeNeeded(cvs)
-				testcanvas.MustApply(cvs, ft)
-				return ft
+				PlaceHolder("holder"),
+				PlaceHolderCellOpts(cell.Dim(), cell.Italic()),
 			},
-		},
-		{
-			desc: "draws label and border",
-			opts: []Option{
-				Label("hi:"),
-				Border(linestyle.Light),
+			canvas: image.Rect(0, 0, 10, 1),
+			meta: &widgetapi.Meta{
+				Focused: false,
 			},
-			canvas: image.Rect(0, 0, 10, 3),
-			meta:   &widgetapi.Meta{},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				cvs := testcanvas.MustNew(ft.Area())
 
-				testdraw.MustText(cvs, "hi:", image.Point{0, 1})
-				testdraw.MustBorder(cvs, image.Rect(3, 0, 10, 3))
 				testcanvas.MustSetAreaCells(
 					cvs,
-					image.Rect(4, 1, 9, 2),
+					cvs.Area(),
 					textFieldRune,
 					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
 				)
+				testdraw.MustText(
+					cvs,
+					"holder",
+					image.Point{0, 0},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorNumber(DefaultPlaceHolderColorNumber)),
+						cell.Dim(),
+						cell.Italic(),
+					),
+				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc: "draws resize needed if label makes text field too narrow",
+			desc: "doesn't draw place holder text when focused",
 			opts: []Option{
-				Label("hello world:"),
+				PlaceHolder("holder"),
 			},
 			canvas: image.Rect(0, 0, 10, 1),
-			meta:   &widgetapi.Meta{},
+			meta: &widgetapi.Meta{
+				Focused: true,
+			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				cvs := testcanvas.MustNew(ft.Area())
-				testdraw.MustResizeNeeded(cvs)
+
+				testcanvas.MustSetAreaCells(
+					cvs,
+					cvs.Area(),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{0, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
+				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc: "sets width percentage for text field, label gets the rest, aligns left by default",
+			desc: "draws place holder text under the cursor when focused if requested",
 			opts: []Option{
-				Label("hi:"),
-				WidthPerc(50),
+				PlaceHolder("holder"),
+				PlaceHolderWhileFocused(),
 			},
 			canvas: image.Rect(0, 0, 10, 1),
-			meta:   &widgetapi.Meta{},
+			meta: &widgetapi.Meta{
+				Focused: true,
+			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				cvs := testcanvas.MustNew(ft.Area())
 
-				testdraw.MustText(cvs, "hi:", image.Point{0, 0})
 				testcanvas.MustSetAreaCells(
 					cvs,
-					image.Rect(5, 0, 10, 1),
+					cvs.Area(),
 					textFieldRune,
 					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
 				)
+				testdraw.MustText(
+					cvs,
+					"holder",
+					image.Point{0, 0},
+					draw.TextCellOpts(cell.FgColor(cell.ColorNumber(DefaultPlaceHolderColorNumber))),
+				)
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{0, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
+				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc: "sets width percentage for text field, label gets the rest, aligns left with option",
+			desc: "place holder text disappears when the user types",
 			opts: []Option{
-				Label("hi:"),
-				WidthPerc(50),
-				LabelAlign(align.HorizontalLeft),
+				PlaceHolder("holder"),
+				PlaceHolderWhileFocused(),
 			},
 			canvas: image.Rect(0, 0, 10, 1),
-			meta:   &widgetapi.Meta{},
+			meta: &widgetapi.Meta{
+				Focused: true,
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+			},
+			wantRead: "a",
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				cvs := testcanvas.MustNew(ft.Area())
 
-				testdraw.MustText(cvs, "hi:", image.Point{0, 0})
 				testcanvas.MustSetAreaCells(
 					cvs,
-					image.Rect(5, 0, 10, 1),
+					cvs.Area(),
 					textFieldRune,
 					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
 				)
+				testdraw.MustText(
+					cvs,
+					"a",
+					image.Point{0, 0},
+				)
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{1, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
+				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc: "sets width percentage for text field, label gets the rest, aligns center with option",
+			desc: "sets custom place holder text color",
 			opts: []Option{
-				Label("hi:"),
-				WidthPerc(50),
-				LabelAlign(align.HorizontalCenter),
+				PlaceHolder("holder"),
+				PlaceHolderColor(cell.ColorRed),
 			},
 			canvas: image.Rect(0, 0, 10, 1),
-			meta:   &widgetapi.Meta{},
+			meta: &widgetapi.Meta{
+				Focused: false,
+			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				cvs := testcanvas.MustNew(ft.Area())
 
-				testdraw.MustText(cvs, "hi:", image.Point{1, 0})
 				testcanvas.MustSetAreaCells(
 					cvs,
-					image.Rect(5, 0, 10, 1),
+					cvs.Area(),
 					textFieldRune,
 					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
 				)
-				testcanvas.MustApply(cvs, ft)
-				return ft
+				testdraw.MustText(
+					cvs,
+					"holder",
+					image.Point{0, 0},
+					draw.TextCellOpts(cell.FgColor(cell.ColorRed)),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "sets custom cursor color",
+			opts: []Option{
+				CursorColor(cell.ColorRed),
+			},
+			canvas: image.Rect(0, 0, 10, 1),
+			meta: &widgetapi.Meta{
+				Focused: true,
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetAreaCells(
+					cvs,
+					cvs.Area(),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{0, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorRed),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "sets width percentage, results in area too small",
+			opts: []Option{
+				WidthPerc(10),
+			},
+			canvas: image.Rect(0, 0, 10, 1),
+			meta:   &widgetapi.Meta{},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustResizeNeeded(cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "sets width percentage, field aligns right",
+			opts: []Option{
+				WidthPerc(50),
+			},
+			canvas: image.Rect(0, 0, 10, 1),
+			meta:   &widgetapi.Meta{},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetAreaCells(
+					cvs,
+					image.Rect(5, 0, 10, 1),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "automatically adjusts space for label, rest for text field",
+			opts: []Option{
+				Label("hi:"),
+			},
+			canvas: image.Rect(0, 0, 10, 1),
+			meta:   &widgetapi.Meta{},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(cvs, "hi:", image.Point{0, 0})
+				testcanvas.MustSetAreaCells(
+					cvs,
+					image.Rect(3, 0, 10, 1),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "has label and border, not enough remaining height",
+			opts: []Option{
+				Label("hi:"),
+				Border(linestyle.Light),
+			},
+			canvas: image.Rect(0, 0, 10, 2),
+			meta:   &widgetapi.Meta{},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustResizeNeeded(cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "draws label and border",
+			opts: []Option{
+				Label("hi:"),
+				Border(linestyle.Light),
+			},
+			canvas: image.Rect(0, 0, 10, 3),
+			meta:   &widgetapi.Meta{},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(cvs, "hi:", image.Point{0, 1})
+				testdraw.MustBorder(cvs, image.Rect(3, 0, 10, 3))
+				testcanvas.MustSetAreaCells(
+					cvs,
+					image.Rect(4, 1, 9, 2),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "draws resize needed if label makes text field too narrow",
+			opts: []Option{
+				Label("hello world:"),
+			},
+			canvas: image.Rect(0, 0, 10, 1),
+			meta:   &widgetapi.Meta{},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustResizeNeeded(cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "sets width percentage for text field, label gets the rest, aligns left by default",
+			opts: []Option{
+				Label("hi:"),
+				WidthPerc(50),
+			},
+			canvas: image.Rect(0, 0, 10, 1),
+			meta:   &widgetapi.Meta{},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(cvs, "hi:", image.Point{0, 0})
+				testcanvas.MustSetAreaCells(
+					cvs,
+					image.Rect(5, 0, 10, 1),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "sets width percentage for text field, label gets the rest, aligns left with option",
+			opts: []Option{
+				Label("hi:"),
+				WidthPerc(50),
+				LabelAlign(align.HorizontalLeft),
+			},
+			canvas: image.Rect(0, 0, 10, 1),
+			meta:   &widgetapi.Meta{},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(cvs, "hi:", image.Point{0, 0})
+				testcanvas.MustSetAreaCells(
+					cvs,
+					image.Rect(5, 0, 10, 1),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "sets width percentage for text field, label gets the rest, aligns center with option",
+			opts: []Option{
+				Label("hi:"),
+				WidthPerc(50),
+				LabelAlign(align.HorizontalCenter),
+			},
+			canvas: image.Rect(0, 0, 10, 1),
+			meta:   &widgetapi.Meta{},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(cvs, "hi:", image.Point{1, 0})
+				testcanvas.MustSetAreaCells(
+					cvs,
+					image.Rect(5, 0, 10, 1),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
 			},
 		},
 		{
@@ -788,14 +1128,382 @@ func TestTextInput(t *testing.T) {
 			},
 		},
 		{
-			desc:   "write ignores control or unsupported space runes",
+			desc:   "write ignores control or unsupported space runes",
+			canvas: image.Rect(0, 0, 10, 1),
+			meta:   &widgetapi.Meta{},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: '\t'},
+				&terminalapi.Keyboard{Key: 0x007f},
+				&terminalapi.Keyboard{Key: 'b'},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetAreaCells(
+					cvs,
+					image.Rect(0, 0, 10, 1),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testdraw.MustText(
+					cvs,
+					"ab",
+					image.Point{0, 0},
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "write filters runes with the provided FilterFn",
+			opts: []Option{
+				Filter(func(r rune) bool {
+					return r != 'b' && r != 'c'
+				}),
+			},
+			canvas: image.Rect(0, 0, 10, 1),
+			meta:   &widgetapi.Meta{},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: 'd'},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetAreaCells(
+					cvs,
+					image.Rect(0, 0, 10, 1),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testdraw.MustText(
+					cvs,
+					"ad",
+					image.Point{0, 0},
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "digits only filter ignores letters but not editing keys",
+			opts: []Option{
+				Filter(unicode.IsDigit),
+			},
+			canvas: image.Rect(0, 0, 10, 1),
+			meta:   &widgetapi.Meta{},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: '1'},
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: '2'},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowLeft},
+				&terminalapi.Keyboard{Key: keyboard.KeyBackspace2},
+				&terminalapi.Keyboard{Key: 'x'},
+				&terminalapi.Keyboard{Key: '3'},
+				&terminalapi.Keyboard{Key: keyboard.KeyEnd},
+				&terminalapi.Keyboard{Key: '4'},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetAreaCells(
+					cvs,
+					image.Rect(0, 0, 10, 1),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testdraw.MustText(
+					cvs,
+					"324",
+					image.Point{0, 0},
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+
+		{
+			desc:   "displays written text with full-width runes",
+			canvas: image.Rect(0, 0, 4, 1),
+			meta:   &widgetapi.Meta{},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: '世'},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetAreaCells(
+					cvs,
+					image.Rect(0, 0, 4, 1),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testdraw.MustText(
+					cvs,
+					"⇦世",
+					image.Point{0, 0},
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "hides text when requested",
+			opts: []Option{
+				HideTextWith('*'),
+			},
+			canvas: image.Rect(0, 0, 10, 1),
+			meta:   &widgetapi.Meta{},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: 'c'},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetAreaCells(
+					cvs,
+					image.Rect(0, 0, 10, 1),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testdraw.MustText(
+					cvs,
+					"***",
+					image.Point{0, 0},
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "hides text, but doesn't hide scrolling arrows",
+			opts: []Option{
+				HideTextWith('*'),
+			},
+			canvas: image.Rect(0, 0, 4, 1),
+			meta:   &widgetapi.Meta{},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: 'd'},
+				&terminalapi.Keyboard{Key: 'e'},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowLeft},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowLeft},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetAreaCells(
+					cvs,
+					image.Rect(0, 0, 4, 1),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testdraw.MustText(
+					cvs,
+					"⇦**⇨",
+					image.Point{0, 0},
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "displays custom scroll indicators",
+			opts: []Option{
+				ScrollIndicators('‹', '›'),
+			},
+			canvas: image.Rect(0, 0, 4, 1),
+			meta:   &widgetapi.Meta{},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: 'd'},
+				&terminalapi.Keyboard{Key: 'e'},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowLeft},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowLeft},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetAreaCells(
+					cvs,
+					image.Rect(0, 0, 4, 1),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testdraw.MustText(
+					cvs,
+					"‹cd›",
+					image.Point{0, 0},
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantRead: "abcde",
+		},
+		{
+			desc: "hides text hides scrolling arrows that are part of the text",
+			opts: []Option{
+				HideTextWith('*'),
+			},
+			canvas: image.Rect(0, 0, 4, 1),
+			meta:   &widgetapi.Meta{},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: '⇦'},
+				&terminalapi.Keyboard{Key: '⇨'},
+				&terminalapi.Keyboard{Key: 'e'},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowLeft},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowLeft},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetAreaCells(
+					cvs,
+					image.Rect(0, 0, 4, 1),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testdraw.MustText(
+					cvs,
+					"⇦**⇨",
+					image.Point{0, 0},
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "hides full-width runes with two hide runes",
+			opts: []Option{
+				HideTextWith('*'),
+			},
+			canvas: image.Rect(0, 0, 4, 1),
+			meta:   &widgetapi.Meta{},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: '世'},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetAreaCells(
+					cvs,
+					image.Rect(0, 0, 4, 1),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testdraw.MustText(
+					cvs,
+					"⇦**",
+					image.Point{0, 0},
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "sets custom text color",
+			opts: []Option{
+				TextColor(cell.ColorRed),
+			},
+			canvas: image.Rect(0, 0, 10, 1),
+			meta:   &widgetapi.Meta{},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: 'c'},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetAreaCells(
+					cvs,
+					image.Rect(0, 0, 10, 1),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testdraw.MustText(
+					cvs,
+					"abc",
+					image.Point{0, 0},
+					draw.TextCellOpts(cell.FgColor(cell.ColorRed)),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "displays written text and cursor when focused",
+			canvas: image.Rect(0, 0, 10, 1),
+			meta: &widgetapi.Meta{
+				Focused: true,
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: 'c'},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetAreaCells(
+					cvs,
+					image.Rect(0, 0, 10, 1),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testdraw.MustText(
+					cvs,
+					"abc",
+					image.Point{0, 0},
+				)
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{3, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "moves cursor left",
 			canvas: image.Rect(0, 0, 10, 1),
-			meta:   &widgetapi.Meta{},
+			meta: &widgetapi.Meta{
+				Focused: true,
+			},
 			events: []terminalapi.Event{
 				&terminalapi.Keyboard{Key: 'a'},
-				&terminalapi.Keyboard{Key: '\t'},
-				&terminalapi.Keyboard{Key: 0x007f},
 				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowLeft},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -809,27 +1517,34 @@ func TestTextInput(t *testing.T) {
 				)
 				testdraw.MustText(
 					cvs,
-					"ab",
+					"abc",
 					image.Point{0, 0},
 				)
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{2, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
+				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc: "write filters runes with the provided FilterFn",
+			desc: "sets custom highlight color",
 			opts: []Option{
-				Filter(func(r rune) bool {
-					return r != 'b' && r != 'c'
-				}),
+				HighlightedColor(cell.ColorRed),
 			},
 			canvas: image.Rect(0, 0, 10, 1),
-			meta:   &widgetapi.Meta{},
+			meta: &widgetapi.Meta{
+				Focused: true,
+			},
 			events: []terminalapi.Event{
 				&terminalapi.Keyboard{Key: 'a'},
 				&terminalapi.Keyboard{Key: 'b'},
 				&terminalapi.Keyboard{Key: 'c'},
-				&terminalapi.Keyboard{Key: 'd'},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowLeft},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -843,22 +1558,31 @@ func TestTextInput(t *testing.T) {
 				)
 				testdraw.MustText(
 					cvs,
-					"ad",
+					"abc",
 					image.Point{0, 0},
 				)
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{2, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorRed),
+				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
-
 		{
-			desc:   "displays written text with full-width runes",
-			canvas: image.Rect(0, 0, 4, 1),
-			meta:   &widgetapi.Meta{},
+			desc:   "moves cursor to start",
+			canvas: image.Rect(0, 0, 10, 1),
+			meta: &widgetapi.Meta{
+				Focused: true,
+			},
 			events: []terminalapi.Event{
 				&terminalapi.Keyboard{Key: 'a'},
 				&terminalapi.Keyboard{Key: 'b'},
-				&terminalapi.Keyboard{Key: '世'},
+				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: keyboard.KeyHome},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -866,30 +1590,38 @@ func TestTextInput(t *testing.T) {
 
 				testcanvas.MustSetAreaCells(
 					cvs,
-					image.Rect(0, 0, 4, 1),
+					image.Rect(0, 0, 10, 1),
 					textFieldRune,
 					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
 				)
 				testdraw.MustText(
 					cvs,
-					"⇦世",
+					"abc",
+					image.Point{0, 0},
+				)
+				testcanvas.MustSetCell(
+					cvs,
 					image.Point{0, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
 				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc: "hides text when requested",
-			opts: []Option{
-				HideTextWith('*'),
-			},
+			desc:   "moves cursor right",
 			canvas: image.Rect(0, 0, 10, 1),
-			meta:   &widgetapi.Meta{},
+			meta: &widgetapi.Meta{
+				Focused: true,
+			},
 			events: []terminalapi.Event{
 				&terminalapi.Keyboard{Key: 'a'},
 				&terminalapi.Keyboard{Key: 'b'},
 				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: keyboard.KeyHome},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowRight},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -903,28 +1635,32 @@ func TestTextInput(t *testing.T) {
 				)
 				testdraw.MustText(
 					cvs,
-					"***",
+					"abc",
 					image.Point{0, 0},
 				)
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{1, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
+				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc: "hides text, but doesn't hide scrolling arrows",
-			opts: []Option{
-				HideTextWith('*'),
+			desc:   "moves cursor to end",
+			canvas: image.Rect(0, 0, 10, 1),
+			meta: &widgetapi.Meta{
+				Focused: true,
 			},
-			canvas: image.Rect(0, 0, 4, 1),
-			meta:   &widgetapi.Meta{},
 			events: []terminalapi.Event{
 				&terminalapi.Keyboard{Key: 'a'},
 				&terminalapi.Keyboard{Key: 'b'},
 				&terminalapi.Keyboard{Key: 'c'},
-				&terminalapi.Keyboard{Key: 'd'},
-				&terminalapi.Keyboard{Key: 'e'},
-				&terminalapi.Keyboard{Key: keyboard.KeyArrowLeft},
-				&terminalapi.Keyboard{Key: keyboard.KeyArrowLeft},
+				&terminalapi.Keyboard{Key: keyboard.KeyHome},
+				&terminalapi.Keyboard{Key: keyboard.KeyEnd},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -932,34 +1668,38 @@ func TestTextInput(t *testing.T) {
 
 				testcanvas.MustSetAreaCells(
 					cvs,
-					image.Rect(0, 0, 4, 1),
+					image.Rect(0, 0, 10, 1),
 					textFieldRune,
 					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
 				)
 				testdraw.MustText(
 					cvs,
-					"⇦**⇨",
+					"abc",
 					image.Point{0, 0},
 				)
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{3, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
+				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc: "hides text hides scrolling arrows that are part of the text",
-			opts: []Option{
-				HideTextWith('*'),
+			desc:   "deletes rune the cursor is on",
+			canvas: image.Rect(0, 0, 10, 1),
+			meta: &widgetapi.Meta{
+				Focused: true,
 			},
-			canvas: image.Rect(0, 0, 4, 1),
-			meta:   &widgetapi.Meta{},
 			events: []terminalapi.Event{
 				&terminalapi.Keyboard{Key: 'a'},
 				&terminalapi.Keyboard{Key: 'b'},
-				&terminalapi.Keyboard{Key: '⇦'},
-				&terminalapi.Keyboard{Key: '⇨'},
-				&terminalapi.Keyboard{Key: 'e'},
-				&terminalapi.Keyboard{Key: keyboard.KeyArrowLeft},
-				&terminalapi.Keyboard{Key: keyboard.KeyArrowLeft},
+				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: keyboard.KeyHome},
+				&terminalapi.Keyboard{Key: keyboard.KeyDelete},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -967,30 +1707,37 @@ func TestTextInput(t *testing.T) {
 
 				testcanvas.MustSetAreaCells(
 					cvs,
-					image.Rect(0, 0, 4, 1),
+					image.Rect(0, 0, 10, 1),
 					textFieldRune,
 					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
 				)
 				testdraw.MustText(
 					cvs,
-					"⇦**⇨",
+					"bc",
+					image.Point{0, 0},
+				)
+				testcanvas.MustSetCell(
+					cvs,
 					image.Point{0, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
 				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc: "hides full-width runes with two hide runes",
-			opts: []Option{
-				HideTextWith('*'),
+			desc:   "deletes rune just before the cursor",
+			canvas: image.Rect(0, 0, 10, 1),
+			meta: &widgetapi.Meta{
+				Focused: true,
 			},
-			canvas: image.Rect(0, 0, 4, 1),
-			meta:   &widgetapi.Meta{},
 			events: []terminalapi.Event{
 				&terminalapi.Keyboard{Key: 'a'},
 				&terminalapi.Keyboard{Key: 'b'},
-				&terminalapi.Keyboard{Key: '世'},
+				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: keyboard.KeyBackspace},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -998,30 +1745,40 @@ func TestTextInput(t *testing.T) {
 
 				testcanvas.MustSetAreaCells(
 					cvs,
-					image.Rect(0, 0, 4, 1),
+					image.Rect(0, 0, 10, 1),
 					textFieldRune,
 					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
 				)
 				testdraw.MustText(
 					cvs,
-					"⇦**",
+					"ab",
 					image.Point{0, 0},
 				)
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{2, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
+				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc: "sets custom text color",
-			opts: []Option{
-				TextColor(cell.ColorRed),
-			},
+			desc:   "left mouse button moves the cursor",
 			canvas: image.Rect(0, 0, 10, 1),
-			meta:   &widgetapi.Meta{},
+			meta: &widgetapi.Meta{
+				Focused: true,
+			},
 			events: []terminalapi.Event{
 				&terminalapi.Keyboard{Key: 'a'},
 				&terminalapi.Keyboard{Key: 'b'},
 				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Mouse{
+					Button:   mouse.ButtonLeft,
+					Position: image.Point{1, 0},
+				},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -1037,14 +1794,23 @@ func TestTextInput(t *testing.T) {
 					cvs,
 					"abc",
 					image.Point{0, 0},
-					draw.TextCellOpts(cell.FgColor(cell.ColorRed)),
+				)
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{1, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
 				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc:   "displays written text and cursor when focused",
+			desc: "right-to-left displays written text and cursor on the right",
+			opts: []Option{
+				RightToLeft(),
+			},
 			canvas: image.Rect(0, 0, 10, 1),
 			meta: &widgetapi.Meta{
 				Focused: true,
@@ -1066,12 +1832,12 @@ func TestTextInput(t *testing.T) {
 				)
 				testdraw.MustText(
 					cvs,
-					"abc",
-					image.Point{0, 0},
+					"cba",
+					image.Point{7, 0},
 				)
 				testcanvas.MustSetCell(
 					cvs,
-					image.Point{3, 0},
+					image.Point{6, 0},
 					cursorRune,
 					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
 					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
@@ -1081,7 +1847,10 @@ func TestTextInput(t *testing.T) {
 			},
 		},
 		{
-			desc:   "moves cursor left",
+			desc: "right-to-left right arrow moves cursor towards the start of the text",
+			opts: []Option{
+				RightToLeft(),
+			},
 			canvas: image.Rect(0, 0, 10, 1),
 			meta: &widgetapi.Meta{
 				Focused: true,
@@ -1090,7 +1859,7 @@ func TestTextInput(t *testing.T) {
 				&terminalapi.Keyboard{Key: 'a'},
 				&terminalapi.Keyboard{Key: 'b'},
 				&terminalapi.Keyboard{Key: 'c'},
-				&terminalapi.Keyboard{Key: keyboard.KeyArrowLeft},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowRight},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -1104,12 +1873,12 @@ func TestTextInput(t *testing.T) {
 				)
 				testdraw.MustText(
 					cvs,
-					"abc",
-					image.Point{0, 0},
+					"cba",
+					image.Point{7, 0},
 				)
 				testcanvas.MustSetCell(
 					cvs,
-					image.Point{2, 0},
+					image.Point{7, 0},
 					cursorRune,
 					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
 					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
@@ -1119,9 +1888,9 @@ func TestTextInput(t *testing.T) {
 			},
 		},
 		{
-			desc: "sets custom highlight color",
+			desc: "right-to-left left arrow moves cursor towards the end of the text",
 			opts: []Option{
-				HighlightedColor(cell.ColorRed),
+				RightToLeft(),
 			},
 			canvas: image.Rect(0, 0, 10, 1),
 			meta: &widgetapi.Meta{
@@ -1131,6 +1900,7 @@ func TestTextInput(t *testing.T) {
 				&terminalapi.Keyboard{Key: 'a'},
 				&terminalapi.Keyboard{Key: 'b'},
 				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: keyboard.KeyHome},
 				&terminalapi.Keyboard{Key: keyboard.KeyArrowLeft},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
@@ -1145,22 +1915,25 @@ func TestTextInput(t *testing.T) {
 				)
 				testdraw.MustText(
 					cvs,
-					"abc",
-					image.Point{0, 0},
+					"cba",
+					image.Point{7, 0},
 				)
 				testcanvas.MustSetCell(
 					cvs,
-					image.Point{2, 0},
+					image.Point{8, 0},
 					cursorRune,
 					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
-					cell.FgColor(cell.ColorRed),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
 				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc:   "moves cursor to start",
+			desc: "right-to-left left mouse button moves the cursor",
+			opts: []Option{
+				RightToLeft(),
+			},
 			canvas: image.Rect(0, 0, 10, 1),
 			meta: &widgetapi.Meta{
 				Focused: true,
@@ -1169,7 +1942,10 @@ func TestTextInput(t *testing.T) {
 				&terminalapi.Keyboard{Key: 'a'},
 				&terminalapi.Keyboard{Key: 'b'},
 				&terminalapi.Keyboard{Key: 'c'},
-				&terminalapi.Keyboard{Key: keyboard.KeyHome},
+				&terminalapi.Mouse{
+					Button:   mouse.ButtonLeft,
+					Position: image.Point{9, 0},
+				},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -1183,12 +1959,12 @@ func TestTextInput(t *testing.T) {
 				)
 				testdraw.MustText(
 					cvs,
-					"abc",
-					image.Point{0, 0},
+					"cba",
+					image.Point{7, 0},
 				)
 				testcanvas.MustSetCell(
 					cvs,
-					image.Point{0, 0},
+					image.Point{9, 0},
 					cursorRune,
 					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
 					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
@@ -1198,17 +1974,18 @@ func TestTextInput(t *testing.T) {
 			},
 		},
 		{
-			desc:   "moves cursor right",
+			desc: "right-to-left full-width runes",
+			opts: []Option{
+				RightToLeft(),
+			},
 			canvas: image.Rect(0, 0, 10, 1),
 			meta: &widgetapi.Meta{
 				Focused: true,
 			},
 			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: '世'},
 				&terminalapi.Keyboard{Key: 'a'},
-				&terminalapi.Keyboard{Key: 'b'},
-				&terminalapi.Keyboard{Key: 'c'},
 				&terminalapi.Keyboard{Key: keyboard.KeyHome},
-				&terminalapi.Keyboard{Key: keyboard.KeyArrowRight},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -1222,12 +1999,12 @@ func TestTextInput(t *testing.T) {
 				)
 				testdraw.MustText(
 					cvs,
-					"abc",
-					image.Point{0, 0},
+					"a世",
+					image.Point{7, 0},
 				)
 				testcanvas.MustSetCell(
 					cvs,
-					image.Point{1, 0},
+					image.Point{8, 0},
 					cursorRune,
 					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
 					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
@@ -1237,17 +2014,13 @@ func TestTextInput(t *testing.T) {
 			},
 		},
 		{
-			desc:   "moves cursor to end",
+			desc:   "ignores tabs by default",
 			canvas: image.Rect(0, 0, 10, 1),
-			meta: &widgetapi.Meta{
-				Focused: true,
-			},
+			meta:   &widgetapi.Meta{},
 			events: []terminalapi.Event{
 				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: keyboard.KeyTab},
 				&terminalapi.Keyboard{Key: 'b'},
-				&terminalapi.Keyboard{Key: 'c'},
-				&terminalapi.Keyboard{Key: keyboard.KeyHome},
-				&terminalapi.Keyboard{Key: keyboard.KeyEnd},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -1261,32 +2034,25 @@ func TestTextInput(t *testing.T) {
 				)
 				testdraw.MustText(
 					cvs,
-					"abc",
+					"ab",
 					image.Point{0, 0},
 				)
-				testcanvas.MustSetCell(
-					cvs,
-					image.Point{3, 0},
-					cursorRune,
-					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
-					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
-				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc:   "deletes rune the cursor is on",
-			canvas: image.Rect(0, 0, 10, 1),
-			meta: &widgetapi.Meta{
-				Focused: true,
+			desc: "displays tabs expanded to the tab width",
+			opts: []Option{
+				AllowTab(),
+				TabWidth(3),
 			},
+			canvas: image.Rect(0, 0, 10, 1),
+			meta:   &widgetapi.Meta{},
 			events: []terminalapi.Event{
 				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: keyboard.KeyTab},
 				&terminalapi.Keyboard{Key: 'b'},
-				&terminalapi.Keyboard{Key: 'c'},
-				&terminalapi.Keyboard{Key: keyboard.KeyHome},
-				&terminalapi.Keyboard{Key: keyboard.KeyDelete},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -1300,31 +2066,28 @@ func TestTextInput(t *testing.T) {
 				)
 				testdraw.MustText(
 					cvs,
-					"bc",
-					image.Point{0, 0},
-				)
-				testcanvas.MustSetCell(
-					cvs,
+					"a   b",
 					image.Point{0, 0},
-					cursorRune,
-					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
-					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
 				)
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc:   "deletes rune just before the cursor",
+			desc: "cursor skips over the expanded tab",
+			opts: []Option{
+				AllowTab(),
+				TabWidth(3),
+			},
 			canvas: image.Rect(0, 0, 10, 1),
 			meta: &widgetapi.Meta{
 				Focused: true,
 			},
 			events: []terminalapi.Event{
 				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: keyboard.KeyTab},
 				&terminalapi.Keyboard{Key: 'b'},
-				&terminalapi.Keyboard{Key: 'c'},
-				&terminalapi.Keyboard{Key: keyboard.KeyBackspace},
+				&terminalapi.Keyboard{Key: keyboard.KeyArrowLeft},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -1338,12 +2101,12 @@ func TestTextInput(t *testing.T) {
 				)
 				testdraw.MustText(
 					cvs,
-					"ab",
+					"a   b",
 					image.Point{0, 0},
 				)
 				testcanvas.MustSetCell(
 					cvs,
-					image.Point{2, 0},
+					image.Point{4, 0},
 					cursorRune,
 					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
 					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
@@ -1353,19 +2116,20 @@ func TestTextInput(t *testing.T) {
 			},
 		},
 		{
-			desc:   "left mouse button moves the cursor",
+			desc: "mouse click within an expanded tab moves the cursor onto the tab",
+			opts: []Option{
+				AllowTab(),
+				TabWidth(3),
+			},
 			canvas: image.Rect(0, 0, 10, 1),
 			meta: &widgetapi.Meta{
 				Focused: true,
 			},
 			events: []terminalapi.Event{
 				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: keyboard.KeyTab},
 				&terminalapi.Keyboard{Key: 'b'},
-				&terminalapi.Keyboard{Key: 'c'},
-				&terminalapi.Mouse{
-					Button:   mouse.ButtonLeft,
-					Position: image.Point{1, 0},
-				},
+				&terminalapi.Mouse{Position: image.Point{3, 0}, Button: mouse.ButtonLeft},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -1379,7 +2143,7 @@ func TestTextInput(t *testing.T) {
 				)
 				testdraw.MustText(
 					cvs,
-					"abc",
+					"a   b",
 					image.Point{0, 0},
 				)
 				testcanvas.MustSetCell(
@@ -1585,6 +2349,79 @@ func TestTextInput(t *testing.T) {
 			if diff := pretty.Compare(tc.wantCallback, gotCallback); diff != "" {
 				t.Errorf("CallbackFn => unexpected diff (-want, +got):\n%s", diff)
 			}
+
+			if tc.wantRead != "" {
+				if got := ti.Read(); got != tc.wantRead {
+					t.Errorf("Read => %q, want %q", got, tc.wantRead)
+				}
+			}
+		})
+	}
+}
+
+func TestTerminalCursor(t *testing.T) {
+	tests := []struct {
+		desc       string
+		opts       []Option
+		meta       *widgetapi.Meta
+		wantCursor bool
+		want       image.Point
+	}{
+		{
+			desc: "doesn't request the cursor by default",
+			meta: &widgetapi.Meta{Focused: true},
+		},
+		{
+			desc: "doesn't request the cursor when not focused",
+			opts: []Option{
+				TerminalCursor(),
+			},
+			meta: &widgetapi.Meta{},
+		},
+		{
+			desc: "requests the cursor after the text",
+			opts: []Option{
+				TerminalCursor(),
+				DefaultText("abc"),
+			},
+			meta:       &widgetapi.Meta{Focused: true},
+			wantCursor: true,
+			want:       image.Point{3, 0},
+		},
+		{
+			desc: "requests the cursor inside the border",
+			opts: []Option{
+				TerminalCursor(),
+				Border(linestyle.Light),
+			},
+			meta:       &widgetapi.Meta{Focused: true},
+			wantCursor: true,
+			want:       image.Point{1, 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ti, err := New(tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			c, err := canvas.New(image.Rect(0, 0, 10, 3))
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+			if err := ti.Draw(c, tc.meta); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			got, ok := c.Cursor()
+			if ok != tc.wantCursor {
+				t.Fatalf("Cursor => got ok %v, want %v", ok, tc.wantCursor)
+			}
+			if ok && !got.Eq(tc.want) {
+				t.Errorf("Cursor => %v, want %v", got, tc.want)
+			}
 		})
 	}
 }
@@ -1592,6 +2429,7 @@ func TestTextInput(t *testing.T) {
 func TestTextInputRead(t *testing.T) {
 	tests := []struct {
 		desc   string
+		opts   []Option
 		events []terminalapi.Event
 		want   string
 	}{
@@ -1609,11 +2447,168 @@ func TestTextInputRead(t *testing.T) {
 			},
 			want: "abc",
 		},
+		{
+			desc: "ignores runes beyond MaxLength",
+			opts: []Option{
+				MaxLength(3),
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: 'd'},
+			},
+			want: "abc",
+		},
+		{
+			desc: "full-width runes count as one towards MaxLength",
+			opts: []Option{
+				MaxLength(3),
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: '世'},
+				&terminalapi.Keyboard{Key: '界'},
+				&terminalapi.Keyboard{Key: 'b'},
+			},
+			want: "a世界",
+		},
+		{
+			desc: "deleting runes makes space under MaxLength",
+			opts: []Option{
+				MaxLength(2),
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: keyboard.KeyBackspace2},
+				&terminalapi.Keyboard{Key: 'd'},
+			},
+			want: "ad",
+		},
+		{
+			desc: "DefaultText counts towards MaxLength",
+			opts: []Option{
+				MaxLength(3),
+				DefaultText("ab"),
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: 'd'},
+			},
+			want: "abc",
+		},
+		{
+			desc: "MaxLength is independent of the MaxWidthCells",
+			opts: []Option{
+				MaxWidthCells(4),
+				MaxLength(6),
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: 'd'},
+				&terminalapi.Keyboard{Key: 'e'},
+				&terminalapi.Keyboard{Key: 'f'},
+				&terminalapi.Keyboard{Key: 'g'},
+			},
+			want: "abcdef",
+		},
+		{
+			desc: "Ctrl+U clears the field",
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlU},
+				&terminalapi.Keyboard{Key: 'c'},
+			},
+			want: "c",
+		},
+		{
+			desc: "typing replaces the text selected with Ctrl+A",
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlA},
+				&terminalapi.Keyboard{Key: 'c'},
+			},
+			want: "c",
+		},
+		{
+			desc: "Backspace deletes the text selected with Ctrl+A",
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlA},
+				&terminalapi.Keyboard{Key: keyboard.KeyBackspace2},
+				&terminalapi.Keyboard{Key: 'c'},
+			},
+			want: "c",
+		},
+		{
+			desc: "Ctrl+W deletes the previous word",
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: ' '},
+				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: 'd'},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlW},
+			},
+			want: "ab ",
+		},
+		{
+			desc: "Ctrl+Backspace deletes the previous word",
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: ' '},
+				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: 'd'},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlBackspace},
+			},
+			want: "ab ",
+		},
+		{
+			desc: "Ctrl+arrows move the cursor by words",
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: ' '},
+				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: 'd'},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlArrowLeft},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlArrowLeft},
+				&terminalapi.Keyboard{Key: 'x'},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlArrowRight},
+				&terminalapi.Keyboard{Key: 'y'},
+			},
+			want: "xaby cd",
+		},
+		{
+			desc: "Ctrl+arrows move the cursor by words in the visual direction for right-to-left text",
+			opts: []Option{
+				RightToLeft(),
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: ' '},
+				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: 'd'},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlArrowRight},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlArrowRight},
+				&terminalapi.Keyboard{Key: 'x'},
+			},
+			want: "xab cd",
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
-			ti, err := New()
+			ti, err := New(tc.opts...)
 			if err != nil {
 				t.Fatalf("New => unexpected error: %v", err)
 			}
@@ -1913,3 +2908,129 @@ func TestSplit(t *testing.T) {
 		})
 	}
 }
+
+func TestMirrorText(t *testing.T) {
+	tests := []struct {
+		desc  string
+		text  string
+		left  rune // DefaultScrollLeftRune if zero.
+		right rune // DefaultScrollRightRune if zero.
+		want  string
+	}{
+		{
+			desc: "empty text",
+		},
+		{
+			desc: "reverses runes",
+			text: "abc",
+			want: "cba",
+		},
+		{
+			desc: "swaps the scrolling arrows",
+			text: "⇦bc⇨",
+			want: "⇦cb⇨",
+		},
+		{
+			desc:  "swaps custom scrolling indicators",
+			text:  "<bc>",
+			left:  '<',
+			right: '>',
+			want:  "<cb>",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			left, right := tc.left, tc.right
+			if left == 0 {
+				left, right = DefaultScrollLeftRune, DefaultScrollRightRune
+			}
+			if got := mirrorText(tc.text, left, right); got != tc.want {
+				t.Errorf("mirrorText(%q) => %q, want %q", tc.text, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSelected(t *testing.T) {
+	tests := []struct {
+		desc   string
+		opts   []Option
+		events []terminalapi.Event
+		want   string
+	}{
+		{
+			desc: "nothing selected",
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+			},
+			want: "",
+		},
+		{
+			desc: "Ctrl+A selects all the text",
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlA},
+			},
+			want: "ab",
+		},
+		{
+			desc: "Ctrl+A selects text longer than the field",
+			opts: []Option{
+				MaxWidthCells(4),
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: 'b'},
+				&terminalapi.Keyboard{Key: 'c'},
+				&terminalapi.Keyboard{Key: 'd'},
+				&terminalapi.Keyboard{Key: 'e'},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlA},
+			},
+			want: "abcde",
+		},
+		{
+			desc: "Home clears the selection",
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlA},
+				&terminalapi.Keyboard{Key: keyboard.KeyHome},
+			},
+			want: "",
+		},
+		{
+			desc: "Ctrl+U clears the selection",
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlA},
+				&terminalapi.Keyboard{Key: keyboard.KeyCtrlU},
+			},
+			want: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ti, err := New(tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			for _, ev := range tc.events {
+				k, ok := ev.(*terminalapi.Keyboard)
+				if !ok {
+					t.Fatalf("unsupported event type: %T", ev)
+				}
+				if err := ti.Keyboard(k, &widgetapi.EventMeta{}); err != nil {
+					t.Fatalf("Keyboard => unexpected error: %v", err)
+				}
+			}
+
+			if got := ti.Selected(); got != tc.want {
+				t.Errorf("Selected => %q, want %q", got, tc.want)
+			}
+		})
+	}
+}